@@ -28,6 +28,7 @@ import (
 	intconfig "github.com/kubeshop/botkube/internal/config"
 	"github.com/kubeshop/botkube/internal/config/reloader"
 	"github.com/kubeshop/botkube/internal/config/remote"
+	"github.com/kubeshop/botkube/internal/eventhistory"
 	"github.com/kubeshop/botkube/internal/health"
 	"github.com/kubeshop/botkube/internal/heartbeat"
 	"github.com/kubeshop/botkube/internal/httpx"
@@ -47,6 +48,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/maputil"
 	"github.com/kubeshop/botkube/pkg/multierror"
 	"github.com/kubeshop/botkube/pkg/notifier"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sink"
 	"github.com/kubeshop/botkube/pkg/version"
 )
@@ -218,7 +220,23 @@ func run(ctx context.Context) (err error) {
 		return metricsSrv.Serve(ctx)
 	})
 
+	eventHistory, err := eventhistory.GetStore(logger.WithField(componentLogFieldKey, "Event History"), conf.Settings, k8sCli)
+	if err != nil {
+		return reportFatalError("while creating event history store", err)
+	}
+	if asyncHistory, ok := eventHistory.(*eventhistory.AsyncStore); ok {
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, analyticsReporter)
+			asyncHistory.Run(ctx)
+			return nil
+		})
+	}
+
 	cmdGuard := command.NewCommandGuard(logger.WithField(componentLogFieldKey, "Command Guard"), discoveryCli)
+	cmdScheduler, err := schedule.NewScheduler(logger.WithField(componentLogFieldKey, "Command Scheduler"), conf.Schedules)
+	if err != nil {
+		return reportFatalError("while creating command scheduler", err)
+	}
 	// Create executor factory
 	cfgManager := config.NewManager(remoteCfgEnabled, logger.WithField(componentLogFieldKey, "Config manager"), conf.Settings.PersistentConfig, cfgVersion, k8sCli, gqlClient, deployClient)
 	executorFactory, err := execute.NewExecutorFactory(
@@ -233,6 +251,9 @@ func run(ctx context.Context) (err error) {
 			RestCfg:           kubeConfig,
 			AuditReporter:     auditReporter,
 			PluginHealthStats: pluginHealthStats,
+			EventHistory:      eventHistory,
+			UserMapping:       plugin.NewUserMappingLoader(logger.WithField(componentLogFieldKey, "User Mapping"), conf.Settings, k8sCli),
+			Schedules:         cmdScheduler,
 		},
 	)
 	if err != nil {
@@ -248,14 +269,16 @@ func run(ctx context.Context) (err error) {
 	//    For example, if in both communication groups there's a Slack configuration pointing to the same workspace,
 	//	  when user executes `kubectl` command, one Bot instance will execute the command and return response,
 	//	  and the second "Sorry, this channel is not authorized to execute kubectl command" error.
+	digestStore := storage.NewForDigest(conf.Settings.SystemConfigMap.Namespace, conf.Settings.SystemConfigMap.Name, k8sCli)
 	commKeys := maputil.SortKeys(conf.Communications)
 	for commGroupIdx, commGroupName := range commKeys {
 		commGroupCfg := conf.Communications[commGroupName]
 
 		commGroupLogger := logger.WithField(commGroupFieldKey, commGroupName)
 		commGroupMeta := bot.CommGroupMetadata{
-			Name:  commGroupName,
-			Index: commGroupIdx + 1,
+			Name:        commGroupName,
+			Index:       commGroupIdx + 1,
+			DigestStore: digestStore,
 		}
 
 		scheduleBotNotifier := func(in bot.Bot) {
@@ -329,6 +352,10 @@ func run(ctx context.Context) (err error) {
 			if err != nil {
 				return reportFatalError("while creating Elasticsearch sink", err)
 			}
+			errGroup.Go(func() error {
+				defer analytics.ReportPanicIfOccurs(commGroupLogger, analyticsReporter)
+				return es.Start(ctx)
+			})
 			sinkNotifiers = append(sinkNotifiers, es)
 		}
 
@@ -340,6 +367,34 @@ func run(ctx context.Context) (err error) {
 
 			sinkNotifiers = append(sinkNotifiers, wh)
 		}
+
+		if commGroupCfg.Loki.Enabled {
+			loki, err := sink.NewLoki(commGroupLogger.WithField(sinkLogFieldKey, "Loki"), commGroupMeta.Index, commGroupCfg.Loki, analyticsReporter)
+			if err != nil {
+				return reportFatalError("while creating Loki sink", err)
+			}
+			sinkNotifiers = append(sinkNotifiers, loki)
+		}
+
+		if commGroupCfg.OTLPLogs.Enabled {
+			otlp, err := sink.NewOTLPLogs(commGroupLogger.WithField(sinkLogFieldKey, "OTLPLogs"), commGroupMeta.Index, commGroupCfg.OTLPLogs, analyticsReporter)
+			if err != nil {
+				return reportFatalError("while creating OTLP logs sink", err)
+			}
+			sinkNotifiers = append(sinkNotifiers, otlp)
+		}
+
+		if commGroupCfg.Kafka.Enabled {
+			kafka, err := sink.NewKafka(commGroupLogger.WithField(sinkLogFieldKey, "Kafka"), commGroupMeta.Index, commGroupCfg.Kafka, analyticsReporter)
+			if err != nil {
+				return reportFatalError("while creating Kafka sink", err)
+			}
+			errGroup.Go(func() error {
+				defer analytics.ReportPanicIfOccurs(commGroupLogger, analyticsReporter)
+				return kafka.Start(ctx)
+			})
+			sinkNotifiers = append(sinkNotifiers, kafka)
+		}
 	}
 	healthChecker.SetNotifiers(getHealthNotifiers(bots, sinkNotifiers))
 
@@ -397,9 +452,14 @@ func run(ctx context.Context) (err error) {
 		})
 	}
 
+	errGroup.Go(func() error {
+		defer analytics.ReportPanicIfOccurs(logger, analyticsReporter)
+		return cmdScheduler.Run(ctx, executorFactory, bot.AsNotifiers(bots))
+	})
+
 	actionProvider := action.NewProvider(logger.WithField(componentLogFieldKey, "Action Provider"), conf.Actions, executorFactory)
 
-	sourcePluginDispatcher := source.NewDispatcher(logger, conf.Settings.ClusterName, bots, sinkNotifiers, pluginManager, actionProvider, analyticsReporter, auditReporter, kubeConfig)
+	sourcePluginDispatcher := source.NewDispatcher(logger, conf.Settings.ClusterName, bots, sinkNotifiers, pluginManager, actionProvider, analyticsReporter, auditReporter, eventHistory, kubeConfig)
 	scheduler := source.NewScheduler(ctx, logger, conf, sourcePluginDispatcher, schedulerChan)
 	err = scheduler.Start(ctx)
 	if err != nil {
@@ -124,6 +124,18 @@ func (t *ThreadMateExecutor) Execute(ctx context.Context, in executor.ExecuteInp
 		return executor.ExecuteOutput{
 			Message: svc.Export(cmd.Export),
 		}, nil
+	case cmd.OnCall != nil && cmd.OnCall.Who != nil:
+		return executor.ExecuteOutput{
+			Message: svc.OnCallWho(cmd.OnCall.Who),
+		}, nil
+	case cmd.OnCall != nil && cmd.OnCall.Override != nil:
+		return executor.ExecuteOutput{
+			Message: svc.OnCallOverride(cmd.OnCall.Override, in.Context.Message),
+		}, nil
+	case cmd.OnCall != nil && cmd.OnCall.Mention != nil:
+		return executor.ExecuteOutput{
+			Message: svc.OnCallMention(cmd.OnCall.Mention),
+		}, nil
 	default:
 		msg, _ := t.Help(ctx)
 		msg.BaseBody.Plaintext = "Please specify a valid command"
@@ -144,6 +156,7 @@ func (*ThreadMateExecutor) Help(context.Context) (api.Message, error) {
 				Buttons: []api.Button{
 					btnBuilder.ForCommandWithDescCmd("Pick a person", "thread-mate pick"),
 					btnBuilder.ForCommandWithDescCmd("Get Activity", "thread-mate get activity"),
+					btnBuilder.ForCommandWithDescCmd("Who is on call", "thread-mate oncall who"),
 				},
 			},
 		},
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/utils/strings/slices"
 )
@@ -14,7 +15,9 @@ import (
 // Resource represents a Kubernetes resource.
 type Resource struct {
 	// Name is always plural, e.g. "pods".
-	Name       string
+	Name string
+	// Group is the API group, e.g. "apps". It's empty for the core group.
+	Group      string
 	Namespaced bool
 
 	// SlashSeparatedInCommand indicates if the resource name should be separated with a slash in the command.
@@ -172,10 +175,25 @@ func (g *CommandGuard) GetServerResourceMap() (map[string]v1.APIResource, error)
 
 	resourceMap := make(map[string]v1.APIResource)
 	for _, item := range resList {
+		gv, err := schema.ParseGroupVersion(item.GroupVersion)
+		if err != nil {
+			g.log.Debugf("Skipping resources with invalid group version %q...", item.GroupVersion)
+			continue
+		}
+
 		for _, res := range item.APIResources {
-			// TODO: Resources should be provided with full group version to avoid collisions in names.
-			// 	For example, "pods" and "nodes" are both in "v1" and "metrics.k8s.io/v1beta1".
-			// 	Ignoring second occurrence for now.
+			// discovery returns group and version only on the list level
+			if res.Group == "" && res.Version == "" {
+				res.Group, res.Version = gv.Group, gv.Version
+			}
+
+			// resources from non-core groups are also available under the fully qualified name, e.g. "applications.argoproj.io"
+			if gv.Group != "" {
+				resourceMap[fmt.Sprintf("%s.%s", res.Name, gv.Group)] = res
+			}
+
+			// NOTE: Short names are ambiguous, for example, "pods" and "nodes" are both in "v1" and "metrics.k8s.io/v1beta1".
+			// 	Ignoring second occurrence, the resource is still available under the fully qualified name.
 			if _, exists := resourceMap[res.Name]; exists {
 				g.log.Debugf("Skipping resource with the same name %q (%q)...", res.Name, item.GroupVersion)
 				continue
@@ -199,6 +217,7 @@ func (g *CommandGuard) GetResourceDetailsFromMap(selectedVerb, resourceType stri
 	if slices.Contains(verbs, selectedVerb) {
 		return Resource{
 			Name:                    res.Name,
+			Group:                   res.Group,
 			Namespaced:              res.Namespaced,
 			SlashSeparatedInCommand: false,
 		}, nil
@@ -208,6 +227,7 @@ func (g *CommandGuard) GetResourceDetailsFromMap(selectedVerb, resourceType stri
 	if exists && slices.Contains(addVerbsWithSlash, selectedVerb) {
 		return Resource{
 			Name:                    res.Name,
+			Group:                   res.Group,
 			Namespaced:              res.Namespaced,
 			SlashSeparatedInCommand: true,
 		}, nil
@@ -196,10 +196,12 @@ func TestCommandGuard_GetResourceDetails_HappyPath(t *testing.T) {
 func TestCommandGuard_GetServerResourceMap_HappyPath(t *testing.T) {
 	// given
 	expectedResMap := map[string]v1.APIResource{
-		"pods":         {Name: "pods", Namespaced: true, Kind: "Pod", Verbs: []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}},
-		"nodes":        {Name: "nodes", Namespaced: false, Kind: "Node", Verbs: []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}, ShortNames: []string{"no"}},
-		"services":     {Name: "services", Namespaced: true, Kind: "Pod", Verbs: []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}},
-		"tokenreviews": {Name: "tokenreviews", Namespaced: false, Kind: "TokenReview", Verbs: []string{"create"}},
+		"pods":                               {Name: "pods", Namespaced: true, Version: "v1", Kind: "Pod", Verbs: []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}},
+		"nodes":                              {Name: "nodes", Namespaced: false, Version: "v1", Kind: "Node", Verbs: []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}, ShortNames: []string{"no"}},
+		"services":                           {Name: "services", Namespaced: true, Version: "v1", Kind: "Pod", Verbs: []string{"create", "delete", "deletecollection", "get", "list", "patch", "update", "watch"}},
+		"tokenreviews":                       {Name: "tokenreviews", Namespaced: false, Group: "authentication.k8s.io", Version: "v1", Kind: "TokenReview", Verbs: []string{"create"}},
+		"tokenreviews.authentication.k8s.io": {Name: "tokenreviews", Namespaced: false, Group: "authentication.k8s.io", Version: "v1", Kind: "TokenReview", Verbs: []string{"create"}},
+		"pods.metrics.k8s.io":                {Name: "pods", Namespaced: false, Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetrics", Verbs: []string{"get", "list"}},
 	}
 	fakeDisco := &fakeDisco{
 		list: []*v1.APIResourceList{
@@ -153,6 +153,24 @@ func fixConfig(actionEnabled bool) config.Config {
 				Name:      "botkube-system",
 				Namespace: "botkube",
 			},
+			EventHistory: config.EventHistory{
+				Storage: config.EventHistoryStorage{
+					Type: config.EventHistoryConfigMapStorage,
+					Bolt: config.BoltStorage{
+						Path: "/tmp/botkube-event-history.db",
+					},
+					ConfigMap: config.K8sResourceRef{
+						Name: "botkube-event-history",
+					},
+				},
+				Retention: config.EventHistoryRetention{
+					MaxEvents: 100,
+					MaxAge:    24 * time.Hour,
+				},
+			},
+		},
+		CommandApprovals: config.CommandApprovals{
+			Timeout: 15 * time.Minute,
 		},
 		Plugins: config.PluginManagement{
 			CacheDir: "/tmp",
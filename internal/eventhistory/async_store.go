@@ -0,0 +1,86 @@
+package eventhistory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var _ Store = (*AsyncStore)(nil)
+
+const (
+	asyncStoreQueueSize    = 1000
+	asyncStoreFlushTimeout = 10 * time.Second
+	asyncStoreWriteTimeout = 30 * time.Second
+)
+
+// ErrQueueFull is returned when the event cannot be queued as the underlying store doesn't keep up with incoming events.
+var ErrQueueFull = errors.New("event history queue is full, event dropped")
+
+// AsyncStore writes events to the underlying store in the background, so dispatching events is not blocked by the storage.
+// Queued events are written once Run is started.
+type AsyncStore struct {
+	Store
+
+	log   logrus.FieldLogger
+	queue chan Event
+}
+
+// NewAsyncStore returns a new AsyncStore instance.
+func NewAsyncStore(log logrus.FieldLogger, store Store) *AsyncStore {
+	return &AsyncStore{
+		Store: store,
+		log:   log,
+		queue: make(chan Event, asyncStoreQueueSize),
+	}
+}
+
+// Add queues a given event. The returned ID is always empty, as it's assigned once the event is written.
+// If the queue is full, the event is dropped and ErrQueueFull is returned.
+func (s *AsyncStore) Add(_ context.Context, event Event) (string, error) {
+	select {
+	case s.queue <- event:
+		return "", nil
+	default:
+		return "", ErrQueueFull
+	}
+}
+
+// Run writes queued events until the context is cancelled. Remaining events are written before returning.
+func (s *AsyncStore) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			s.flush()
+			return
+		case event := <-s.queue:
+			s.write(ctx, event)
+		}
+	}
+}
+
+func (s *AsyncStore) flush() {
+	// the parent context is already cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), asyncStoreFlushTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case event := <-s.queue:
+			s.write(ctx, event)
+		default:
+			return
+		}
+	}
+}
+
+func (s *AsyncStore) write(ctx context.Context, event Event) {
+	ctx, cancel := context.WithTimeout(ctx, asyncStoreWriteTimeout)
+	defer cancel()
+
+	if _, err := s.Store.Add(ctx, event); err != nil {
+		s.log.Errorf("while storing event from source %q: %s", event.SourceName, err.Error())
+	}
+}
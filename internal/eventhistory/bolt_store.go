@@ -0,0 +1,185 @@
+package eventhistory
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+var _ Store = (*BoltStore)(nil)
+
+var eventsBucket = []byte("events")
+
+const boltOpenTimeout = 5 * time.Second
+
+// BoltStore stores events in an embedded BoltDB file.
+// Events are keyed by a monotonically increasing sequence number, so they are ordered from the oldest to the newest.
+type BoltStore struct {
+	log       logrus.FieldLogger
+	db        *bolt.DB
+	retention retention
+}
+
+// NewBoltStore opens or creates BoltDB file under a given path and returns a new BoltStore instance.
+func NewBoltStore(log logrus.FieldLogger, path string, cfg config.EventHistoryRetention) (*BoltStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path for the BoltDB event history storage cannot be empty")
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("while opening BoltDB file %q: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(eventsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while creating events bucket: %w", err)
+	}
+
+	return &BoltStore{
+		log:       log,
+		db:        db,
+		retention: retention{cfg: cfg, now: time.Now},
+	}, nil
+}
+
+// Add stores a given event and removes events that exceed retention limits.
+func (s *BoltStore) Add(_ context.Context, event Event) (string, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket)
+
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return fmt.Errorf("while getting next sequence: %w", err)
+		}
+		event.ID = strconv.FormatUint(seq, 10)
+
+		raw, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("while marshaling event: %w", err)
+		}
+		if err := bucket.Put(boltKey(seq), raw); err != nil {
+			return fmt.Errorf("while storing event: %w", err)
+		}
+
+		return s.prune(bucket, seq)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return event.ID, nil
+}
+
+// List returns events matching a given query, starting from the newest ones.
+func (s *BoltStore) List(_ context.Context, query Query) ([]Event, error) {
+	var out []Event
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(eventsBucket).Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			var event Event
+			if err := json.Unmarshal(v, &event); err != nil {
+				return fmt.Errorf("while unmarshaling event %q: %w", k, err)
+			}
+			if !query.Since.IsZero() && event.Timestamp.Before(query.Since) {
+				// all remaining events are older
+				return nil
+			}
+			if !query.Matches(event) {
+				continue
+			}
+
+			out = append(out, event)
+			if query.Limit > 0 && len(out) >= query.Limit {
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Get returns event with a given ID.
+func (s *BoltStore) Get(_ context.Context, id string) (Event, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return Event{}, ErrEventNotFound
+	}
+
+	var event Event
+	err = s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(eventsBucket).Get(boltKey(seq))
+		if raw == nil {
+			return ErrEventNotFound
+		}
+		return json.Unmarshal(raw, &event)
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return event, nil
+}
+
+// Close releases the BoltDB file.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// prune removes events exceeding retention limits. As events are removed only from the beginning,
+// keys are contiguous and the number of stored events can be calculated based on the first and last sequence.
+func (s *BoltStore) prune(bucket *bolt.Bucket, lastSeq uint64) error {
+	cursor := bucket.Cursor()
+	firstKey, _ := cursor.First()
+	if firstKey == nil {
+		return nil
+	}
+	total := int(lastSeq - binary.BigEndian.Uint64(firstKey) + 1)
+
+	// events are ordered, so the retention is checked only until the first event which should be kept
+	var keysToRemove [][]byte
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		var event Event
+		if err := json.Unmarshal(v, &event); err != nil {
+			return fmt.Errorf("while unmarshaling event %q: %w", k, err)
+		}
+
+		if !s.retention.exceedsMaxEvents(total-len(keysToRemove)) && !s.retention.isExpired(event) {
+			break
+		}
+		// keys are backed by the memory-mapped pages, which may be modified by deletion
+		keysToRemove = append(keysToRemove, append([]byte(nil), k...))
+	}
+
+	for _, k := range keysToRemove {
+		if err := bucket.Delete(k); err != nil {
+			return fmt.Errorf("while deleting event %q: %w", k, err)
+		}
+	}
+
+	if len(keysToRemove) > 0 {
+		s.log.Debugf("Removed %d event(s) from history due to retention limits.", len(keysToRemove))
+	}
+	return nil
+}
+
+func boltKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
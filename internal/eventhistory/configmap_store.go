@@ -0,0 +1,241 @@
+package eventhistory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+var _ Store = (*ConfigMapStore)(nil)
+
+const (
+	configMapEventsKey = "events"
+	// maxConfigMapDataSize is the maximum size of the stored events. It's lower than the 1 MiB ConfigMap limit to leave room for metadata.
+	maxConfigMapDataSize = 900 * 1024
+)
+
+// configMapState defines the ConfigMap persistence model.
+type configMapState struct {
+	LastID uint64  `json:"lastID"`
+	Events []Event `json:"events"`
+}
+
+// ConfigMapStore stores events in a ConfigMap used as a ring buffer.
+// The whole state is kept in memory and written to the ConfigMap on each change.
+// As the ConfigMap size is limited to 1 MiB, the oldest events are removed once the stored data exceeds maxConfigMapDataSize.
+type ConfigMapStore struct {
+	log       logrus.FieldLogger
+	k8sCli    kubernetes.Interface
+	ref       config.K8sResourceRef
+	retention retention
+
+	mu     sync.Mutex
+	loaded bool
+	state  configMapState
+	// cm is the last written ConfigMap, so it doesn't have to be fetched before each update.
+	cm *corev1.ConfigMap
+}
+
+// NewConfigMapStore returns a new ConfigMapStore instance.
+func NewConfigMapStore(log logrus.FieldLogger, k8sCli kubernetes.Interface, ref config.K8sResourceRef, cfg config.EventHistoryRetention) *ConfigMapStore {
+	return &ConfigMapStore{
+		log:       log,
+		k8sCli:    k8sCli,
+		ref:       ref,
+		retention: retention{cfg: cfg, now: time.Now},
+	}
+}
+
+// Add stores a given event and removes events that exceed retention limits.
+func (s *ConfigMapStore) Add(ctx context.Context, event Event) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return "", err
+	}
+
+	event.ID = strconv.FormatUint(s.state.LastID+1, 10)
+	events := append(s.state.Events, event)
+	if idx := s.retention.firstRetainedIdx(events); idx > 0 {
+		s.log.Debugf("Removing %d event(s) from history due to retention limits.", idx)
+		// copy to release the underlying array of the removed events
+		events = append([]Event(nil), events[idx:]...)
+	}
+
+	newState := configMapState{
+		LastID: s.state.LastID + 1,
+		Events: events,
+	}
+	newState, err := s.save(ctx, newState)
+	if err != nil {
+		return "", err
+	}
+
+	s.state = newState
+	return event.ID, nil
+}
+
+// List returns events matching a given query, starting from the newest ones.
+func (s *ConfigMapStore) List(ctx context.Context, query Query) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return nil, err
+	}
+
+	var out []Event
+	for i := len(s.state.Events) - 1; i >= 0; i-- {
+		event := s.state.Events[i]
+		if !query.Matches(event) {
+			continue
+		}
+
+		out = append(out, event)
+		if query.Limit > 0 && len(out) >= query.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+// Get returns event with a given ID.
+func (s *ConfigMapStore) Get(ctx context.Context, id string) (Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return Event{}, err
+	}
+
+	for _, event := range s.state.Events {
+		if event.ID == id {
+			return event, nil
+		}
+	}
+	return Event{}, ErrEventNotFound
+}
+
+// load reads the state from the ConfigMap. It's done only once, as the store is the only ConfigMap writer.
+func (s *ConfigMapStore) load(ctx context.Context) error {
+	if s.loaded {
+		return nil
+	}
+
+	cm, err := s.k8sCli.CoreV1().ConfigMaps(s.ref.Namespace).Get(ctx, s.ref.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		s.loaded = true
+		return nil
+	default:
+		return fmt.Errorf("while getting the event history ConfigMap: %w", err)
+	}
+
+	var state configMapState
+	if data, found := cm.Data[configMapEventsKey]; found {
+		if err := json.Unmarshal([]byte(data), &state); err != nil {
+			return fmt.Errorf("while unmarshaling the event history data: %w", err)
+		}
+	}
+
+	s.state = state
+	s.cm = cm
+	s.loaded = true
+	return nil
+}
+
+// save writes a given state to the ConfigMap and returns the written state, which may have the oldest events removed due to the size limit.
+func (s *ConfigMapStore) save(ctx context.Context, state configMapState) (configMapState, error) {
+	raw, state, err := s.marshalWithinLimit(state)
+	if err != nil {
+		return configMapState{}, err
+	}
+
+	if s.cm != nil {
+		newCM := s.cm.DeepCopy()
+		if newCM.Data == nil {
+			newCM.Data = map[string]string{}
+		}
+		newCM.Data[configMapEventsKey] = string(raw)
+
+		updated, err := s.k8sCli.CoreV1().ConfigMaps(newCM.Namespace).Update(ctx, newCM, metav1.UpdateOptions{})
+		switch {
+		case err == nil:
+			s.cm = updated
+			return state, nil
+		case apierrors.IsConflict(err), apierrors.IsNotFound(err):
+			// modified or removed by someone else, fall back to the full flow
+			s.cm = nil
+		default:
+			return configMapState{}, fmt.Errorf("while updating the event history ConfigMap: %w", err)
+		}
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.ref.Name,
+			Namespace: s.ref.Namespace,
+		},
+		Data: map[string]string{
+			configMapEventsKey: string(raw),
+		},
+	}
+
+	created, err := s.k8sCli.CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		s.cm = created
+	case apierrors.IsAlreadyExists(err):
+		old, err := s.k8sCli.CoreV1().ConfigMaps(cm.Namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+		if err != nil {
+			return configMapState{}, fmt.Errorf("while getting already existing ConfigMap: %w", err)
+		}
+
+		newCM := old.DeepCopy()
+		if newCM.Data == nil {
+			newCM.Data = map[string]string{}
+		}
+		newCM.Data[configMapEventsKey] = string(raw)
+
+		updated, err := s.k8sCli.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, newCM, metav1.UpdateOptions{})
+		if err != nil {
+			return configMapState{}, fmt.Errorf("while updating the event history ConfigMap: %w", err)
+		}
+		s.cm = updated
+	default:
+		return configMapState{}, fmt.Errorf("while creating the event history ConfigMap: %w", err)
+	}
+
+	return state, nil
+}
+
+// marshalWithinLimit marshals a given state. The oldest events are removed until the data fits maxConfigMapDataSize.
+func (s *ConfigMapStore) marshalWithinLimit(state configMapState) ([]byte, configMapState, error) {
+	for {
+		raw, err := json.Marshal(state)
+		if err != nil {
+			return nil, configMapState{}, fmt.Errorf("while marshaling the event history data: %w", err)
+		}
+		if len(raw) <= maxConfigMapDataSize || len(state.Events) <= 1 {
+			return raw, state, nil
+		}
+
+		// remove the oldest quarter at once to limit the number of attempts
+		toRemove := len(state.Events)/4 + 1
+		s.log.Debugf("Removing %d event(s) from history due to the ConfigMap size limit.", toRemove)
+		state.Events = append([]Event(nil), state.Events[toRemove:]...)
+	}
+}
@@ -0,0 +1,30 @@
+package eventhistory
+
+import (
+	"context"
+)
+
+var _ Store = (*NoopStore)(nil)
+
+// NoopStore is the NOOP event history store used when the event history is disabled.
+type NoopStore struct{}
+
+// NewNoopStore returns a new NoopStore instance.
+func NewNoopStore() *NoopStore {
+	return &NoopStore{}
+}
+
+// Add is a NOOP.
+func (*NoopStore) Add(context.Context, Event) (string, error) {
+	return "", nil
+}
+
+// List always returns no events.
+func (*NoopStore) List(context.Context, Query) ([]Event, error) {
+	return nil, nil
+}
+
+// Get always returns ErrEventNotFound.
+func (*NoopStore) Get(context.Context, string) (Event, error) {
+	return Event{}, ErrEventNotFound
+}
@@ -0,0 +1,131 @@
+package eventhistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// ErrEventNotFound is returned when a given event doesn't exist in the history.
+var ErrEventNotFound = errors.New("event not found")
+
+// MaxRawObjectSize is the maximum size of the raw event object stored in the history. Bigger objects are not stored.
+const MaxRawObjectSize = 32 * 1024
+
+// Event represents a single event stored in the history.
+type Event struct {
+	ID         string `json:"id"`
+	SourceName string `json:"sourceName"`
+	// Interactive is true if event was dispatched to platforms with interactivity support.
+	// The same source is started separately for interactive and non-interactive platforms, so a given event is stored for each of them.
+	Interactive bool            `json:"interactive"`
+	Timestamp   time.Time       `json:"timestamp"`
+	Namespace   string          `json:"namespace,omitempty"`
+	Level       string          `json:"level,omitempty"`
+	Title       string          `json:"title"`
+	RawObject   json.RawMessage `json:"rawObject,omitempty"`
+}
+
+// Query defines criteria for listing events.
+type Query struct {
+	// SourceNames holds source names which events should be returned. Events from all sources are returned if empty.
+	SourceNames []string
+	Interactive bool
+	Namespace   string
+	Since       time.Time
+	// Limit defines the maximum number of returned events. No limit is applied if zero.
+	Limit int
+}
+
+// Matches returns true if a given event matches the query criteria.
+func (q Query) Matches(event Event) bool {
+	if event.Interactive != q.Interactive {
+		return false
+	}
+	if len(q.SourceNames) > 0 && !slices.Contains(q.SourceNames, event.SourceName) {
+		return false
+	}
+	if q.Namespace != "" && q.Namespace != event.Namespace {
+		return false
+	}
+	if !q.Since.IsZero() && event.Timestamp.Before(q.Since) {
+		return false
+	}
+	return true
+}
+
+// Store persists dispatched events.
+type Store interface {
+	// Add stores a given event and returns its assigned ID.
+	Add(ctx context.Context, event Event) (string, error)
+	// List returns events matching a given query, starting from the newest ones.
+	List(ctx context.Context, query Query) ([]Event, error)
+	// Get returns event with a given ID. If not found, ErrEventNotFound is returned.
+	Get(ctx context.Context, id string) (Event, error)
+}
+
+// GetStore returns the event history store based on the configuration.
+// If the history is enabled, events are written asynchronously once AsyncStore.Run is started.
+func GetStore(log logrus.FieldLogger, cfg config.Settings, k8sCli kubernetes.Interface) (Store, error) {
+	historyCfg := cfg.EventHistory
+	if !historyCfg.Enabled {
+		return NewNoopStore(), nil
+	}
+
+	var store Store
+	switch historyCfg.Storage.Type {
+	case config.EventHistoryBoltStorage:
+		boltStore, err := NewBoltStore(log, historyCfg.Storage.Bolt.Path, historyCfg.Retention)
+		if err != nil {
+			return nil, err
+		}
+		store = boltStore
+	case config.EventHistoryConfigMapStorage, "":
+		ref := historyCfg.Storage.ConfigMap
+		if ref.Namespace == "" {
+			ref.Namespace = cfg.SystemConfigMap.Namespace
+		}
+		store = NewConfigMapStore(log, k8sCli, ref, historyCfg.Retention)
+	default:
+		return nil, fmt.Errorf("unknown event history storage type %q", historyCfg.Storage.Type)
+	}
+
+	return NewAsyncStore(log, store), nil
+}
+
+// IsEnabled returns false if a given store is the NOOP store used when the event history is disabled.
+func IsEnabled(store Store) bool {
+	_, noop := store.(*NoopStore)
+	return store != nil && !noop
+}
+
+// retention applies retention limits to events ordered from the oldest to the newest.
+type retention struct {
+	cfg config.EventHistoryRetention
+	now func() time.Time
+}
+
+// firstRetainedIdx returns an index of the first event that should be kept.
+func (r retention) firstRetainedIdx(events []Event) int {
+	idx := 0
+	for idx < len(events) && (r.exceedsMaxEvents(len(events)-idx) || r.isExpired(events[idx])) {
+		idx++
+	}
+	return idx
+}
+
+func (r retention) exceedsMaxEvents(count int) bool {
+	return r.cfg.MaxEvents > 0 && count > r.cfg.MaxEvents
+}
+
+func (r retention) isExpired(event Event) bool {
+	return r.cfg.MaxAge > 0 && event.Timestamp.Before(r.now().Add(-r.cfg.MaxAge))
+}
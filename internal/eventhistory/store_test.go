@@ -0,0 +1,222 @@
+package eventhistory
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+var fixNow = time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC)
+
+func TestStores(t *testing.T) {
+	retentionCfg := config.EventHistoryRetention{
+		MaxEvents: 3,
+		MaxAge:    time.Hour,
+	}
+
+	tests := []struct {
+		name     string
+		newStore func(t *testing.T) Store
+	}{
+		{
+			name: "BoltDB",
+			newStore: func(t *testing.T) Store {
+				store, err := NewBoltStore(loggerx.NewNoop(), filepath.Join(t.TempDir(), "events.db"), retentionCfg)
+				require.NoError(t, err)
+				t.Cleanup(func() {
+					assert.NoError(t, store.Close())
+				})
+				store.retention.now = func() time.Time { return fixNow }
+				return store
+			},
+		},
+		{
+			name: "ConfigMap",
+			newStore: func(t *testing.T) Store {
+				ref := config.K8sResourceRef{Name: "botkube-event-history", Namespace: "botkube"}
+				store := NewConfigMapStore(loggerx.NewNoop(), fake.NewSimpleClientset(), ref, retentionCfg)
+				store.retention.now = func() time.Time { return fixNow }
+				return store
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			store := tc.newStore(t)
+
+			fixEvents := []Event{
+				fixEvent("k8s-events", "default", fixNow.Add(-2*time.Hour)), // expired
+				fixEvent("k8s-events", "default", fixNow.Add(-50*time.Minute)),
+				fixEvent("prometheus", "kube-system", fixNow.Add(-40*time.Minute)),
+				fixEvent("k8s-events", "kube-system", fixNow.Add(-30*time.Minute)),
+				fixEvent("k8s-events", "default", fixNow.Add(-20*time.Minute)),
+			}
+
+			// when
+			for idx, event := range fixEvents {
+				id, err := store.Add(ctx, event)
+				require.NoError(t, err)
+				assert.Equal(t, fmt.Sprint(idx+1), id)
+			}
+
+			// then
+			all, err := store.List(ctx, Query{})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"5", "4", "3"}, eventIDs(all), "only last 3 events should be retained")
+
+			filtered, err := store.List(ctx, Query{SourceNames: []string{"k8s-events"}})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"5", "4"}, eventIDs(filtered))
+
+			filtered, err = store.List(ctx, Query{Namespace: "kube-system"})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"4", "3"}, eventIDs(filtered))
+
+			filtered, err = store.List(ctx, Query{Since: fixNow.Add(-35 * time.Minute)})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"5", "4"}, eventIDs(filtered))
+
+			filtered, err = store.List(ctx, Query{Limit: 1})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"5"}, eventIDs(filtered))
+
+			filtered, err = store.List(ctx, Query{Interactive: true})
+			require.NoError(t, err)
+			assert.Empty(t, filtered)
+
+			event, err := store.Get(ctx, "4")
+			require.NoError(t, err)
+			assert.Equal(t, "kube-system", event.Namespace)
+			assert.JSONEq(t, `{"kind":"Pod"}`, string(event.RawObject))
+
+			_, err = store.Get(ctx, "1")
+			assert.ErrorIs(t, err, ErrEventNotFound)
+		})
+	}
+}
+
+func TestConfigMapStoreLoadsPersistedEvents(t *testing.T) {
+	// given
+	ctx := context.Background()
+	k8sCli := fake.NewSimpleClientset()
+	ref := config.K8sResourceRef{Name: "botkube-event-history", Namespace: "botkube"}
+
+	store := NewConfigMapStore(loggerx.NewNoop(), k8sCli, ref, config.EventHistoryRetention{})
+	_, err := store.Add(ctx, fixEvent("k8s-events", "default", fixNow))
+	require.NoError(t, err)
+
+	// when
+	restartedStore := NewConfigMapStore(loggerx.NewNoop(), k8sCli, ref, config.EventHistoryRetention{})
+	id, err := restartedStore.Add(ctx, fixEvent("k8s-events", "default", fixNow))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "2", id)
+	events, err := restartedStore.List(ctx, Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "1"}, eventIDs(events))
+}
+
+func TestConfigMapStoreSizeLimit(t *testing.T) {
+	// given
+	ctx := context.Background()
+	ref := config.K8sResourceRef{Name: "botkube-event-history", Namespace: "botkube"}
+	k8sCli := fake.NewSimpleClientset()
+	store := NewConfigMapStore(loggerx.NewNoop(), k8sCli, ref, config.EventHistoryRetention{})
+
+	event := fixEvent("k8s-events", "default", fixNow)
+	event.RawObject = []byte(fmt.Sprintf(`{"data":%q}`, strings.Repeat("x", MaxRawObjectSize-20)))
+
+	// when
+	for i := 0; i < 50; i++ {
+		_, err := store.Add(ctx, event)
+		require.NoError(t, err)
+	}
+
+	// then
+	cm, err := k8sCli.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(cm.Data[configMapEventsKey]), maxConfigMapDataSize)
+
+	events, err := store.List(ctx, Query{})
+	require.NoError(t, err)
+	assert.Less(t, len(events), 50)
+	assert.Equal(t, "50", events[0].ID, "the newest event should be kept")
+}
+
+func TestAsyncStore(t *testing.T) {
+	// given
+	ref := config.K8sResourceRef{Name: "botkube-event-history", Namespace: "botkube"}
+	underlying := NewConfigMapStore(loggerx.NewNoop(), fake.NewSimpleClientset(), ref, config.EventHistoryRetention{})
+	store := NewAsyncStore(loggerx.NewNoop(), underlying)
+
+	// when
+	for i := 0; i < 3; i++ {
+		id, err := store.Add(context.Background(), fixEvent("k8s-events", "default", fixNow))
+		require.NoError(t, err)
+		assert.Empty(t, id)
+	}
+
+	// then events are not written until the store is started
+	events, err := store.List(context.Background(), Query{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	// when
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	store.Run(ctx)
+
+	// then queued events are written on shutdown
+	events, err = store.List(context.Background(), Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "2", "1"}, eventIDs(events))
+}
+
+func TestAsyncStoreDropsEventsWhenQueueIsFull(t *testing.T) {
+	// given
+	store := NewAsyncStore(loggerx.NewNoop(), NewNoopStore())
+	for i := 0; i < asyncStoreQueueSize; i++ {
+		_, err := store.Add(context.Background(), fixEvent("k8s-events", "default", fixNow))
+		require.NoError(t, err)
+	}
+
+	// when
+	_, err := store.Add(context.Background(), fixEvent("k8s-events", "default", fixNow))
+
+	// then
+	assert.ErrorIs(t, err, ErrQueueFull)
+}
+
+func fixEvent(source, ns string, timestamp time.Time) Event {
+	return Event{
+		SourceName: source,
+		Timestamp:  timestamp,
+		Namespace:  ns,
+		Level:      "error",
+		Title:      "v1/pods error",
+		RawObject:  []byte(`{"kind":"Pod"}`),
+	}
+}
+
+func eventIDs(events []Event) []string {
+	var out []string
+	for _, event := range events {
+		out = append(out, event.ID)
+	}
+	return out
+}
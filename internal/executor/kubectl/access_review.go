@@ -0,0 +1,151 @@
+package kubectl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/executor/kubectl/accessreview"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/formatx"
+)
+
+// AccessReviewConfig holds configuration for checking permissions before running commands.
+type AccessReviewConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+type (
+	accessReviewer interface {
+		Review(ctx context.Context, kubeConfigPath, defaultNamespace, cmd string) (*accessreview.DeniedAccess, impersonationSubject, error)
+	}
+
+	// impersonationSubject holds the identity used to run the commands, generated based on the plugin RBAC policy rule.
+	impersonationSubject struct {
+		User   string
+		Groups []string
+	}
+)
+
+// kubeconfigAccessReviewer reviews commands for the identity configured in a given kubeconfig.
+// It's created once per executor, so the cluster resources are discovered only once and shared between identities.
+type kubeconfigAccessReviewer struct {
+	mu        sync.Mutex
+	resources *accessreview.ResourceCache
+}
+
+func newKubeconfigAccessReviewer() *kubeconfigAccessReviewer {
+	return &kubeconfigAccessReviewer{}
+}
+
+// Review checks if a given command is allowed for the identity configured in a given kubeconfig.
+func (r *kubeconfigAccessReviewer) Review(ctx context.Context, kubeConfigPath, defaultNamespace, cmd string) (*accessreview.DeniedAccess, impersonationSubject, error) {
+	rawCfg, err := clientcmd.LoadFromFile(kubeConfigPath)
+	if err != nil {
+		return nil, impersonationSubject{}, fmt.Errorf("while loading kubeconfig: %w", err)
+	}
+
+	var subject impersonationSubject
+	if kubeCtx, found := rawCfg.Contexts[rawCfg.CurrentContext]; found {
+		if authInfo, found := rawCfg.AuthInfos[kubeCtx.AuthInfo]; found {
+			subject = impersonationSubject{
+				User:   authInfo.Impersonate,
+				Groups: authInfo.ImpersonateGroups,
+			}
+		}
+	}
+
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeConfigPath)
+	if err != nil {
+		return nil, subject, fmt.Errorf("while creating kube config: %w", err)
+	}
+	resources, err := r.resourceCache(kubeConfig)
+	if err != nil {
+		return nil, subject, err
+	}
+	authCli, err := authorizationv1.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, subject, fmt.Errorf("while creating authorization client: %w", err)
+	}
+
+	denied, err := accessreview.NewCommandReviewer(authCli, resources).Review(ctx, defaultNamespace, cmd)
+	return denied, subject, err
+}
+
+// resourceCache returns the resource cache, backed by the memory cached discovery client created on first use.
+func (r *kubeconfigAccessReviewer) resourceCache(kubeConfig *rest.Config) (*accessreview.ResourceCache, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.resources != nil {
+		return r.resources, nil
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("while creating discovery client: %w", err)
+	}
+	r.resources = accessreview.NewResourceCache(memory.NewMemCacheClient(discoveryClient))
+	return r.resources, nil
+}
+
+func deniedAccessMessage(cmd string, denied *accessreview.DeniedAccess, subject impersonationSubject) api.Message {
+	attrs := denied.Attributes
+
+	resource := attrs.Resource
+	if attrs.Group != "" {
+		resource = fmt.Sprintf("%s.%s", resource, attrs.Group)
+	}
+	if attrs.Subresource != "" {
+		resource = fmt.Sprintf("%s/%s", resource, attrs.Subresource)
+	}
+
+	textFields := api.TextFields{
+		{Key: "Verb", Value: formatx.AdaptiveCodeBlock(attrs.Verb)},
+		{Key: "Resource", Value: formatx.AdaptiveCodeBlock(resource)},
+	}
+	if attrs.Name != "" {
+		textFields = append(textFields, api.TextField{Key: "Name", Value: formatx.AdaptiveCodeBlock(attrs.Name)})
+	}
+	namespace := attrs.Namespace
+	if namespace == "" {
+		namespace = "all namespaces / cluster-wide"
+	}
+	textFields = append(textFields, api.TextField{Key: "Namespace", Value: formatx.AdaptiveCodeBlock(namespace)})
+	if subject.User != "" {
+		textFields = append(textFields, api.TextField{Key: "User", Value: formatx.AdaptiveCodeBlock(subject.User)})
+	}
+	if len(subject.Groups) > 0 {
+		textFields = append(textFields, api.TextField{Key: "Groups", Value: formatx.AdaptiveCodeBlock(strings.Join(subject.Groups, ", "))})
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header:      ":exclamation: Missing permissions",
+			Description: fmt.Sprintf("You don't have enough permissions to run `%s %s`. The identity is configured with the plugin `context.rbac` policy rule.", PluginName, cmd),
+		},
+		TextFields: textFields,
+	}
+	if denied.Reason != "" {
+		section.Body.Plaintext = fmt.Sprintf("Reason: %s", denied.Reason)
+	}
+
+	canICmd := fmt.Sprintf("%s auth can-i --list", PluginName)
+	if attrs.Namespace != "" {
+		canICmd = fmt.Sprintf("%s -n %s", canICmd, attrs.Namespace)
+	}
+	section.Buttons = api.Buttons{
+		api.NewMessageButtonBuilder().ForCommandWithoutDesc("Show my permissions", canICmd),
+	}
+
+	return api.Message{
+		Sections: []api.Section{section},
+	}
+}
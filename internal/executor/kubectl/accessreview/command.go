@@ -0,0 +1,357 @@
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	v1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+
+	"github.com/kubeshop/botkube/internal/command"
+)
+
+// verbSpec describes how a given kubectl verb is translated into the Kubernetes API verb.
+type verbSpec struct {
+	// verb is the Kubernetes API verb used when the object name is specified.
+	verb string
+	// listVerb is the Kubernetes API verb used when the object name is not specified. If empty, verb is used.
+	listVerb    string
+	subresource string
+	// defaultResource is used by commands which accept only the object name, such as `kubectl logs nginx`.
+	defaultResource string
+	// subcommands holds verbs for commands which have nested subcommands, such as `kubectl set image`.
+	subcommands map[string]verbSpec
+}
+
+// kubectlVerbs holds kubectl verbs which can be translated into Kubernetes API verbs. Other commands are not reviewed.
+var kubectlVerbs = map[string]verbSpec{
+	"get":      {verb: "get", listVerb: "list"},
+	"describe": {verb: "get", listVerb: "list"},
+	"delete":   {verb: "delete"},
+	"patch":    {verb: "patch"},
+	"label":    {verb: "patch"},
+	"annotate": {verb: "patch"},
+	"taint":    {verb: "patch"},
+	"scale":    {verb: "patch", subresource: "scale"},
+	"logs":     {verb: "get", subresource: "log", defaultResource: "pods"},
+	"exec":     {verb: "create", subresource: "exec", defaultResource: "pods"},
+	"cordon":   {verb: "patch", defaultResource: "nodes"},
+	"uncordon": {verb: "patch", defaultResource: "nodes"},
+	"drain":    {verb: "patch", defaultResource: "nodes"},
+	"set": {subcommands: map[string]verbSpec{
+		"image":          {verb: "patch"},
+		"env":            {verb: "patch"},
+		"resources":      {verb: "patch"},
+		"selector":       {verb: "patch"},
+		"serviceaccount": {verb: "patch"},
+	}},
+	"rollout": {subcommands: map[string]verbSpec{
+		"restart": {verb: "patch"},
+		"pause":   {verb: "patch"},
+		"resume":  {verb: "patch"},
+		"undo":    {verb: "patch"},
+		"status":  {verb: "get"},
+		"history": {verb: "get"},
+	}},
+}
+
+// DeniedAccess describes a denied access to a Kubernetes resource.
+type DeniedAccess struct {
+	Attributes authv1.ResourceAttributes
+	Reason     string
+}
+
+// CommandReviewer checks if the kubectl command can be executed before running it.
+type CommandReviewer struct {
+	cli       v1.AuthorizationV1Interface
+	resources *ResourceCache
+}
+
+// NewCommandReviewer returns a new CommandReviewer instance. The resource cache can be shared between reviewers.
+func NewCommandReviewer(cli v1.AuthorizationV1Interface, resources *ResourceCache) *CommandReviewer {
+	return &CommandReviewer{
+		cli:       cli,
+		resources: resources,
+	}
+}
+
+// Review checks if a given kubectl command is allowed for the current identity.
+// Returns nil if the access is allowed, or the command cannot be translated into resource attributes,
+// as in such case the kubectl itself reports the missing permissions.
+func (r *CommandReviewer) Review(ctx context.Context, defaultNamespace, cmd string) (*DeniedAccess, error) {
+	attrs, err := r.resourceAttributes(defaultNamespace, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attr := range attrs {
+		attr := attr
+		review := authv1.SelfSubjectAccessReview{
+			Spec: authv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &attr,
+			},
+		}
+		out, err := r.cli.SelfSubjectAccessReviews().Create(ctx, &review, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("while creating access review: %w", err)
+		}
+
+		if !out.Status.Allowed {
+			return &DeniedAccess{
+				Attributes: attr,
+				Reason:     out.Status.Reason,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *CommandReviewer) resourceAttributes(defaultNamespace, cmd string) ([]authv1.ResourceAttributes, error) {
+	in, err := parseCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if in.hasFilename || len(in.args) == 0 {
+		return nil, nil
+	}
+
+	spec, found := kubectlVerbs[in.args[0]]
+	if !found {
+		return nil, nil
+	}
+	args := in.args[1:]
+	if spec.subcommands != nil {
+		if len(args) == 0 {
+			return nil, nil
+		}
+		spec, found = spec.subcommands[args[0]]
+		if !found {
+			return nil, nil
+		}
+		args = args[1:]
+	}
+
+	types, name := resourceTypesAndName(spec, args)
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	var out []authv1.ResourceAttributes
+	for _, resType := range types {
+		res, found, err := r.resources.Get(strings.ToLower(resType))
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			// unknown resource, kubectl will report it
+			return nil, nil
+		}
+		if spec.defaultResource != "" && res.gvr.Resource != spec.defaultResource {
+			// e.g. `kubectl logs deploy/nginx`, which reads logs of the selected Pod
+			return nil, nil
+		}
+
+		verb := spec.verb
+		if name == "" && spec.listVerb != "" {
+			verb = spec.listVerb
+		}
+
+		ns := in.namespace
+		switch {
+		case !res.namespaced, in.allNamespaces:
+			ns = ""
+		case ns == "":
+			ns = defaultNamespace
+		}
+
+		out = append(out, authv1.ResourceAttributes{
+			Namespace:   ns,
+			Verb:        verb,
+			Group:       res.gvr.Group,
+			Resource:    res.gvr.Resource,
+			Subresource: spec.subresource,
+			Name:        name,
+		})
+	}
+
+	return out, nil
+}
+
+type resourceInfo struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// resourceCacheRefreshInterval is the minimum interval between refreshes of the resource cache triggered by unknown resources.
+const resourceCacheRefreshInterval = time.Minute
+
+// ResourceCache caches cluster resources, so they are not discovered for each reviewed command.
+// The cache is refreshed when a given resource is not found, e.g. after a new CRD is installed.
+type ResourceCache struct {
+	discoveryCli command.K8sDiscoveryInterface
+	now          func() time.Time
+
+	mu          sync.Mutex
+	resources   map[string]resourceInfo
+	refreshedAt time.Time
+}
+
+// NewResourceCache returns a new ResourceCache instance.
+func NewResourceCache(discoveryCli command.K8sDiscoveryInterface) *ResourceCache {
+	return &ResourceCache{
+		discoveryCli: discoveryCli,
+		now:          time.Now,
+	}
+}
+
+// Get returns a resource with a given name. See resourceMap for the supported names.
+func (c *ResourceCache) Get(name string) (resourceInfo, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.resources != nil {
+		if res, found := c.resources[name]; found {
+			return res, true, nil
+		}
+		if c.now().Sub(c.refreshedAt) < resourceCacheRefreshInterval {
+			return resourceInfo{}, false, nil
+		}
+		if cached, ok := c.discoveryCli.(discovery.CachedDiscoveryInterface); ok {
+			cached.Invalidate()
+		}
+	}
+
+	resources, err := c.resourceMap()
+	if err != nil {
+		return resourceInfo{}, false, err
+	}
+	c.resources = resources
+	c.refreshedAt = c.now()
+
+	res, found := c.resources[name]
+	return res, found, nil
+}
+
+// resourceMap returns resources indexed by all names which can be used in kubectl commands,
+// e.g. "deployments", "deployment", "deploy", and "deployments.apps".
+func (c *ResourceCache) resourceMap() (map[string]resourceInfo, error) {
+	resList, err := c.discoveryCli.ServerPreferredResources()
+	if err != nil && len(resList) == 0 {
+		return nil, fmt.Errorf("while getting resource list from K8s cluster: %w", err)
+	}
+
+	out := map[string]resourceInfo{}
+	for _, item := range resList {
+		gv, err := schema.ParseGroupVersion(item.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, res := range item.APIResources {
+			if strings.Contains(res.Name, "/") {
+				// subresources are not used directly in kubectl commands
+				continue
+			}
+
+			info := resourceInfo{
+				gvr:        gv.WithResource(res.Name),
+				namespaced: res.Namespaced,
+			}
+
+			names := append([]string{res.Name, res.SingularName, strings.ToLower(res.Kind)}, res.ShortNames...)
+			for _, name := range names {
+				if name == "" {
+					continue
+				}
+				if gv.Group != "" {
+					out[fmt.Sprintf("%s.%s", name, gv.Group)] = info
+				}
+				if _, exists := out[name]; !exists {
+					out[name] = info
+				}
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// resourceTypesAndName extracts resource types and the object name from args, such as
+// "pods nginx", "pods/nginx", "pods,services", or "nginx" for commands with default resource.
+func resourceTypesAndName(spec verbSpec, args []string) ([]string, string) {
+	if len(args) == 0 {
+		return nil, ""
+	}
+
+	if resType, name, found := strings.Cut(args[0], "/"); found {
+		return []string{resType}, name
+	}
+
+	if spec.defaultResource != "" {
+		return []string{spec.defaultResource}, args[0]
+	}
+
+	var name string
+	// multiple names are reviewed on the resource level
+	if len(args) == 2 {
+		name = args[1]
+	}
+	return strings.Split(args[0], ","), name
+}
+
+type parsedCommand struct {
+	args          []string
+	namespace     string
+	allNamespaces bool
+	hasFilename   bool
+}
+
+func parseCommand(cmd string) (parsedCommand, error) {
+	var out parsedCommand
+	newFlagSet := func(isLogs bool) (*pflag.FlagSet, *[]string) {
+		f := pflag.NewFlagSet("access-review", pflag.ContinueOnError)
+		f.BoolP("help", "h", false, "to make sure that parsing is ignoring the --help,-h flags as there are specially process by pflag")
+		f.ParseErrorsWhitelist.UnknownFlags = true
+
+		f.StringVarP(&out.namespace, "namespace", "n", "", "Kubernetes Namespace")
+		f.BoolVarP(&out.allNamespaces, "all-namespaces", "A", false, "Kubernetes All Namespaces")
+		f.BoolP("stdin", "i", false, "Pass stdin to the container")
+		f.BoolP("tty", "t", false, "Stdin is a TTY")
+		f.BoolP("watch", "w", false, "Watch for changes")
+		f.Bool("all", false, "Select all resources")
+		f.Bool("force", false, "Force operation")
+		f.Bool("overwrite", false, "Overwrite existing values")
+
+		var filenames []string
+		if isLogs {
+			f.BoolP("follow", "f", false, "Stream the logs")
+		} else {
+			f.StringSliceVarP(&filenames, "filename", "f", nil, "Files that contain the resources")
+			f.StringSliceP("kustomize", "k", nil, "Kustomization directory")
+		}
+		return f, &filenames
+	}
+
+	// `-f` has different meaning for logs, so the verb needs to be known first
+	f, _ := newFlagSet(false)
+	if err := f.Parse(strings.Fields(cmd)); err != nil {
+		return parsedCommand{}, fmt.Errorf("while parsing args: %w", err)
+	}
+	isLogs := len(f.Args()) > 0 && f.Args()[0] == "logs"
+
+	f, filenames := newFlagSet(isLogs)
+	if err := f.Parse(strings.Fields(cmd)); err != nil {
+		return parsedCommand{}, fmt.Errorf("while parsing args: %w", err)
+	}
+
+	out.args = f.Args()
+	out.hasFilename = len(*filenames) > 0 || f.Changed("kustomize")
+	return out, nil
+}
@@ -0,0 +1,195 @@
+package accessreview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCommandReviewerResourceAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      string
+		expAttrs []authv1.ResourceAttributes
+	}{
+		{
+			name: "get single object",
+			cmd:  "get po nginx",
+			expAttrs: []authv1.ResourceAttributes{
+				{Namespace: "default", Verb: "get", Resource: "pods", Name: "nginx"},
+			},
+		},
+		{
+			name: "list multiple resources in all namespaces",
+			cmd:  "get deploy,svc -A",
+			expAttrs: []authv1.ResourceAttributes{
+				{Verb: "list", Group: "apps", Resource: "deployments"},
+				{Verb: "list", Resource: "services"},
+			},
+		},
+		{
+			name: "scale with slash separated name",
+			cmd:  "-n prod scale deployments.apps/nginx --replicas 3",
+			expAttrs: []authv1.ResourceAttributes{
+				{Namespace: "prod", Verb: "patch", Group: "apps", Resource: "deployments", Subresource: "scale", Name: "nginx"},
+			},
+		},
+		{
+			name: "logs with default resource",
+			cmd:  "logs nginx -f -c app",
+			expAttrs: []authv1.ResourceAttributes{
+				{Namespace: "default", Verb: "get", Resource: "pods", Subresource: "log", Name: "nginx"},
+			},
+		},
+		{
+			name: "exec with interactive flags",
+			cmd:  "exec -it nginx -n test -- sh",
+			expAttrs: []authv1.ResourceAttributes{
+				{Namespace: "test", Verb: "create", Resource: "pods", Subresource: "exec", Name: "nginx"},
+			},
+		},
+		{
+			name: "subcommand",
+			cmd:  "set image deployment/nginx nginx=nginx:1.25",
+			expAttrs: []authv1.ResourceAttributes{
+				{Namespace: "default", Verb: "patch", Group: "apps", Resource: "deployments", Name: "nginx"},
+			},
+		},
+		{
+			name: "cluster-scoped resource",
+			cmd:  "cordon node-1",
+			expAttrs: []authv1.ResourceAttributes{
+				{Verb: "patch", Resource: "nodes", Name: "node-1"},
+			},
+		},
+		{
+			name: "logs for other resource are not reviewed",
+			cmd:  "logs deploy/nginx",
+		},
+		{
+			name: "commands with files are not reviewed",
+			cmd:  "delete -f manifest.yaml",
+		},
+		{
+			name: "unknown resources are not reviewed",
+			cmd:  "get foos",
+		},
+		{
+			name: "unknown commands are not reviewed",
+			cmd:  "api-resources",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			reviewer := NewCommandReviewer(fake.NewSimpleClientset().AuthorizationV1(), NewResourceCache(&fakeDiscovery{}))
+
+			// when
+			out, err := reviewer.resourceAttributes("default", tc.cmd)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expAttrs, out)
+		})
+	}
+}
+
+func TestCommandReviewerReview(t *testing.T) {
+	// given
+	cli := fake.NewSimpleClientset()
+	cli.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		review.Status = authv1.SubjectAccessReviewStatus{
+			Allowed: attrs.Resource != "services",
+			Reason:  "RBAC: access denied",
+		}
+		return true, review, nil
+	})
+	reviewer := NewCommandReviewer(cli.AuthorizationV1(), NewResourceCache(&fakeDiscovery{}))
+
+	// when
+	out, err := reviewer.Review(context.Background(), "default", "get pods,services")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &DeniedAccess{
+		Attributes: authv1.ResourceAttributes{Namespace: "default", Verb: "list", Resource: "services"},
+		Reason:     "RBAC: access denied",
+	}, out)
+
+	// when
+	out, err = reviewer.Review(context.Background(), "default", "get pods")
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestResourceCache(t *testing.T) {
+	// given
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	discoveryCli := &fakeDiscovery{}
+	cache := NewResourceCache(discoveryCli)
+	cache.now = func() time.Time { return now }
+
+	// when
+	for _, name := range []string{"pods", "deploy", "deployments.apps"} {
+		_, found, err := cache.Get(name)
+		require.NoError(t, err)
+		assert.True(t, found)
+	}
+
+	// then
+	assert.Equal(t, 1, discoveryCli.calls)
+
+	// when an unknown resource is requested right after the refresh
+	_, found, err := cache.Get("foos")
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, 1, discoveryCli.calls)
+
+	// when
+	now = now.Add(resourceCacheRefreshInterval)
+	_, found, err = cache.Get("foos")
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, 2, discoveryCli.calls)
+}
+
+type fakeDiscovery struct {
+	calls int
+}
+
+func (f *fakeDiscovery) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	f.calls++
+	return []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", SingularName: "pod", Kind: "Pod", ShortNames: []string{"po"}, Namespaced: true},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true},
+				{Name: "services", SingularName: "service", Kind: "Service", ShortNames: []string{"svc"}, Namespaced: true},
+				{Name: "nodes", SingularName: "node", Kind: "Node", ShortNames: []string{"no"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", SingularName: "deployment", Kind: "Deployment", ShortNames: []string{"deploy"}, Namespaced: true},
+			},
+		},
+	}, nil
+}
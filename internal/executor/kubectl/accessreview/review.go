@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	authv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,12 +37,17 @@ func (c *K8sAuth) CheckUserAccess(ns, verb, resource, name string) error {
 		verb = "get"
 		subresource = "log"
 	}
+
+	// fully qualified resources, such as "applications.argoproj.io", need to be split into resource and API group
+	resource, group, _ := strings.Cut(resource, ".")
+
 	ctx := context.Background()
 	review := authv1.SelfSubjectAccessReview{
 		Spec: authv1.SelfSubjectAccessReviewSpec{
 			ResourceAttributes: &authv1.ResourceAttributes{
 				Namespace:   ns,
 				Verb:        verb,
+				Group:       group,
 				Resource:    resource,
 				Subresource: subresource,
 				Name:        name,
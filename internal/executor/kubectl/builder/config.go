@@ -9,10 +9,20 @@ type (
 	AllowedResources struct {
 		// Namespaces if not specified, builder needs to have proper permissions to list all namespaces in the cluster.
 		Namespaces []string `yaml:"namespaces,omitempty"`
+		// NamespaceLabelSelector selects additional namespaces by labels, e.g. "team=payments".
+		NamespaceLabelSelector string `yaml:"namespaceLabelSelector,omitempty"`
 		// Verbs holds allowed verbs, at least one verbs MUST be specified.
 		Verbs []string `yaml:"verbs,omitempty"`
 		// Resources holds allowed resources.
 		Resources []string `yaml:"resources,omitempty"`
+		// CustomResources holds configuration for custom resources discovery.
+		CustomResources CustomResources `yaml:"customResources,omitempty"`
+	}
+	// CustomResources describes which custom resources served by the cluster are added to the resources dropdown.
+	CustomResources struct {
+		Enabled bool `yaml:"enabled"`
+		// Groups narrows down discovered resources to given API groups. If not specified, all custom resources are added.
+		Groups []string `yaml:"groups,omitempty"`
 	}
 )
 
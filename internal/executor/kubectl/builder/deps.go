@@ -25,6 +25,7 @@ type (
 		GetAllowedResourcesForVerb(verb string, allConfiguredResources []string) ([]command.Resource, error)
 		GetResourceDetails(verb, resourceType string) (command.Resource, error)
 		FilterSupportedVerbs(allVerbs []string) []string
+		GetServerResourceMap() (map[string]metav1.APIResource, error)
 	}
 
 	// AuthChecker provides an option to check if we can run a kubectl commands with a given permission.
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubeshop/botkube/internal/command"
@@ -25,6 +29,7 @@ const (
 	verbsDropdownCommand             = "@builder --verbs"
 	resourceTypesDropdownCommand     = "@builder --resource-type"
 	resourceNamesDropdownCommand     = "@builder --resource-name"
+	resourceNamesPageDropdownCommand = "@builder --resource-names-page"
 	resourceNamespaceDropdownCommand = "@builder --namespace"
 	filterPlaintextInputCommand      = "@builder --filter-query"
 	kubectlCommandName               = "kubectl"
@@ -85,6 +90,7 @@ func (e *Kubectl) Handle(ctx context.Context, cmd string, isInteractivitySupport
 		return e.initialMessage(allVerbs)
 	}
 	cmd = fmt.Sprintf("%s %s", args[0], args[1])
+	allTypes = e.appendCustomResources(allTypes)
 
 	stateDetails := e.extractStateDetails(state)
 	if stateDetails.namespace == "" {
@@ -106,6 +112,7 @@ func (e *Kubectl) Handle(ctx context.Context, cmd string, isInteractivitySupport
 		resourceTypesDropdownCommand: func() (api.Message, error) {
 			// the resource type was selected, so clear resource name from command preview.
 			stateDetails.resourceName = ""
+			stateDetails.page = ""
 			e.log.Info("Selecting resource type")
 			return e.renderMessage(ctx, stateDetails, allVerbs, allTypes)
 		},
@@ -114,10 +121,15 @@ func (e *Kubectl) Handle(ctx context.Context, cmd string, isInteractivitySupport
 			// it in command preview.
 			return e.renderMessage(ctx, stateDetails, allVerbs, allTypes)
 		},
+		resourceNamesPageDropdownCommand: func() (api.Message, error) {
+			// the resource name is cleared only if it's not on the selected page.
+			return e.renderMessage(ctx, stateDetails, allVerbs, allTypes)
+		},
 		resourceNamespaceDropdownCommand: func() (api.Message, error) {
 			// when the namespace was changed, there is a small chance that resource name will be still matching,
 			// we will need to do the external call to check that. For now, we clear resource name from command preview.
 			stateDetails.resourceName = ""
+			stateDetails.page = ""
 			return e.renderMessage(ctx, stateDetails, allVerbs, allTypes)
 		},
 		filterPlaintextInputCommand: func() (api.Message, error) {
@@ -250,8 +262,8 @@ func (e *Kubectl) renderMessage(ctx context.Context, stateDetails stateDetails,
 	//   1. Verb requires resource types
 	//   2. Selected resource type is still valid for the selected verb
 	var (
-		resNames = e.tryToGetResourceNamesSelect(ctx, stateDetails)
-		nsNames  = e.tryToGetNamespaceSelect(ctx, stateDetails)
+		resNames, resPages = e.tryToGetResourceNamesSelect(ctx, stateDetails)
+		nsNames            = e.tryToGetNamespaceSelect(ctx, stateDetails)
 	)
 
 	// 4. If a given resource name is not on the list anymore, clear it.
@@ -268,16 +280,18 @@ func (e *Kubectl) renderMessage(ctx context.Context, stateDetails stateDetails,
 	preview := e.buildCommandPreview(stateDetails)
 	return KubectlCmdBuilderMessage(
 		stateDetails.dropdownsBlockID, *allVerbsSelect,
-		WithAdditionalSelects(matchingTypes, resNames, nsNames),
+		WithAdditionalSelects(matchingTypes, resNames, resPages, nsNames),
 		WithAdditionalSections(preview...),
 	), nil
 }
 
-func (e *Kubectl) tryToGetResourceNamesSelect(ctx context.Context, state stateDetails) *api.Select {
+// tryToGetResourceNamesSelect returns resource names dropdown. If there are more names than the dropdown can hold,
+// names are paginated and the page dropdown is returned as well.
+func (e *Kubectl) tryToGetResourceNamesSelect(ctx context.Context, state stateDetails) (*api.Select, *api.Select) {
 	e.log.Info("Get resource names")
 	if state.resourceType == "" {
 		e.log.Info("Return empty resource name")
-		return EmptyResourceNameDropdown()
+		return EmptyResourceNameDropdown(), nil
 	}
 	cmd := fmt.Sprintf(`get %s --ignore-not-found=true -o go-template='{{range .items}}{{.metadata.name}}{{"\n"}}{{end}}'`, state.resourceType)
 	if state.namespace != "" {
@@ -288,15 +302,52 @@ func (e *Kubectl) tryToGetResourceNamesSelect(ctx context.Context, state stateDe
 	out, err := e.kcRunner.RunKubectlCommand(ctx, e.defaultNamespace, cmd)
 	if err != nil {
 		e.log.WithField("error", err.Error()).Error("Cannot fetch resource names. Returning empty resource name dropdown.")
-		return EmptyResourceNameDropdown()
+		return EmptyResourceNameDropdown(), nil
 	}
 
 	lines := getNonEmptyLines(out)
 	if len(lines) == 0 {
-		return EmptyResourceNameDropdown()
+		return EmptyResourceNameDropdown(), nil
+	}
+
+	if len(lines) <= dropdownItemsLimit {
+		return ResourceNamesSelect(overflowSentence(lines), state.resourceName), nil
+	}
+
+	// page dropdown is also limited, so the remaining names can be selected only by typing the full command
+	pagesCount := (len(lines) + dropdownItemsLimit - 1) / dropdownItemsLimit
+	if pagesCount > dropdownItemsLimit {
+		pagesCount = dropdownItemsLimit
+	}
+	page, err := strconv.Atoi(state.page)
+	if err != nil || page < 1 || page > pagesCount {
+		page = 1
+	}
+
+	var (
+		pages       []dropdownItem
+		initialPage dropdownItem
+	)
+	for i := 1; i <= pagesCount; i++ {
+		start, end := pageBounds(i, len(lines))
+		item := newDropdownItem(fmt.Sprintf("%d-%d of %d", start+1, end, len(lines)), strconv.Itoa(i))
+		if i == page {
+			initialPage = item
+		}
+		pages = append(pages, item)
 	}
 
-	return ResourceNamesSelect(overflowSentence(lines), state.resourceName)
+	start, end := pageBounds(page, len(lines))
+	return ResourceNamesSelect(overflowSentence(lines[start:end]), state.resourceName), ResourceNamesPageSelect(pages, initialPage)
+}
+
+// pageBounds returns start and end indexes of a given 1-based page.
+func pageBounds(page, total int) (int, int) {
+	start, end := (page-1)*dropdownItemsLimit, page*dropdownItemsLimit
+	if end > total {
+		end = total
+	}
+	return start, end
 }
 
 func (e *Kubectl) tryToGetNamespaceSelect(ctx context.Context, details stateDetails) *api.Select {
@@ -335,22 +386,27 @@ func (e *Kubectl) tryToGetNamespaceSelect(ctx context.Context, details stateDeta
 
 func (e *Kubectl) collectAdditionalNamespaces(ctx context.Context) []string {
 	// if preconfigured, use specified those Namespaces
-	if len(e.cfg.Allowed.Namespaces) > 0 {
+	selector := e.cfg.Allowed.NamespaceLabelSelector
+	if len(e.cfg.Allowed.Namespaces) > 0 && selector == "" {
 		return e.cfg.Allowed.Namespaces
 	}
 
-	// user didn't narrow down the namespace dropdown, so let's try to get all namespaces.
-	allClusterNamespaces, err := e.namespaceLister.List(ctx, metav1.ListOptions{
-		Limit: dropdownItemsLimit,
+	// user didn't narrow down the namespace dropdown, or selected them by labels, so let's try to get matching namespaces.
+	clusterNamespaces, err := e.namespaceLister.List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+		Limit:         dropdownItemsLimit,
 	})
 	if err != nil {
-		e.log.WithField("error", err.Error()).Error("Cannot fetch all available Kubernetes namespaces, ignoring namespace dropdown...")
-		// we cannot fetch other namespaces, so let's render only the default one.
-		return nil
+		e.log.WithField("error", err.Error()).Error("Cannot fetch available Kubernetes namespaces, using only preconfigured ones...")
+		// we cannot fetch other namespaces, so let's render only the preconfigured and the default one.
+		return e.cfg.Allowed.Namespaces
 	}
 
-	var out []string
-	for _, item := range allClusterNamespaces.Items {
+	out := append([]string{}, e.cfg.Allowed.Namespaces...)
+	for _, item := range clusterNamespaces.Items {
+		if slices.Contains(out, item.Name) {
+			continue
+		}
 		out = append(out, item.Name)
 	}
 
@@ -372,12 +428,83 @@ func (e *Kubectl) getAllowedResourcesSelectList(verb string, resources []string,
 		return nil, err
 	}
 
-	allowedResourcesList := make([]string, 0, len(allowedResources))
+	// resources configured with fully qualified names, such as custom resources, must keep them in commands
+	// as the plural name might be ambiguous.
+	qualifiedNames := map[string]struct{}{}
+	for _, name := range resources {
+		if strings.Contains(name, ".") {
+			qualifiedNames[name] = struct{}{}
+		}
+	}
+
+	itemsByGroup := map[string][]dropdownItem{}
 	for _, item := range allowedResources {
-		allowedResourcesList = append(allowedResourcesList, item.Name)
+		value := item.Name
+		if _, found := qualifiedNames[fmt.Sprintf("%s.%s", item.Name, item.Group)]; found {
+			value = fmt.Sprintf("%s.%s", item.Name, item.Group)
+		}
+		itemsByGroup[item.Group] = append(itemsByGroup[item.Group], newDropdownItem(item.Name, value))
 	}
 
-	return ResourceTypeSelect(allowedResourcesList, resourceType), nil
+	groupNames := maps.Keys(itemsByGroup)
+	sort.Strings(groupNames) // core group is empty, so it's always first
+
+	groups := make([]dropdownGroup, 0, len(groupNames))
+	for _, name := range groupNames {
+		displayName := name
+		if displayName == "" {
+			displayName = "core"
+		}
+		groups = append(groups, dropdownGroup{Name: displayName, Items: itemsByGroup[name]})
+	}
+
+	return ResourceTypeSelect(groups, resourceType), nil
+}
+
+// appendCustomResources appends custom resources served by the cluster to a given resources list.
+// Custom resources are added with fully qualified names, e.g. "applications.argoproj.io".
+func (e *Kubectl) appendCustomResources(resources []string) []string {
+	crCfg := e.cfg.Allowed.CustomResources
+	if !crCfg.Enabled {
+		return resources
+	}
+
+	resMap, err := e.commandGuard.GetServerResourceMap()
+	if err != nil {
+		e.log.WithField("error", err.Error()).Error("Cannot discover custom resources. Using only configured resources.")
+		return resources
+	}
+
+	var discovered []string
+	for name, res := range resMap {
+		if name != fmt.Sprintf("%s.%s", res.Name, res.Group) {
+			// each resource is also indexed by its plural name, use only the fully qualified one
+			continue
+		}
+
+		switch {
+		case len(crCfg.Groups) > 0:
+			if !slices.Contains(crCfg.Groups, res.Group) {
+				continue
+			}
+		case !isCustomResourceGroup(res.Group):
+			continue
+		}
+
+		if slices.Contains(resources, name) {
+			continue
+		}
+		discovered = append(discovered, name)
+	}
+	sort.Strings(discovered)
+
+	return append(append([]string{}, resources...), discovered...)
+}
+
+// isCustomResourceGroup returns true for API groups which are not built into Kubernetes.
+// Custom resource groups must contain a dot, and the "*.k8s.io" groups are reserved for Kubernetes.
+func isCustomResourceGroup(group string) bool {
+	return strings.Contains(group, ".") && !strings.HasSuffix(group, ".k8s.io")
 }
 
 type stateDetails struct {
@@ -387,6 +514,7 @@ type stateDetails struct {
 	namespace    string
 	resourceType string
 	resourceName string
+	page         string
 	filter       string
 }
 
@@ -410,6 +538,8 @@ func (e *Kubectl) extractStateDetails(state *slack.BlockActionStates) stateDetai
 				details.resourceType = act.SelectedOption.Value
 			case resourceNamesDropdownCommand:
 				details.resourceName = act.SelectedOption.Value
+			case resourceNamesPageDropdownCommand:
+				details.page = act.SelectedOption.Value
 			case resourceNamespaceDropdownCommand:
 				details.namespace = act.SelectedOption.Value
 			case filterPlaintextInputCommand:
@@ -21,6 +21,12 @@ type (
 		Name  string
 		Value string
 	}
+
+	// dropdownGroup describes the group of dropdown items.
+	dropdownGroup struct {
+		Name  string
+		Items []dropdownItem
+	}
 )
 
 // newDropdownItem returns the dropdownItem instance.
@@ -141,9 +147,55 @@ func VerbSelect(verbs []string, initialItem string) *api.Select {
 	return selectDropdown("Select command", verbsDropdownCommand, dropdownItemsFromSlice(verbs), newDropdownItem(initialItem, initialItem))
 }
 
-// ResourceTypeSelect return drop-down select for kubectl resources types.
-func ResourceTypeSelect(resources []string, initialItem string) *api.Select {
-	return selectDropdown("Select resource", resourceTypesDropdownCommand, dropdownItemsFromSlice(resources), newDropdownItem(initialItem, initialItem))
+// ResourceTypeSelect return drop-down select for kubectl resources types grouped by API groups.
+// If there is only one group, items are not grouped.
+func ResourceTypeSelect(groups []dropdownGroup, initialValue string) *api.Select {
+	const name = "Select resource"
+
+	var (
+		opts          []api.OptionGroup
+		initialOption *api.OptionItem
+		total         int
+	)
+	for _, group := range groups {
+		groupName := group.Name
+		if len(groups) == 1 {
+			groupName = name
+		}
+
+		optGroup := api.OptionGroup{Name: groupName}
+		for _, item := range group.Items {
+			if item.Value == "" || item.Name == "" || total >= dropdownItemsLimit {
+				continue
+			}
+			total++
+
+			opt := api.OptionItem{
+				Name:  item.Name,
+				Value: item.Value,
+			}
+			if item.Value == initialValue {
+				initialOption = &api.OptionItem{Name: item.Name, Value: item.Value}
+			}
+			optGroup.Options = append(optGroup.Options, opt)
+		}
+
+		if len(optGroup.Options) == 0 {
+			continue
+		}
+		opts = append(opts, optGroup)
+	}
+
+	if len(opts) == 0 {
+		return nil
+	}
+
+	return &api.Select{
+		Name:          name,
+		Command:       fmt.Sprintf("%s %s %s", api.MessageBotNamePlaceholder, kubectlCommandName, resourceTypesDropdownCommand),
+		InitialOption: initialOption,
+		OptionGroups:  opts,
+	}
 }
 
 // ResourceNamesSelect return drop-down select for kubectl resources names.
@@ -151,6 +203,11 @@ func ResourceNamesSelect(names []string, initialItem string) *api.Select {
 	return selectDropdown("Select resource name", resourceNamesDropdownCommand, dropdownItemsFromSlice(names), newDropdownItem(initialItem, initialItem))
 }
 
+// ResourceNamesPageSelect return drop-down select for pages of kubectl resources names.
+func ResourceNamesPageSelect(pages []dropdownItem, initialPage dropdownItem) *api.Select {
+	return selectDropdown("Select page", resourceNamesPageDropdownCommand, pages, initialPage)
+}
+
 // ResourceNamespaceSelect return drop-down select for kubectl allowed namespaces.
 func ResourceNamespaceSelect(names []dropdownItem, initialNamespace dropdownItem) *api.Select {
 	return selectDropdown("Select namespace", resourceNamespaceDropdownCommand, names, initialNamespace)
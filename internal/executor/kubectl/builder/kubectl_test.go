@@ -3,6 +3,8 @@ package builder_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/slack-go/slack"
@@ -596,3 +598,216 @@ func (f *fakeErrCommandGuard) GetAllowedResourcesForVerb(string, []string) ([]co
 func (f *fakeErrCommandGuard) GetResourceDetails(string, string) (command.Resource, error) {
 	return command.Resource{}, f.fixErr
 }
+
+// GetServerResourceMap returns a map of all resources available on the server.
+func (f *fakeErrCommandGuard) GetServerResourceMap() (map[string]metav1.APIResource, error) {
+	return nil, f.fixErr
+}
+
+func TestCustomResourcesGroupedByAPIGroup(t *testing.T) {
+	// given
+	var (
+		state      = fixStateForResource("applications.argoproj.io", "")
+		kcExecutor = &fakeKcExecutor{}
+		guard      = &fakeDiscoveryCommandGuard{}
+	)
+
+	kcCmdBuilder := builder.NewKubectl(kcExecutor, builder.Config{
+		Allowed: builder.AllowedResources{
+			Verbs:     []string{"get"},
+			Resources: []string{"pods", "deployments"},
+			CustomResources: builder.CustomResources{
+				Enabled: true,
+			},
+		},
+	}, loggerx.NewNoop(), guard, "default", &fakeNamespaceLister{}, &fakeAuthChecker{})
+
+	// when
+	gotMsg, err := kcCmdBuilder.Handle(context.Background(), "@builder --resource-type", true, state)
+	gotMsg.ReplaceBotNamePlaceholder(testingBotName)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, gotMsg.Sections, 3)
+
+	resTypes := gotMsg.Sections[0].Selects.Items[1]
+	assert.Equal(t, &api.OptionItem{Name: "applications", Value: "applications.argoproj.io"}, resTypes.InitialOption)
+	assert.Equal(t, []api.OptionGroup{
+		{Name: "core", Options: []api.OptionItem{{Name: "pods", Value: "pods"}}},
+		{Name: "apps", Options: []api.OptionItem{{Name: "deployments", Value: "deployments"}}},
+		{Name: "argoproj.io", Options: []api.OptionItem{{Name: "applications", Value: "applications.argoproj.io"}}},
+	}, resTypes.OptionGroups)
+
+	assert.Equal(t, "kubectl get applications.argoproj.io -n default", gotMsg.Sections[1].Body.CodeBlock)
+	assert.True(t, strings.HasPrefix(kcExecutor.command, "get applications.argoproj.io "))
+}
+
+func TestResourceNamesPagination(t *testing.T) {
+	// given
+	var names []string
+	for i := 1; i <= 250; i++ {
+		names = append(names, fmt.Sprintf("pod-%d", i))
+	}
+	var (
+		state      = fixStateForResource("pods", "3")
+		kcExecutor = &fakeStaticKcExecutor{out: strings.Join(names, "\n")}
+	)
+
+	kcCmdBuilder := builder.NewKubectl(kcExecutor, builder.Config{
+		Allowed: builder.AllowedResources{
+			Verbs:     []string{"get"},
+			Resources: []string{"pods"},
+		},
+	}, loggerx.NewNoop(), kubectl.NewFakeCommandGuard(), "default", &fakeNamespaceLister{}, &fakeAuthChecker{})
+
+	// when
+	gotMsg, err := kcCmdBuilder.Handle(context.Background(), "@builder --resource-names-page", true, state)
+	gotMsg.ReplaceBotNamePlaceholder(testingBotName)
+
+	// then
+	require.NoError(t, err)
+	selects := gotMsg.Sections[0].Selects.Items
+	require.Len(t, selects, 5)
+
+	resNames := selects[2]
+	require.Len(t, resNames.OptionGroups, 1)
+	require.Len(t, resNames.OptionGroups[0].Options, 50)
+	assert.Equal(t, "pod-201", resNames.OptionGroups[0].Options[0].Value)
+	assert.Equal(t, "pod-250", resNames.OptionGroups[0].Options[49].Value)
+
+	pages := selects[3]
+	assert.Equal(t, "@BKTesting kubectl @builder --resource-names-page", pages.Command)
+	assert.Equal(t, &api.OptionItem{Name: "201-250 of 250", Value: "3"}, pages.InitialOption)
+	assert.Equal(t, []api.OptionItem{
+		{Name: "1-100 of 250", Value: "1"},
+		{Name: "101-200 of 250", Value: "2"},
+		{Name: "201-250 of 250", Value: "3"},
+	}, pages.OptionGroups[0].Options)
+}
+
+func TestNamespacesSelectedByLabels(t *testing.T) {
+	// given
+	var (
+		state    = fixStateForResource("pods", "")
+		nsLister = &fakeLabeledNamespaceLister{names: []string{"default", "payments-api", "payments-db"}}
+	)
+
+	kcCmdBuilder := builder.NewKubectl(&fakeKcExecutor{}, builder.Config{
+		Allowed: builder.AllowedResources{
+			Verbs:                  []string{"get"},
+			Resources:              []string{"pods"},
+			Namespaces:             []string{"default"},
+			NamespaceLabelSelector: "team=payments",
+		},
+	}, loggerx.NewNoop(), kubectl.NewFakeCommandGuard(), "default", nsLister, &fakeAuthChecker{})
+
+	// when
+	gotMsg, err := kcCmdBuilder.Handle(context.Background(), "@builder --namespace", true, state)
+	gotMsg.ReplaceBotNamePlaceholder(testingBotName)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "team=payments", nsLister.opts.LabelSelector)
+
+	selects := gotMsg.Sections[0].Selects.Items
+	require.Len(t, selects, 4)
+	assert.Equal(t, []api.OptionItem{
+		{Name: "default (namespace)", Value: "default"},
+		{Name: "payments-api", Value: "payments-api"},
+		{Name: "payments-db", Value: "payments-db"},
+	}, selects[3].OptionGroups[0].Options)
+}
+
+func fixStateForResource(resourceType, page string) *slack.BlockActionStates {
+	state := &slack.BlockActionStates{
+		Values: map[string]map[string]slack.BlockAction{
+			blockID: {
+				"kubectl @builder --resource-type": slack.BlockAction{
+					SelectedOption: slack.OptionBlockObject{
+						Value: resourceType,
+					},
+				},
+				"kubectl @builder --verbs": slack.BlockAction{
+					SelectedOption: slack.OptionBlockObject{
+						Value: "get",
+					},
+				},
+			},
+		},
+	}
+	if page != "" {
+		state.Values[blockID]["kubectl @builder --resource-names-page"] = slack.BlockAction{
+			SelectedOption: slack.OptionBlockObject{
+				Value: page,
+			},
+		}
+	}
+	return state
+}
+
+type fakeStaticKcExecutor struct {
+	out string
+}
+
+func (r *fakeStaticKcExecutor) RunKubectlCommand(context.Context, string, string) (string, error) {
+	return r.out, nil
+}
+
+type fakeLabeledNamespaceLister struct {
+	names []string
+	opts  metav1.ListOptions
+}
+
+func (f *fakeLabeledNamespaceLister) List(_ context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	f.opts = opts
+
+	out := &corev1.NamespaceList{}
+	for _, name := range f.names {
+		out.Items = append(out.Items, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return out, nil
+}
+
+// fakeDiscoveryCommandGuard serves built-in and custom resources.
+type fakeDiscoveryCommandGuard struct{}
+
+func (f *fakeDiscoveryCommandGuard) FilterSupportedVerbs(allVerbs []string) []string {
+	return allVerbs
+}
+
+func (f *fakeDiscoveryCommandGuard) GetAllowedResourcesForVerb(verb string, allConfiguredResources []string) ([]command.Resource, error) {
+	var out []command.Resource
+	for _, name := range allConfiguredResources {
+		res, err := f.GetResourceDetails(verb, name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, res)
+	}
+	return out, nil
+}
+
+func (f *fakeDiscoveryCommandGuard) GetResourceDetails(_, resourceType string) (command.Resource, error) {
+	resMap, _ := f.GetServerResourceMap()
+	res, found := resMap[resourceType]
+	if !found {
+		return command.Resource{}, command.ErrResourceNotFound
+	}
+	return command.Resource{Name: res.Name, Group: res.Group, Namespaced: res.Namespaced}, nil
+}
+
+func (f *fakeDiscoveryCommandGuard) GetServerResourceMap() (map[string]metav1.APIResource, error) {
+	pods := metav1.APIResource{Name: "pods", Version: "v1", Namespaced: true}
+	deployments := metav1.APIResource{Name: "deployments", Group: "apps", Version: "v1", Namespaced: true}
+	apps := metav1.APIResource{Name: "applications", Group: "argoproj.io", Version: "v1alpha1", Namespaced: true}
+	podMetrics := metav1.APIResource{Name: "pods", Group: "metrics.k8s.io", Version: "v1beta1", Namespaced: true}
+
+	return map[string]metav1.APIResource{
+		"pods":                     pods,
+		"deployments":              deployments,
+		"deployments.apps":         deployments,
+		"applications":             apps,
+		"applications.argoproj.io": apps,
+		"pods.metrics.k8s.io":      podMetrics,
+	}, nil
+}
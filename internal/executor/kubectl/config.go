@@ -5,6 +5,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/kubeshop/botkube/internal/executor/kubectl/builder"
 	"github.com/kubeshop/botkube/pkg/api"
@@ -15,14 +16,25 @@ import (
 
 // Config holds Kubectl plugin configuration parameters.
 type Config struct {
-	Log                config.Logger  `yaml:"log"`
-	DefaultNamespace   string         `yaml:"defaultNamespace,omitempty"`
-	InteractiveBuilder builder.Config `yaml:"interactiveBuilder,omitempty"`
+	Log                config.Logger      `yaml:"log"`
+	DefaultNamespace   string             `yaml:"defaultNamespace,omitempty"`
+	InteractiveBuilder builder.Config     `yaml:"interactiveBuilder,omitempty"`
+	Preview            PreviewConfig      `yaml:"preview,omitempty"`
+	AccessReview       AccessReviewConfig `yaml:"accessReview,omitempty"`
 }
 
 func (c Config) Validate() error {
-	if len(c.InteractiveBuilder.Allowed.Namespaces) > 0 {
-		found := slices.Contains(c.InteractiveBuilder.Allowed.Namespaces, c.DefaultNamespace)
+	allowed := c.InteractiveBuilder.Allowed
+	if allowed.NamespaceLabelSelector != "" {
+		if _, err := labels.Parse(allowed.NamespaceLabelSelector); err != nil {
+			return fmt.Errorf("while parsing namespace label selector: %w", err)
+		}
+		// namespaces are selected dynamically, so the default one cannot be validated upfront
+		return nil
+	}
+
+	if len(allowed.Namespaces) > 0 {
+		found := slices.Contains(allowed.Namespaces, c.DefaultNamespace)
 		if !found {
 			return fmt.Errorf("the %q namespace must be included under allowed namespaces property", c.DefaultNamespace)
 		}
@@ -35,6 +47,10 @@ func MergeConfigs(configs []*executor.Config) (Config, error) {
 	defaults := Config{
 		DefaultNamespace:   defaultNamespace,
 		InteractiveBuilder: builder.DefaultConfig(),
+		Preview:            DefaultPreviewConfig(),
+		AccessReview: AccessReviewConfig{
+			Enabled: true,
+		},
 	}
 
 	var out Config
@@ -161,11 +177,81 @@ func jsonSchema(description string) api.JSONSchema {
 						"type": "string",
 						"title": "Namespace"
 					  }
+					},
+					"namespaceLabelSelector": {
+					  "type": "string",
+					  "title": "Namespace label selector",
+					  "description": "Label selector for additional namespaces, such as \"team=payments\". Builder needs to have proper permissions to list namespaces in the cluster."
+					},
+					"customResources": {
+					  "type": "object",
+					  "title": "Custom resources",
+					  "description": "Configuration of custom resources discovery.",
+					  "properties": {
+						"enabled": {
+						  "type": "boolean",
+						  "title": "Enabled",
+						  "description": "If enabled, custom resources served by the cluster are added to the resources dropdown.",
+						  "default": false
+						},
+						"groups": {
+						  "type": "array",
+						  "title": "API groups",
+						  "description": "List of API groups of discovered resources. If not specified, all custom resources are added.",
+						  "default": [],
+						  "items": {
+							"type": "string",
+							"title": "API group"
+						  }
+						}
+					  }
 					}
 				  }
 				}
 			  }
 			},
+			"preview": {
+			  "title": "Dry-run preview",
+			  "description": "Configuration of the server-side dry-run preview displayed before running mutating commands. The preview is displayed only on platforms that support interactivity.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If enabled, the selected commands are executed only after confirming the dry-run preview.",
+				  "type": "boolean",
+				  "default": true
+				},
+				"verbs": {
+				  "type": "array",
+				  "title": "Verbs",
+				  "description": "Kubectl verbs for which the dry-run preview is displayed. Multi-word verbs, such as \"set image\", are supported.",
+				  "default": [
+					"apply",
+					"patch",
+					"scale",
+					"set image",
+					"delete"
+				  ],
+				  "items": {
+					"title": "Verb",
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"accessReview": {
+			  "title": "Access review",
+			  "description": "Configuration of the permissions check executed before running commands.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If enabled, commands are checked with the SelfSubjectAccessReview for the plugin identity, and missing permissions are explained instead of returning the raw kubectl error.",
+				  "type": "boolean",
+				  "default": true
+				}
+			  }
+			},
 			"log": {
 			  "title": "Logging",
 			  "description": "Logging configuration for the plugin.",
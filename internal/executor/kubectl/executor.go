@@ -47,15 +47,17 @@ type (
 
 // Executor provides functionality for running Helm CLI.
 type Executor struct {
-	pluginVersion string
-	kcRunner      kcRunner
+	pluginVersion  string
+	kcRunner       kcRunner
+	accessReviewer accessReviewer
 }
 
 // NewExecutor returns a new Executor instance.
 func NewExecutor(ver string, kcRunner kcRunner) *Executor {
 	return &Executor{
-		pluginVersion: ver,
-		kcRunner:      kcRunner,
+		pluginVersion:  ver,
+		kcRunner:       kcRunner,
+		accessReviewer: newKubeconfigAccessReviewer(),
 	}
 }
 
@@ -123,6 +125,45 @@ func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 		}, nil
 	}
 
+	cmd, isConfirmed := cutFlag(cmd, confirmFlag)
+	cmd, isCancelled := cutFlag(cmd, cancelFlag)
+	if isCancelled {
+		return executor.ExecuteOutput{
+			Message: api.Message{
+				ReplaceOriginal: true,
+				BaseBody: api.Body{
+					Plaintext: fmt.Sprintf("Execution of `%s %s` was cancelled.", PluginName, cmd),
+				},
+			},
+		}, nil
+	}
+
+	if cfg.AccessReview.Enabled {
+		msg, denied := e.reviewAccess(ctx, log, kubeConfigPath, cfg.DefaultNamespace, cmd)
+		if denied {
+			return executor.ExecuteOutput{
+				Message: msg,
+			}, nil
+		}
+	}
+
+	previewer := NewPreviewer(scopedKubectlRunner, cfg.Preview, cfg.DefaultNamespace)
+	shouldPreview, err := previewer.ShouldPreview(cmd)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while checking if command should be previewed: %w", err)
+	}
+	// the preview can be confirmed only with buttons, so on other platforms commands are executed directly.
+	// Approved commands were already confirmed by the approver, so they are executed directly too.
+	if shouldPreview && !isConfirmed && !in.Context.IsApproved && in.Context.IsInteractivitySupported {
+		msg, err := previewer.Preview(ctx, cmd)
+		if err != nil {
+			return executor.ExecuteOutput{}, fmt.Errorf("while rendering dry-run preview: %w", err)
+		}
+		return executor.ExecuteOutput{
+			Message: msg,
+		}, nil
+	}
+
 	out, err := scopedKubectlRunner.RunKubectlCommand(ctx, cfg.DefaultNamespace, cmd)
 	if err != nil {
 		return executor.ExecuteOutput{}, err
@@ -132,6 +173,21 @@ func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 	}, nil
 }
 
+// reviewAccess checks if the command is allowed for the plugin identity. As kubectl reports missing permissions anyway,
+// the command is executed if the access cannot be reviewed.
+func (e *Executor) reviewAccess(ctx context.Context, log logrus.FieldLogger, kubeConfigPath, defaultNamespace, cmd string) (api.Message, bool) {
+	denied, subject, err := e.accessReviewer.Review(ctx, kubeConfigPath, defaultNamespace, cmd)
+	if err != nil {
+		log.WithError(err).Warn("Cannot review access. Skipping access review...")
+		return api.Message{}, false
+	}
+	if denied == nil {
+		return api.Message{}, false
+	}
+
+	return deniedAccessMessage(cmd, denied, subject), true
+}
+
 // Help returns help message.
 func (*Executor) Help(context.Context) (api.Message, error) {
 	return api.NewCodeBlockMessage(help(), true), nil
@@ -2,12 +2,16 @@ package kubectl
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
 
+	"github.com/kubeshop/botkube/internal/executor/kubectl/accessreview"
+	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/executor"
 	"github.com/kubeshop/botkube/pkg/pluginx"
 )
@@ -178,3 +182,237 @@ func TestNotSupportedCommandsAndFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestPreviewMutatingCommands(t *testing.T) {
+	liveDeploy := heredoc.Doc(`
+		apiVersion: apps/v1
+		kind: Deployment
+		metadata:
+		  name: nginx
+		  namespace: default
+		  resourceVersion: "100"
+		spec:
+		  replicas: 1
+		status:
+		  replicas: 1`)
+	dryRunDeploy := heredoc.Doc(`
+		apiVersion: apps/v1
+		kind: Deployment
+		metadata:
+		  name: nginx
+		  namespace: default
+		  resourceVersion: "101"
+		spec:
+		  replicas: 3
+		status:
+		  replicas: 1`)
+
+	tests := []struct {
+		name               string
+		givenCommand       string
+		givenInteractivity bool
+		givenApproved      bool
+		givenConfig        string
+		expCommands        []string
+		expCodeBlock       string
+		expButtons         api.Buttons
+		expPlaintext       string
+	}{
+		{
+			name:               "Preview scale command",
+			givenCommand:       "kubectl scale deployment nginx --replicas=3",
+			givenInteractivity: true,
+			expCommands: []string{
+				"kubectl -n default scale deployment nginx --replicas=3 --dry-run=server -o yaml",
+				"kubectl get Deployment.apps nginx -o yaml -n default",
+			},
+			expCodeBlock: heredoc.Doc(`
+				--- live/deployment/nginx
+				+++ dry-run/deployment/nginx
+				@@ -4,4 +4,4 @@
+				   name: nginx
+				   namespace: default
+				 spec:
+				-  replicas: 1
+				+  replicas: 3
+				`),
+			expButtons: api.Buttons{
+				api.NewMessageButtonBuilder().ForCommandWithoutDesc("Execute", "kubectl scale deployment nginx --replicas=3 --bk-confirm", api.ButtonStylePrimary),
+				api.NewMessageButtonBuilder().ForCommandWithoutDesc("Cancel", "kubectl scale deployment nginx --replicas=3 --bk-cancel", api.ButtonStyleDanger),
+			},
+		},
+		{
+			name:               "Preview delete command",
+			givenCommand:       "kubectl delete pod nginx -n test",
+			givenInteractivity: true,
+			expCommands: []string{
+				"kubectl delete pod nginx -n test --dry-run=server",
+			},
+			expCodeBlock: "mocked",
+			expButtons: api.Buttons{
+				api.NewMessageButtonBuilder().ForCommandWithoutDesc("Execute", "kubectl delete pod nginx -n test --bk-confirm", api.ButtonStylePrimary),
+				api.NewMessageButtonBuilder().ForCommandWithoutDesc("Cancel", "kubectl delete pod nginx -n test --bk-cancel", api.ButtonStyleDanger),
+			},
+		},
+		{
+			name:               "Execute confirmed command",
+			givenCommand:       "kubectl scale deployment nginx --replicas=3 --bk-confirm",
+			givenInteractivity: true,
+			expCommands: []string{
+				"kubectl -n default scale deployment nginx --replicas=3",
+			},
+			expCodeBlock: "mocked",
+		},
+		{
+			name:               "Cancel command",
+			givenCommand:       "kubectl scale deployment nginx --replicas=3 --bk-cancel",
+			givenInteractivity: true,
+			expPlaintext:       "Execution of `kubectl scale deployment nginx --replicas=3` was cancelled.",
+		},
+		{
+			name:               "Execute command with explicit dry-run",
+			givenCommand:       "kubectl delete pod nginx --dry-run=client",
+			givenInteractivity: true,
+			expCommands: []string{
+				"kubectl -n default delete pod nginx --dry-run=client",
+			},
+			expCodeBlock: "mocked",
+		},
+		{
+			name:         "Execute command directly if interactivity is not supported",
+			givenCommand: "kubectl delete pod nginx",
+			expCommands: []string{
+				"kubectl -n default delete pod nginx",
+			},
+			expCodeBlock: "mocked",
+		},
+		{
+			name:               "Execute approved command directly",
+			givenCommand:       "kubectl delete pod nginx -n test",
+			givenInteractivity: true,
+			givenApproved:      true,
+			expCommands: []string{
+				"kubectl delete pod nginx -n test",
+			},
+			expCodeBlock: "mocked",
+		},
+		{
+			name:         "Execute command directly if verb is not configured",
+			givenCommand: "kubectl delete pod nginx",
+			givenConfig: heredoc.Doc(`
+				preview:
+				  verbs: ["apply"]`),
+			givenInteractivity: true,
+			expCommands: []string{
+				"kubectl -n default delete pod nginx",
+			},
+			expCodeBlock: "mocked",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var gotCmds []string
+			mockFn := NewMockedBinaryRunner(func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+				gotCmds = append(gotCmds, rawCmd)
+				switch {
+				case strings.Contains(rawCmd, "scale") && strings.Contains(rawCmd, "--dry-run=server"):
+					return pluginx.ExecuteCommandOutput{Stdout: dryRunDeploy}, nil
+				case strings.Contains(rawCmd, "get Deployment.apps"):
+					return pluginx.ExecuteCommandOutput{Stdout: liveDeploy}, nil
+				}
+				return pluginx.ExecuteCommandOutput{
+					Stdout: "mocked",
+				}, nil
+			})
+
+			exec := NewExecutor("dev", mockFn)
+
+			// when
+			out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.givenCommand,
+				Configs: []*executor.Config{
+					{
+						RawYAML: []byte(tc.givenConfig),
+					},
+				},
+				Context: executor.ExecuteInputContext{
+					KubeConfig:               []byte("not empty"),
+					IsInteractivitySupported: tc.givenInteractivity,
+					IsApproved:               tc.givenApproved,
+				},
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expCommands, gotCmds)
+			if tc.expPlaintext != "" {
+				assert.Equal(t, tc.expPlaintext, out.Message.BaseBody.Plaintext)
+				return
+			}
+			if tc.expButtons == nil {
+				assert.Equal(t, tc.expCodeBlock, out.Message.BaseBody.CodeBlock)
+				return
+			}
+			require.Len(t, out.Message.Sections, 1)
+			assert.Equal(t, tc.expCodeBlock, out.Message.Sections[0].Body.CodeBlock)
+			assert.Equal(t, tc.expButtons, out.Message.Sections[0].Buttons)
+		})
+	}
+}
+
+func TestAccessReviewDeniedCommand(t *testing.T) {
+	// given
+	var wasKubectlCalled bool
+	mockFn := NewMockedBinaryRunner(func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+		wasKubectlCalled = true
+		return pluginx.ExecuteCommandOutput{
+			Stdout: "mocked",
+		}, nil
+	})
+
+	exec := NewExecutor("dev", mockFn)
+	exec.accessReviewer = &fakeAccessReviewer{
+		denied: &accessreview.DeniedAccess{
+			Attributes: authv1.ResourceAttributes{Namespace: "prod", Verb: "delete", Resource: "pods", Name: "nginx"},
+			Reason:     "RBAC: access denied",
+		},
+		subject: impersonationSubject{User: "botkube-plugins", Groups: []string{"sre", "developers"}},
+	}
+
+	// when
+	out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+		Command: "kubectl delete pod nginx -n prod",
+		Context: executor.ExecuteInputContext{
+			KubeConfig: []byte("not empty"),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, wasKubectlCalled)
+	require.Len(t, out.Message.Sections, 1)
+
+	section := out.Message.Sections[0]
+	assert.Equal(t, "Reason: RBAC: access denied", section.Body.Plaintext)
+	assert.Equal(t, api.TextFields{
+		{Key: "Verb", Value: "`delete`"},
+		{Key: "Resource", Value: "`pods`"},
+		{Key: "Name", Value: "`nginx`"},
+		{Key: "Namespace", Value: "`prod`"},
+		{Key: "User", Value: "`botkube-plugins`"},
+		{Key: "Groups", Value: "`sre, developers`"},
+	}, section.TextFields)
+	assert.Equal(t, api.Buttons{
+		api.NewMessageButtonBuilder().ForCommandWithoutDesc("Show my permissions", "kubectl auth can-i --list -n prod"),
+	}, section.Buttons)
+}
+
+type fakeAccessReviewer struct {
+	denied  *accessreview.DeniedAccess
+	subject impersonationSubject
+}
+
+func (f *fakeAccessReviewer) Review(context.Context, string, string, string) (*accessreview.DeniedAccess, impersonationSubject, error) {
+	return f.denied, f.subject, nil
+}
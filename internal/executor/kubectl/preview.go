@@ -0,0 +1,300 @@
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+const (
+	confirmFlag = "--bk-confirm"
+	cancelFlag  = "--bk-cancel"
+
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+	noChangesMsg                = "No changes detected."
+)
+
+// PreviewConfig holds configuration for dry-run previews of mutating commands.
+type PreviewConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Verbs holds verbs for which the preview is displayed. Multi-word verbs, such as "set image", are supported.
+	Verbs []string `yaml:"verbs,omitempty"`
+}
+
+// DefaultPreviewConfig returns default configuration for dry-run previews.
+func DefaultPreviewConfig() PreviewConfig {
+	return PreviewConfig{
+		Enabled: true,
+		Verbs:   []string{"apply", "patch", "scale", "set image", "delete"},
+	}
+}
+
+type scopedRunner interface {
+	RunKubectlCommand(ctx context.Context, defaultNamespace, cmd string) (string, error)
+}
+
+// Previewer renders the server-side dry-run preview of mutating kubectl commands.
+type Previewer struct {
+	runner           scopedRunner
+	cfg              PreviewConfig
+	defaultNamespace string
+}
+
+// NewPreviewer returns a new Previewer instance.
+func NewPreviewer(runner scopedRunner, cfg PreviewConfig, defaultNamespace string) *Previewer {
+	return &Previewer{
+		runner:           runner,
+		cfg:              cfg,
+		defaultNamespace: defaultNamespace,
+	}
+}
+
+// ShouldPreview returns true if a given command should be previewed before execution.
+func (p *Previewer) ShouldPreview(cmd string) (bool, error) {
+	if !p.cfg.Enabled {
+		return false, nil
+	}
+
+	args, isDryRun, err := parsePreviewArgs(cmd)
+	if err != nil {
+		return false, err
+	}
+	if isDryRun {
+		// user already asked for a dry-run, no need to preview it
+		return false, nil
+	}
+	return matchedVerb(args, p.cfg.Verbs) != "", nil
+}
+
+// Preview runs a given command in the server-side dry-run mode and returns the diff against live objects.
+func (p *Previewer) Preview(ctx context.Context, cmd string) (api.Message, error) {
+	args, _, err := parsePreviewArgs(cmd)
+	if err != nil {
+		return api.Message{}, err
+	}
+
+	var preview string
+	if len(args) > 0 && args[0] == "delete" {
+		preview, err = p.runner.RunKubectlCommand(ctx, p.defaultNamespace, fmt.Sprintf("%s --dry-run=server", cmd))
+	} else {
+		preview, err = p.diff(ctx, cmd)
+	}
+	if err != nil {
+		return api.Message{}, err
+	}
+
+	btn := api.NewMessageButtonBuilder()
+	return api.Message{
+		Sections: []api.Section{
+			{
+				Base: api.Base{
+					Header:      "Dry-run preview",
+					Description: fmt.Sprintf("Review the changes of `%s %s` before executing it.", PluginName, cmd),
+					Body: api.Body{
+						CodeBlock: preview,
+					},
+				},
+				Buttons: api.Buttons{
+					btn.ForCommandWithoutDesc("Execute", fmt.Sprintf("%s %s %s", PluginName, cmd, confirmFlag), api.ButtonStylePrimary),
+					btn.ForCommandWithoutDesc("Cancel", fmt.Sprintf("%s %s %s", PluginName, cmd, cancelFlag), api.ButtonStyleDanger),
+				},
+			},
+		},
+	}, nil
+}
+
+func (p *Previewer) diff(ctx context.Context, cmd string) (string, error) {
+	out, err := p.runner.RunKubectlCommand(ctx, p.defaultNamespace, fmt.Sprintf("%s --dry-run=server -o yaml", cmd))
+	if err != nil {
+		return "", err
+	}
+
+	objs, err := decodeObjects(out)
+	if err != nil {
+		return "", fmt.Errorf("while decoding dry-run output: %w", err)
+	}
+
+	var diffs []string
+	for _, obj := range objs {
+		live, err := p.getLive(ctx, obj)
+		if err != nil {
+			return "", err
+		}
+
+		diff, err := diffObjects(live, obj)
+		if err != nil {
+			return "", err
+		}
+		if diff == "" {
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+
+	if len(diffs) == 0 {
+		return noChangesMsg, nil
+	}
+	return strings.Join(diffs, "\n"), nil
+}
+
+// getLive returns the live version of a given object. If the object doesn't exist yet, returns nil.
+func (p *Previewer) getLive(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := obj.GroupVersionKind()
+	resource := gvk.Kind
+	if gvk.Group != "" {
+		resource = fmt.Sprintf("%s.%s", gvk.Kind, gvk.Group)
+	}
+
+	getCmd := fmt.Sprintf("get %s %s -o yaml", resource, obj.GetName())
+	if ns := obj.GetNamespace(); ns != "" {
+		getCmd = fmt.Sprintf("%s -n %s", getCmd, ns)
+	}
+
+	out, err := p.runner.RunKubectlCommand(ctx, p.defaultNamespace, getCmd)
+	if err != nil {
+		// the dry-run already succeeded, so most likely the object is about to be created
+		return nil, nil
+	}
+
+	objs, err := decodeObjects(out)
+	if err != nil {
+		return nil, fmt.Errorf("while decoding live object: %w", err)
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+func decodeObjects(in string) ([]*unstructured.Unstructured, error) {
+	if strings.TrimSpace(in) == "" {
+		return nil, nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(in), &obj.Object); err != nil {
+		return nil, err
+	}
+
+	if !obj.IsList() {
+		return []*unstructured.Unstructured{obj}, nil
+	}
+
+	list, err := obj.ToList()
+	if err != nil {
+		return nil, err
+	}
+	var out []*unstructured.Unstructured
+	for i := range list.Items {
+		out = append(out, &list.Items[i])
+	}
+	return out, nil
+}
+
+func diffObjects(live, dryRun *unstructured.Unstructured) (string, error) {
+	name := fmt.Sprintf("%s/%s", strings.ToLower(dryRun.GetKind()), dryRun.GetName())
+
+	liveYAML, err := toComparableYAML(live)
+	if err != nil {
+		return "", fmt.Errorf("while marshaling live %s: %w", name, err)
+	}
+	dryRunYAML, err := toComparableYAML(dryRun)
+	if err != nil {
+		return "", fmt.Errorf("while marshaling dry-run %s: %w", name, err)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(dryRunYAML),
+		FromFile: fmt.Sprintf("live/%s", name),
+		ToFile:   fmt.Sprintf("dry-run/%s", name),
+		Context:  3,
+	})
+}
+
+// toComparableYAML marshals a given object without the fields that are changed by the API server on each write.
+func toComparableYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", lastAppliedConfigAnnotation)
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	out, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(out)), nil
+}
+
+// cutFlag removes a given boolean flag from the command. Returns true if the flag was found.
+func cutFlag(cmd, flag string) (string, bool) {
+	var (
+		found bool
+		out   []string
+	)
+	for _, arg := range strings.Fields(cmd) {
+		if arg == flag {
+			found = true
+			continue
+		}
+		out = append(out, arg)
+	}
+	if !found {
+		return cmd, false
+	}
+	return strings.Join(out, " "), true
+}
+
+// parsePreviewArgs returns the positional arguments of a given command and whether the dry-run flag is set.
+func parsePreviewArgs(cmd string) ([]string, bool, error) {
+	f := pflag.NewFlagSet("extract-preview-args", pflag.ContinueOnError)
+	f.BoolP("help", "h", false, "to make sure that parsing is ignoring the --help,-h flags as there are specially process by pflag")
+	f.ParseErrorsWhitelist.UnknownFlags = true
+	f.StringP("namespace", "n", "", "Kubernetes Namespace")
+	f.BoolP("all-namespaces", "A", false, "Kubernetes All Namespaces")
+	f.String("dry-run", "", "Dry-run strategy")
+	f.Lookup("dry-run").NoOptDefVal = "unchanged"
+
+	if err := f.Parse(strings.Fields(cmd)); err != nil {
+		return nil, false, fmt.Errorf("while parsing args: %w", err)
+	}
+	return f.Args(), f.Changed("dry-run"), nil
+}
+
+func matchedVerb(args, verbs []string) string {
+	for _, verb := range verbs {
+		verbArgs := strings.Fields(verb)
+		if len(verbArgs) == 0 || len(verbArgs) > len(args) {
+			continue
+		}
+
+		matched := true
+		for i := range verbArgs {
+			if !strings.EqualFold(verbArgs[i], args[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return verb
+		}
+	}
+	return ""
+}
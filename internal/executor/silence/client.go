@@ -0,0 +1,144 @@
+package silence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// SilenceStateActive describes a silence that currently mutes matching alerts.
+	SilenceStateActive = "active"
+	// SilenceStatePending describes a silence that starts in the future.
+	SilenceStatePending = "pending"
+	// SilenceStateExpired describes a silence that no longer mutes alerts.
+	SilenceStateExpired = "expired"
+
+	defaultHTTPTimeout = 30 * time.Second
+)
+
+// Matcher represents an Alertmanager silence matcher.
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// String returns the matcher in the Alertmanager notation, e.g. alertname="KubePodCrashLooping".
+func (m Matcher) String() string {
+	op := "="
+	switch {
+	case m.IsRegex && m.IsEqual:
+		op = "=~"
+	case m.IsRegex && !m.IsEqual:
+		op = "!~"
+	case !m.IsEqual:
+		op = "!="
+	}
+	return fmt.Sprintf("%s%s%q", m.Name, op, m.Value)
+}
+
+// Silence represents an Alertmanager silence.
+type Silence struct {
+	ID        string         `json:"id,omitempty"`
+	Matchers  []Matcher      `json:"matchers"`
+	StartsAt  time.Time      `json:"startsAt"`
+	EndsAt    time.Time      `json:"endsAt"`
+	CreatedBy string         `json:"createdBy"`
+	Comment   string         `json:"comment"`
+	Status    *SilenceStatus `json:"status,omitempty"`
+}
+
+// SilenceStatus holds the Alertmanager silence status.
+type SilenceStatus struct {
+	State string `json:"state"`
+}
+
+// Client provides functionality to manage silences using the Alertmanager API v2.
+type Client struct {
+	baseURL string
+	httpCli *http.Client
+}
+
+// NewClient returns a new Client instance.
+func NewClient(alertmanagerURL string) (*Client, error) {
+	if _, err := url.ParseRequestURI(alertmanagerURL); err != nil {
+		return nil, fmt.Errorf("while parsing Alertmanager URL: %w", err)
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(alertmanagerURL, "/"),
+		httpCli: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+// CreateSilence creates a new silence and returns its ID.
+func (c *Client) CreateSilence(ctx context.Context, silence Silence) (string, error) {
+	body, err := json.Marshal(silence)
+	if err != nil {
+		return "", fmt.Errorf("while marshalling silence: %w", err)
+	}
+
+	var out struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v2/silences", bytes.NewReader(body), &out); err != nil {
+		return "", err
+	}
+	return out.SilenceID, nil
+}
+
+// ListSilences returns all silences known to Alertmanager.
+func (c *Client) ListSilences(ctx context.Context) ([]Silence, error) {
+	var out []Silence
+	if err := c.do(ctx, http.MethodGet, "/api/v2/silences", nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpireSilence expires a given silence.
+func (c *Client) ExpireSilence(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/silence/%s", url.PathEscape(id)), nil, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpCli.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling Alertmanager API: %w", err)
+	}
+	defer res.Body.Close()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("while reading response body: %w", err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("Alertmanager API returned %d status code: %s", res.StatusCode, strings.TrimSpace(string(raw)))
+	}
+
+	if out == nil || len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("while unmarshalling response body: %w", err)
+	}
+	return nil
+}
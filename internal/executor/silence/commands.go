@@ -0,0 +1,61 @@
+package silence
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Commands defines all supported Silence plugin commands and their flags.
+type Commands struct {
+	Create *CreateCommand `arg:"subcommand:create"`
+	List   *ListCommand   `arg:"subcommand:list"`
+	Expire *ExpireCommand `arg:"subcommand:expire"`
+}
+
+// CreateCommand holds flags for the 'silence create' command.
+type CreateCommand struct {
+	Matchers []string `arg:"--matcher,-m,separate"`
+	Duration string   `arg:"--duration,-d"`
+	Comment  string   `arg:"--comment,-c"`
+}
+
+// ListCommand holds flags for the 'silence list' command.
+type ListCommand struct {
+	All bool `arg:"--all,-A"`
+}
+
+// ExpireCommand holds arguments for the 'silence expire' command.
+type ExpireCommand struct {
+	ID string `arg:"positional"`
+}
+
+// matcherRegex matches the Alertmanager matcher notation, e.g. alertname="KubePodCrashLooping" or severity=~"warning|critical".
+var matcherRegex = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)\s*(.*?)\s*$`)
+
+// ParseMatcher parses a single matcher, e.g. 'alertname=KubePodCrashLooping' or 'pod!~"api-.*"'.
+func ParseMatcher(in string) (Matcher, error) {
+	groups := matcherRegex.FindStringSubmatch(in)
+	if groups == nil {
+		return Matcher{}, fmt.Errorf("invalid matcher %q, expected format is <label><operator><value>, where operator is one of: =, !=, =~, !~", in)
+	}
+
+	name, op, value := groups[1], groups[2], unquote(groups[3])
+	if value == "" {
+		return Matcher{}, fmt.Errorf("invalid matcher %q, value cannot be empty", in)
+	}
+
+	matcher := Matcher{
+		Name:    name,
+		Value:   value,
+		IsEqual: op == "=" || op == "=~",
+		IsRegex: op == "=~" || op == "!~",
+	}
+	return matcher, nil
+}
+
+func unquote(in string) string {
+	if len(in) >= 2 && in[0] == '"' && in[len(in)-1] == '"' {
+		return in[1 : len(in)-1]
+	}
+	return in
+}
@@ -0,0 +1,48 @@
+package silence
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const defaultSilenceDuration = 2 * time.Hour
+
+// Config holds Silence plugin configuration parameters.
+type Config struct {
+	AlertmanagerURL string        `yaml:"alertmanagerURL,omitempty"`
+	DefaultDuration time.Duration `yaml:"defaultDuration,omitempty"`
+	Log             config.Logger `yaml:"log"`
+}
+
+// Validate validates the Silence configuration parameters.
+func (c *Config) Validate() error {
+	if c.AlertmanagerURL == "" {
+		return errors.New("the Alertmanager URL cannot be empty")
+	}
+	if c.DefaultDuration <= 0 {
+		return errors.New("the default silence duration must be positive")
+	}
+	return nil
+}
+
+// MergeConfigs merges the Silence configuration.
+func MergeConfigs(configs []*executor.Config) (Config, error) {
+	defaults := Config{
+		DefaultDuration: defaultSilenceDuration,
+	}
+
+	var out Config
+	if err := pluginx.MergeExecutorConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	if err := out.Validate(); err != nil {
+		return Config{}, fmt.Errorf("while validating merged configuration: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,334 @@
+package silence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/alexflint/go-arg"
+	"github.com/prometheus/common/model"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/formatx"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const (
+	// PluginName is the name of the Silence Botkube plugin.
+	PluginName  = "silence"
+	description = "Create, list and expire Prometheus Alertmanager silences directly from your favorite communication platform."
+
+	defaultCreatedBy   = "Botkube"
+	silenceTimeFormat  = "2006-01-02 15:04:05 MST"
+	maxExpireButtons   = 10
+	noSilencesFoundMsg = "No silences found."
+)
+
+var _ executor.Executor = &Executor{}
+
+// Executor provides functionality for managing Alertmanager silences.
+type Executor struct {
+	pluginVersion string
+	now           func() time.Time
+}
+
+// NewExecutor returns a new Executor instance.
+func NewExecutor(ver string) *Executor {
+	return &Executor{
+		pluginVersion: ver,
+		now:           time.Now,
+	}
+}
+
+// Metadata returns details about the Silence plugin.
+func (e *Executor) Metadata(context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     e.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+// Execute returns a given command as response.
+//
+// Supported commands:
+// - create
+// - list
+// - expire
+func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (executor.ExecuteOutput, error) {
+	cfg, err := MergeConfigs(in.Configs)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	log := loggerx.New(cfg.Log)
+
+	var cmd Commands
+	err = pluginx.ParseCommand(PluginName, in.Command, &cmd)
+	switch {
+	case err == nil:
+	case errors.Is(err, arg.ErrHelp):
+		return executor.ExecuteOutput{
+			Message: api.NewCodeBlockMessage(help(), true),
+		}, nil
+	default:
+		return executor.ExecuteOutput{}, fmt.Errorf("while parsing input command: %w", err)
+	}
+
+	cli, err := NewClient(cfg.AlertmanagerURL)
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	var msg api.Message
+	switch {
+	case cmd.Create != nil:
+		log.WithField("matchers", cmd.Create.Matchers).Debug("Creating silence...")
+		msg, err = e.create(ctx, cli, cfg, cmd.Create, in.Context)
+	case cmd.List != nil:
+		log.Debug("Listing silences...")
+		msg, err = e.list(ctx, cli, cmd.List, in.Context.IsInteractivitySupported)
+	case cmd.Expire != nil:
+		log.WithField("id", cmd.Expire.ID).Debug("Expiring silence...")
+		msg, err = e.expire(ctx, cli, cmd.Expire)
+	default:
+		msg = api.NewCodeBlockMessage(help(), true)
+	}
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	return executor.ExecuteOutput{
+		Message: msg,
+	}, nil
+}
+
+// Help returns help message
+func (*Executor) Help(context.Context) (api.Message, error) {
+	return api.NewCodeBlockMessage(help(), true), nil
+}
+
+func (e *Executor) create(ctx context.Context, cli *Client, cfg Config, cmd *CreateCommand, inCtx executor.ExecuteInputContext) (api.Message, error) {
+	if len(cmd.Matchers) == 0 {
+		return api.Message{}, errors.New("at least one matcher is required, e.g. '--matcher alertname=KubePodCrashLooping'")
+	}
+
+	var matchers []Matcher
+	for _, raw := range cmd.Matchers {
+		matcher, err := ParseMatcher(raw)
+		if err != nil {
+			return api.Message{}, err
+		}
+		matchers = append(matchers, matcher)
+	}
+
+	duration := cfg.DefaultDuration
+	if cmd.Duration != "" {
+		parsed, err := model.ParseDuration(cmd.Duration)
+		if err != nil {
+			return api.Message{}, fmt.Errorf("while parsing duration: %w", err)
+		}
+		duration = time.Duration(parsed)
+	}
+	if duration <= 0 {
+		return api.Message{}, errors.New("the silence duration must be positive")
+	}
+
+	comment := cmd.Comment
+	if comment == "" {
+		comment = "Created from chat."
+	}
+
+	startsAt := e.now().UTC()
+	silence := Silence{
+		Matchers:  matchers,
+		StartsAt:  startsAt,
+		EndsAt:    startsAt.Add(duration),
+		CreatedBy: createdBy(inCtx.Message.User),
+		Comment:   comment,
+	}
+
+	id, err := cli.CreateSilence(ctx, silence)
+	if err != nil {
+		return api.Message{}, fmt.Errorf("while creating silence: %w", err)
+	}
+
+	body := heredoc.Docf(`
+		Silence %q created.
+
+		Matchers:  %s
+		Ends at:   %s
+		Comment:   %s`, id, matchersString(matchers), silence.EndsAt.Format(silenceTimeFormat), comment)
+
+	msg := api.NewCodeBlockMessage(body, true)
+	if inCtx.IsInteractivitySupported {
+		btnBuilder := api.NewMessageButtonBuilder()
+		msg.Sections = []api.Section{
+			{
+				Buttons: []api.Button{
+					btnBuilder.ForCommandWithoutDesc("Expire", fmt.Sprintf("%s expire %s", PluginName, id), api.ButtonStyleDanger),
+				},
+			},
+		}
+	}
+	return msg, nil
+}
+
+func (e *Executor) list(ctx context.Context, cli *Client, cmd *ListCommand, isInteractivitySupported bool) (api.Message, error) {
+	silences, err := cli.ListSilences(ctx)
+	if err != nil {
+		return api.Message{}, fmt.Errorf("while listing silences: %w", err)
+	}
+
+	var filtered []Silence
+	for _, silence := range silences {
+		if !cmd.All && silenceState(silence) == SilenceStateExpired {
+			continue
+		}
+		filtered = append(filtered, silence)
+	}
+	if len(filtered) == 0 {
+		return api.NewCodeBlockMessage(noSilencesFoundMsg, true), nil
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].EndsAt.Before(filtered[j].EndsAt)
+	})
+
+	table := formatx.Table{
+		Headers: []string{"ID", "STATE", "ENDS AT", "CREATED BY", "MATCHERS", "COMMENT"},
+	}
+	for _, silence := range filtered {
+		table.Rows = append(table.Rows, []string{
+			silence.ID,
+			silenceState(silence),
+			silence.EndsAt.UTC().Format(silenceTimeFormat),
+			silence.CreatedBy,
+			matchersString(silence.Matchers),
+			silence.Comment,
+		})
+	}
+
+	msg := api.NewCodeBlockMessage(table.Render(), true)
+	if !isInteractivitySupported {
+		return msg, nil
+	}
+
+	btnBuilder := api.NewMessageButtonBuilder()
+	var btns api.Buttons
+	for _, silence := range filtered {
+		if silenceState(silence) == SilenceStateExpired {
+			continue
+		}
+		if len(btns) >= maxExpireButtons {
+			break
+		}
+		btns = append(btns, btnBuilder.ForCommandWithoutDesc(fmt.Sprintf("Expire %s", shortID(silence.ID)), fmt.Sprintf("%s expire %s", PluginName, silence.ID), api.ButtonStyleDanger))
+	}
+	if len(btns) > 0 {
+		msg.Sections = []api.Section{{Buttons: btns}}
+	}
+	return msg, nil
+}
+
+func (e *Executor) expire(ctx context.Context, cli *Client, cmd *ExpireCommand) (api.Message, error) {
+	if cmd.ID == "" {
+		return api.Message{}, errors.New("silence ID is required, e.g. 'silence expire <id>'")
+	}
+
+	if err := cli.ExpireSilence(ctx, cmd.ID); err != nil {
+		return api.Message{}, fmt.Errorf("while expiring silence %q: %w", cmd.ID, err)
+	}
+
+	return api.NewCodeBlockMessage(fmt.Sprintf("Silence %q expired.", cmd.ID), true), nil
+}
+
+func silenceState(silence Silence) string {
+	if silence.Status == nil {
+		return ""
+	}
+	return silence.Status.State
+}
+
+func createdBy(user executor.User) string {
+	switch {
+	case user.DisplayName != "":
+		return user.DisplayName
+	case user.Mention != "":
+		return user.Mention
+	default:
+		return defaultCreatedBy
+	}
+}
+
+func matchersString(matchers []Matcher) string {
+	var out []string
+	for _, matcher := range matchers {
+		out = append(out, matcher.String())
+	}
+	return strings.Join(out, ", ")
+}
+
+func shortID(id string) string {
+	const maxLen = 8
+	if len(id) <= maxLen {
+		return id
+	}
+	return id[:maxLen]
+}
+
+func help() string {
+	return heredoc.Doc(`
+		Usage:
+		  silence [command]
+
+		Available Commands:
+		  create      Creates a new Alertmanager silence
+		  list        Lists active and pending silences
+		  expire      Expires a given silence
+
+		Create flags:
+		  -m, --matcher    Alert label matcher, e.g. 'alertname=KubePodCrashLooping' or 'severity=~"warning|critical"'. Can be specified multiple times.
+		  -d, --duration   Silence duration, e.g. 30m, 2h or 1d. Defaults to the configured default duration.
+		  -c, --comment    Silence comment.
+
+		List flags:
+		  -A, --all        Lists also expired silences.
+
+		Examples:
+		  silence create --matcher alertname=KubePodCrashLooping --matcher namespace=default --duration 2h --comment "Investigating"
+		  silence list
+		  silence expire 8a1c7e9d-5bd3-4c0f-a4a8-1b5f2e0d1c3a`)
+}
+
+// jsonSchema returns JSON schema for the executor.
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+			  "$schema": "http://json-schema.org/draft-07/schema#",
+			  "title": "Silence",
+			  "description": "%s",
+			  "type": "object",
+			  "properties": {
+				"alertmanagerURL": {
+				  "title": "Alertmanager URL",
+				  "description": "Alertmanager endpoint without API version and resource, e.g. http://alertmanager-operated.monitoring:9093.",
+				  "type": "string",
+				  "format": "uri"
+				},
+				"defaultDuration": {
+				  "title": "Default duration",
+				  "description": "Silence duration used if not explicitly specified during command execution.",
+				  "type": "string",
+				  "default": "2h"
+				}
+			  },
+			  "required": ["alertmanagerURL"]
+			}`, description),
+	}
+}
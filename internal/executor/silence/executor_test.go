@@ -0,0 +1,280 @@
+package silence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+)
+
+var fixNow = time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC)
+
+func TestExecutorCreate(t *testing.T) {
+	// given
+	am := newFakeAlertmanager(t)
+	e := newTestExecutor()
+
+	// when
+	out, err := e.Execute(context.Background(), executor.ExecuteInput{
+		Command: `silence create --matcher alertname=KubePodCrashLooping --matcher 'pod=~"api-.*"' --duration 1h --comment "Investigating"`,
+		Configs: fixConfigs(am.URL),
+		Context: executor.ExecuteInputContext{
+			IsInteractivitySupported: true,
+			Message: executor.Message{
+				User: executor.User{DisplayName: "Jane Doe"},
+			},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		Silence "silence-1" created.
+
+		Matchers:  alertname="KubePodCrashLooping", pod=~"api-.*"
+		Ends at:   2023-05-10 13:00:00 UTC
+		Comment:   Investigating`), out.Message.BaseBody.CodeBlock)
+
+	require.Len(t, out.Message.Sections, 1)
+	require.Len(t, out.Message.Sections[0].Buttons, 1)
+	assert.Equal(t, "{{BotName}} silence expire silence-1", out.Message.Sections[0].Buttons[0].Command)
+
+	silence := am.silences["silence-1"]
+	assert.Equal(t, []Matcher{
+		{Name: "alertname", Value: "KubePodCrashLooping", IsEqual: true},
+		{Name: "pod", Value: "api-.*", IsEqual: true, IsRegex: true},
+	}, silence.Matchers)
+	assert.Equal(t, fixNow, silence.StartsAt.UTC())
+	assert.Equal(t, fixNow.Add(time.Hour), silence.EndsAt.UTC())
+	assert.Equal(t, "Jane Doe", silence.CreatedBy)
+	assert.Equal(t, "Investigating", silence.Comment)
+}
+
+func TestExecutorCreateValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		expErrMsg string
+	}{
+		{
+			name:      "missing matchers",
+			command:   "silence create --duration 1h",
+			expErrMsg: "at least one matcher is required, e.g. '--matcher alertname=KubePodCrashLooping'",
+		},
+		{
+			name:      "invalid matcher",
+			command:   "silence create --matcher alertname",
+			expErrMsg: `invalid matcher "alertname", expected format is <label><operator><value>, where operator is one of: =, !=, =~, !~`,
+		},
+		{
+			name:      "invalid duration",
+			command:   "silence create --matcher alertname=Foo --duration forever",
+			expErrMsg: `while parsing duration: not a valid duration string: "forever"`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			am := newFakeAlertmanager(t)
+			e := newTestExecutor()
+
+			// when
+			_, err := e.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.command,
+				Configs: fixConfigs(am.URL),
+			})
+
+			// then
+			assert.EqualError(t, err, tc.expErrMsg)
+			assert.Empty(t, am.silences)
+		})
+	}
+}
+
+func TestExecutorListAndExpire(t *testing.T) {
+	// given
+	ctx := context.Background()
+	am := newFakeAlertmanager(t)
+	e := newTestExecutor()
+	configs := fixConfigs(am.URL)
+
+	for _, cmd := range []string{
+		"silence create --matcher alertname=KubePodCrashLooping --duration 2h",
+		"silence create --matcher alertname=TargetDown --duration 1h --comment Maintenance",
+	} {
+		_, err := e.Execute(ctx, executor.ExecuteInput{Command: cmd, Configs: configs})
+		require.NoError(t, err)
+	}
+
+	// when
+	out, err := e.Execute(ctx, executor.ExecuteInput{
+		Command: "silence expire silence-1",
+		Configs: configs,
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `Silence "silence-1" expired.`, out.Message.BaseBody.CodeBlock)
+
+	// when
+	out, err = e.Execute(ctx, executor.ExecuteInput{
+		Command: "silence list",
+		Configs: configs,
+		Context: executor.ExecuteInputContext{IsInteractivitySupported: true},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		ID        STATE  ENDS AT                 CREATED BY MATCHERS               COMMENT
+		silence-2 active 2023-05-10 13:00:00 UTC Botkube    alertname="TargetDown" Maintenance`), out.Message.BaseBody.CodeBlock)
+	require.Len(t, out.Message.Sections, 1)
+	assert.Equal(t, api.Buttons{
+		api.NewMessageButtonBuilder().ForCommandWithoutDesc("Expire silence-", "silence expire silence-2", api.ButtonStyleDanger),
+	}, out.Message.Sections[0].Buttons)
+
+	// when
+	out, err = e.Execute(ctx, executor.ExecuteInput{
+		Command: "silence list --all",
+		Configs: configs,
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		ID        STATE   ENDS AT                 CREATED BY MATCHERS                        COMMENT
+		silence-2 active  2023-05-10 13:00:00 UTC Botkube    alertname="TargetDown"          Maintenance
+		silence-1 expired 2023-05-10 14:00:00 UTC Botkube    alertname="KubePodCrashLooping" Created from chat.`), out.Message.BaseBody.CodeBlock)
+	assert.Empty(t, out.Message.Sections)
+}
+
+func TestExecutorExpireUnknownSilence(t *testing.T) {
+	// given
+	am := newFakeAlertmanager(t)
+	e := newTestExecutor()
+
+	// when
+	_, err := e.Execute(context.Background(), executor.ExecuteInput{
+		Command: "silence expire unknown",
+		Configs: fixConfigs(am.URL),
+	})
+
+	// then
+	assert.EqualError(t, err, `while expiring silence "unknown": Alertmanager API returned 404 status code: silence not found`)
+}
+
+func TestParseMatcher(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected Matcher
+	}{
+		{in: "alertname=Foo", expected: Matcher{Name: "alertname", Value: "Foo", IsEqual: true}},
+		{in: `severity!="info"`, expected: Matcher{Name: "severity", Value: "info"}},
+		{in: "pod=~api-.*", expected: Matcher{Name: "pod", Value: "api-.*", IsEqual: true, IsRegex: true}},
+		{in: "namespace!~kube-.*", expected: Matcher{Name: "namespace", Value: "kube-.*", IsRegex: true}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			matcher, err := ParseMatcher(tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, matcher)
+		})
+	}
+}
+
+func newTestExecutor() *Executor {
+	e := NewExecutor("dev")
+	e.now = func() time.Time { return fixNow }
+	return e
+}
+
+func fixConfigs(url string) []*executor.Config {
+	return []*executor.Config{
+		{RawYAML: []byte(fmt.Sprintf("alertmanagerURL: %s", url))},
+	}
+}
+
+// fakeAlertmanager is a minimal stand-in for the Alertmanager API v2 silences endpoints.
+type fakeAlertmanager struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	silences map[string]Silence
+}
+
+func newFakeAlertmanager(t *testing.T) *fakeAlertmanager {
+	t.Helper()
+
+	am := &fakeAlertmanager{silences: map[string]Silence{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/silences", am.handleSilences)
+	mux.HandleFunc("/api/v2/silence/", am.handleSilence)
+	am.Server = httptest.NewServer(mux)
+	t.Cleanup(am.Close)
+
+	return am
+}
+
+func (a *fakeAlertmanager) handleSilences(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		out := make([]Silence, 0, len(a.silences))
+		for _, silence := range a.silences {
+			out = append(out, silence)
+		}
+		writeJSON(w, out)
+	case http.MethodPost:
+		raw, _ := io.ReadAll(r.Body)
+		var silence Silence
+		if err := json.Unmarshal(raw, &silence); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		silence.ID = fmt.Sprintf("silence-%d", len(a.silences)+1)
+		silence.Status = &SilenceStatus{State: SilenceStateActive}
+		a.silences[silence.ID] = silence
+		writeJSON(w, map[string]string{"silenceID": silence.ID})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (a *fakeAlertmanager) handleSilence(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := r.URL.Path[len("/api/v2/silence/"):]
+	silence, found := a.silences[id]
+	if !found {
+		http.Error(w, "silence not found", http.StatusNotFound)
+		return
+	}
+	silence.Status = &SilenceStatus{State: SilenceStateExpired}
+	a.silences[id] = silence
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeJSON(w http.ResponseWriter, in any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(in)
+}
@@ -10,6 +10,32 @@ type (
 		Resolve  *ResolveCmd  `arg:"subcommand:resolve"`
 		Takeover *TakeoverCmd `arg:"subcommand:takeover"`
 		Export   *ExportCmd   `arg:"subcommand:export"`
+		OnCall   *OnCallCmd   `arg:"subcommand:oncall"`
+	}
+
+	// OnCallCmd represents the "oncall" subcommand.
+	OnCallCmd struct {
+		Who      *OnCallWhoCmd      `arg:"subcommand:who"`
+		Override *OnCallOverrideCmd `arg:"subcommand:override"`
+		Mention  *OnCallMentionCmd  `arg:"subcommand:mention"`
+	}
+
+	// OnCallWhoCmd represents the "oncall who" subcommand.
+	OnCallWhoCmd struct {
+		Schedule string `arg:"-s,--schedule"`
+	}
+
+	// OnCallOverrideCmd represents the "oncall override" subcommand.
+	OnCallOverrideCmd struct {
+		User     string `arg:"positional"`
+		From     string `arg:"--from"`
+		Until    string `arg:"--until"`
+		Schedule string `arg:"-s,--schedule"`
+	}
+
+	// OnCallMentionCmd represents the "oncall mention" subcommand.
+	OnCallMentionCmd struct {
+		Schedule string `arg:"-s,--schedule"`
 	}
 
 	// ExportCmd represents the "export" subcommand.
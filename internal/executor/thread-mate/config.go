@@ -23,6 +23,7 @@ type Config struct {
 	RoundRobin RoundRobinConfig `yaml:"roundRobin"`
 	Logger     config.Logger    `yaml:"log"`
 	Pick       PickConfig       `yaml:"pick"`
+	OnCall     OnCallConfig     `yaml:"onCall"`
 
 	Persistence PersistenceConfig `yaml:"persistence"`
 }
@@ -41,6 +42,26 @@ type PickConfig struct {
 	MessagesTemplate string        `yaml:"messagesTemplate"`
 }
 
+// OnCallConfig holds the on-call schedules configuration.
+type OnCallConfig struct {
+	Schedules []OnCallScheduleConfig `yaml:"schedules"`
+}
+
+// OnCallScheduleConfig holds a single on-call rotation configuration.
+type OnCallScheduleConfig struct {
+	Name string `yaml:"name"`
+	// Rotation is either "daily" or "weekly". Defaults to "weekly".
+	Rotation RotationType `yaml:"rotation"`
+	// Start is the date of the first shift in format YYYY-MM-DD. Weekly shifts are handed over on the same weekday.
+	Start string `yaml:"start"`
+	// Handover is the time of day when the shift is handed over, in format HH:MM. Defaults to "09:00".
+	Handover string `yaml:"handover"`
+	// Timezone is the IANA time zone of the start date and the handover time. Defaults to "UTC".
+	Timezone string `yaml:"timezone"`
+	// Participants in format {id}:{name}, listed in the rotation order.
+	Participants []string `yaml:"participants"`
+}
+
 // Validate validates the configuration parameters.
 func (c *Config) Validate() error {
 	issues := multierror.New()
@@ -50,6 +71,18 @@ func (c *Config) Validate() error {
 	if len(c.RoundRobin.Assignees) == 0 {
 		issues = multierror.Append(issues, errors.New("the assignees list cannot be empty"))
 	}
+
+	names := map[string]struct{}{}
+	for _, item := range c.OnCall.Schedules {
+		if _, found := names[item.Name]; found {
+			issues = multierror.Append(issues, fmt.Errorf("the on-call schedule name %q is not unique", item.Name))
+		}
+		names[item.Name] = struct{}{}
+
+		if _, err := item.Parse(); err != nil {
+			issues = multierror.Append(issues, fmt.Errorf("invalid on-call schedule %q: %w", item.Name, err))
+		}
+	}
 	return issues.ErrorOrNil()
 }
 
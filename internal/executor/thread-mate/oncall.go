@@ -0,0 +1,352 @@
+package thread_mate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+)
+
+const onCallTimeLayout = "Mon, 02 Jan 2006 15:04 MST"
+
+// overrideTimeLayouts are accepted formats of the override start and end. Times without a zone are in the schedule time zone.
+var overrideTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04",
+	time.DateOnly,
+}
+
+type (
+	// Override replaces the on-call person of a given schedule for a given period, e.g. during vacations.
+	Override struct {
+		ID        string
+		Schedule  string
+		Assignee  Assignee
+		From      time.Time
+		Until     time.Time
+		CreatedBy Assignee
+	}
+
+	// Overrides represents a collection of on-call overrides.
+	Overrides struct {
+		sync.RWMutex
+		list  []Override
+		dirty bool
+	}
+
+	// onCall represents the person on call at a given time.
+	onCall struct {
+		Assignee Assignee
+		Until    time.Time
+		Override *Override
+	}
+)
+
+// ResetDirty resets the dirty flag to indicate that changes have been saved.
+func (o *Overrides) ResetDirty() {
+	o.Lock()
+	defer o.Unlock()
+	o.dirty = false
+}
+
+// IsDirty returns true if the Overrides collection has been modified.
+func (o *Overrides) IsDirty() bool {
+	o.RLock()
+	defer o.RUnlock()
+	return o.dirty
+}
+
+// Append adds a new override to the Overrides collection.
+func (o *Overrides) Append(in Override) {
+	o.Lock()
+	defer o.Unlock()
+	o.list = append(o.list, in)
+	o.dirty = true
+}
+
+// Get returns a copy of the Overrides collection.
+func (o *Overrides) Get() []Override {
+	o.RLock()
+	defer o.RUnlock()
+	return append([]Override{}, o.list...)
+}
+
+// Active returns the override of a given schedule active at a given time. If overrides overlap, the most recent one wins.
+func (o *Overrides) Active(schedule string, at time.Time) *Override {
+	o.RLock()
+	defer o.RUnlock()
+
+	for idx := len(o.list) - 1; idx >= 0; idx-- {
+		item := o.list[idx]
+		if item.Schedule != schedule || at.Before(item.From) || !at.Before(item.Until) {
+			continue
+		}
+		return &item
+	}
+	return nil
+}
+
+// Upcoming returns overrides of a given schedule which start after a given time, ordered by the start time.
+func (o *Overrides) Upcoming(schedule string, after time.Time) []Override {
+	o.RLock()
+	defer o.RUnlock()
+
+	var out []Override
+	for _, item := range o.list {
+		if item.Schedule != schedule || !item.From.After(after) {
+			continue
+		}
+		out = append(out, item)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].From.Before(out[j].From)
+	})
+	return out
+}
+
+// Prune removes overrides which already ended.
+func (o *Overrides) Prune(now time.Time) {
+	o.Lock()
+	defer o.Unlock()
+
+	var out []Override
+	for _, item := range o.list {
+		if !item.Until.After(now) {
+			continue
+		}
+		out = append(out, item)
+	}
+	if len(out) != len(o.list) {
+		o.list = out
+		o.dirty = true
+	}
+}
+
+// OnCallWho handles the "oncall who" command and returns people currently on call.
+func (t *ThreadMate) OnCallWho(cmd *OnCallWhoCmd) api.Message {
+	if len(t.schedules) == 0 {
+		return api.NewPlaintextMessage("🔍 No on-call schedules configured", false)
+	}
+
+	schedules := t.schedules
+	if cmd != nil && cmd.Schedule != "" {
+		schedule, found := t.getSchedule(cmd.Schedule)
+		if !found {
+			return api.NewPlaintextMessage(fmt.Sprintf("🔍 On-call schedule %q not found", cmd.Schedule), false)
+		}
+		schedules = []Schedule{schedule}
+	}
+
+	now := time.Now()
+	var sections []api.Section
+	for _, schedule := range schedules {
+		sections = append(sections, t.renderOnCallSection(schedule, now))
+	}
+
+	return api.Message{
+		Sections: sections,
+	}
+}
+
+// OnCallOverride handles the "oncall override" command and replaces the on-call person for a given period.
+func (t *ThreadMate) OnCallOverride(cmd *OnCallOverrideCmd, message executor.Message) api.Message {
+	if cmd == nil || cmd.User == "" || cmd.Until == "" {
+		return api.NewPlaintextMessage("Missing user or end of the override, e.g. `thread-mate oncall override @user --until 2023-08-14T09:00`", false)
+	}
+
+	schedule, found := t.getSchedule(cmd.Schedule)
+	if !found {
+		return api.NewPlaintextMessage(fmt.Sprintf("🔍 On-call schedule %q not found", cmd.Schedule), false)
+	}
+
+	requester, found := schedule.participantByID(extractIDFromMention(message.User.Mention))
+	if !found {
+		return api.NewPlaintextMessage(fmt.Sprintf("❌ You cannot override the %q on-call schedule because you are not on its participant list.", schedule.Name), false)
+	}
+
+	now := time.Now()
+	from := now
+	if cmd.From != "" {
+		var err error
+		from, err = parseOverrideTime(cmd.From, now, schedule.Location())
+		if err != nil {
+			return api.NewPlaintextMessage(fmt.Sprintf("❌ Invalid start of the override: %s", err), false)
+		}
+	}
+	until, err := parseOverrideTime(cmd.Until, from, schedule.Location())
+	if err != nil {
+		return api.NewPlaintextMessage(fmt.Sprintf("❌ Invalid end of the override: %s", err), false)
+	}
+	if !until.After(from) || !until.After(now) {
+		return api.NewPlaintextMessage("❌ The end of the override must be in the future and after its start.", false)
+	}
+
+	userID := strings.TrimPrefix(extractIDFromMention(cmd.User), "@")
+	assignee, found := schedule.participantByID(userID)
+	if !found {
+		assignee = Assignee{ID: userID, DisplayName: userID}
+	}
+
+	t.onCallOverrides.Append(Override{
+		ID:        uuid.NewString(),
+		Schedule:  schedule.Name,
+		Assignee:  assignee,
+		From:      from,
+		Until:     until,
+		CreatedBy: requester,
+	})
+
+	loc := schedule.Location()
+	return api.NewPlaintextMessage(fmt.Sprintf("✅ %s is on call for %q from %s until %s.", asMention(assignee.ID), schedule.Name, from.In(loc).Format(onCallTimeLayout), until.In(loc).Format(onCallTimeLayout)), false)
+}
+
+// OnCallMention handles the "oncall mention" command and mentions the person currently on call.
+// It's meant to be used in actions bound to sources which should notify the on-call person.
+func (t *ThreadMate) OnCallMention(cmd *OnCallMentionCmd) api.Message {
+	var name string
+	if cmd != nil {
+		name = cmd.Schedule
+	}
+
+	schedule, found := t.getSchedule(name)
+	if !found {
+		return api.NewPlaintextMessage(fmt.Sprintf("🔍 On-call schedule %q not found", name), false)
+	}
+
+	current := t.onCallAt(schedule, time.Now())
+	return api.NewPlaintextMessage(fmt.Sprintf("📟 %s, you are on call for %q. Please take a look!", asMention(current.Assignee.ID), schedule.Name), false)
+}
+
+func (t *ThreadMate) renderOnCallSection(schedule Schedule, now time.Time) api.Section {
+	loc := schedule.Location()
+	current := t.onCallAt(schedule, now)
+	next := t.onCallAt(schedule, current.Until)
+
+	fields := []api.TextField{
+		{Key: "On call", Value: asMention(current.Assignee.ID)},
+		{Key: "Until", Value: current.Until.In(loc).Format(onCallTimeLayout)},
+		{Key: "Next", Value: asMention(next.Assignee.ID)},
+	}
+	if current.Override != nil {
+		fields = append(fields, api.TextField{Key: "Override by", Value: asMention(current.Override.CreatedBy.ID)})
+	}
+
+	var overrides []string
+	for _, item := range t.onCallOverrides.Upcoming(schedule.Name, now) {
+		overrides = append(overrides, fmt.Sprintf("%s from %s until %s", asMention(item.Assignee.ID), item.From.In(loc).Format(onCallTimeLayout), item.Until.In(loc).Format(onCallTimeLayout)))
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("📟 %s on-call", schedule.Name),
+		},
+		TextFields: fields,
+	}
+	if len(overrides) > 0 {
+		section.BulletLists = api.BulletLists{
+			{Title: "Upcoming overrides", Items: overrides},
+		}
+	}
+	return section
+}
+
+// onCallAt returns the person on call at a given time, taking into account overrides.
+func (t *ThreadMate) onCallAt(schedule Schedule, at time.Time) onCall {
+	shift := schedule.ShiftAt(at)
+	out := onCall{
+		Assignee: shift.Assignee,
+		Until:    shift.End,
+	}
+
+	if override := t.onCallOverrides.Active(schedule.Name, at); override != nil {
+		out = onCall{
+			Assignee: override.Assignee,
+			Until:    override.Until,
+			Override: override,
+		}
+	}
+
+	if upcoming := t.onCallOverrides.Upcoming(schedule.Name, at); len(upcoming) > 0 && upcoming[0].From.Before(out.Until) {
+		out.Until = upcoming[0].From
+	}
+	return out
+}
+
+// getSchedule returns the schedule with a given name. If the name is empty, the first schedule is returned.
+func (t *ThreadMate) getSchedule(name string) (Schedule, bool) {
+	if len(t.schedules) == 0 {
+		return Schedule{}, false
+	}
+	if name == "" {
+		return t.schedules[0], true
+	}
+	for _, item := range t.schedules {
+		if item.Name == name {
+			return item, true
+		}
+	}
+	return Schedule{}, false
+}
+
+func (t *ThreadMate) tryToLoadOverrides() Overrides {
+	name := t.configMapName(overridesCMName)
+	rawData, err := t.cfgDumper.Get(t.cfg.Persistence.ConfigMapNamespace, name)
+	if err != nil {
+		t.log.WithError(err).WithField("overrides", name).Debug("Cannot fetch on-call overrides, starting fresh...")
+		return Overrides{}
+	}
+
+	var out []Override
+	err = json.Unmarshal([]byte(rawData), &out)
+	if err != nil {
+		t.log.WithError(err).WithField("overrides", name).Debug("Cannot unmarshal on-call overrides, starting fresh...")
+		return Overrides{}
+	}
+
+	return Overrides{list: out}
+}
+
+func (t *ThreadMate) tryToDumpOverrides() {
+	t.onCallOverrides.Prune(time.Now())
+	if !t.onCallOverrides.IsDirty() {
+		return
+	}
+
+	name := t.configMapName(overridesCMName)
+	raw, err := json.Marshal(t.onCallOverrides.Get())
+	if err != nil {
+		t.log.WithError(err).WithField("overrides", name).Errorf("Cannot marshal on-call overrides, will repeat in %d...", t.cfg.Persistence.SyncInterval)
+		return
+	}
+
+	err = t.cfgDumper.SaveOrUpdate(t.cfg.Persistence.ConfigMapNamespace, name, string(raw))
+	if err != nil {
+		t.log.WithError(err).WithField("overrides", name).Errorf("Cannot dump on-call overrides, will repeat in %d...", t.cfg.Persistence.SyncInterval)
+		return
+	}
+
+	t.onCallOverrides.ResetDirty()
+}
+
+// parseOverrideTime parses the override start or end. It accepts a duration relative to a given time, e.g. `72h`,
+// or a date and time in one of the overrideTimeLayouts formats.
+func parseOverrideTime(in string, relativeTo time.Time, loc *time.Location) (time.Time, error) {
+	if d, err := time.ParseDuration(in); err == nil {
+		return relativeTo.Add(d), nil
+	}
+
+	for _, layout := range overrideTimeLayouts {
+		out, err := time.ParseInLocation(layout, in, loc)
+		if err == nil {
+			return out, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported format of %q, use a duration, e.g. 72h, or a date, e.g. 2023-08-14 or 2023-08-14T09:00", in)
+}
@@ -0,0 +1,173 @@
+package thread_mate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+// RotationType defines how often the on-call shift is handed over.
+type RotationType string
+
+const (
+	RotationTypeDaily  RotationType = "daily"
+	RotationTypeWeekly RotationType = "weekly"
+)
+
+const (
+	defaultHandover = "09:00"
+	defaultTimezone = "UTC"
+	handoverLayout  = "15:04"
+)
+
+type (
+	// Schedule represents a parsed on-call rotation.
+	Schedule struct {
+		Name         string
+		Participants []Assignee
+
+		location   *time.Location
+		periodDays int
+		// firstHandover is the start of the first shift.
+		firstHandover time.Time
+	}
+
+	// Shift represents a single on-call shift.
+	Shift struct {
+		Assignee Assignee
+		Start    time.Time
+		End      time.Time
+	}
+)
+
+// Parse validates the schedule configuration and returns the parsed schedule.
+func (s OnCallScheduleConfig) Parse() (Schedule, error) {
+	issues := multierror.New()
+	if s.Name == "" {
+		issues = multierror.Append(issues, errors.New("the name cannot be empty"))
+	}
+	if len(s.Participants) == 0 {
+		issues = multierror.Append(issues, errors.New("the participants list cannot be empty"))
+	}
+
+	periodDays := 7
+	switch s.Rotation {
+	case "", RotationTypeWeekly:
+	case RotationTypeDaily:
+		periodDays = 1
+	default:
+		issues = multierror.Append(issues, fmt.Errorf("unknown rotation %q, allowed values: %s, %s", s.Rotation, RotationTypeDaily, RotationTypeWeekly))
+	}
+
+	tz := s.Timezone
+	if tz == "" {
+		tz = defaultTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		issues = multierror.Append(issues, fmt.Errorf("while loading timezone: %w", err))
+		loc = time.UTC
+	}
+
+	handoverRaw := s.Handover
+	if handoverRaw == "" {
+		handoverRaw = defaultHandover
+	}
+	handover, err := time.Parse(handoverLayout, handoverRaw)
+	if err != nil {
+		issues = multierror.Append(issues, fmt.Errorf("while parsing handover time, expected format HH:MM: %w", err))
+	}
+
+	start, err := time.ParseInLocation(time.DateOnly, s.Start, loc)
+	if err != nil {
+		issues = multierror.Append(issues, fmt.Errorf("while parsing start date, expected format YYYY-MM-DD: %w", err))
+	}
+
+	if err := issues.ErrorOrNil(); err != nil {
+		return Schedule{}, err
+	}
+
+	var participants []Assignee
+	for _, item := range s.Participants {
+		participants = append(participants, parseAssignee(item))
+	}
+
+	return Schedule{
+		Name:          s.Name,
+		Participants:  participants,
+		location:      loc,
+		periodDays:    periodDays,
+		firstHandover: time.Date(start.Year(), start.Month(), start.Day(), handover.Hour(), handover.Minute(), 0, 0, loc),
+	}, nil
+}
+
+// ShiftAt returns the shift according to the rotation at a given time. Overrides are not taken into account.
+func (s Schedule) ShiftAt(at time.Time) Shift {
+	at = at.In(s.location)
+
+	// the most recent daily handover
+	handover := time.Date(at.Year(), at.Month(), at.Day(), s.firstHandover.Hour(), s.firstHandover.Minute(), 0, 0, s.location)
+	if at.Before(handover) {
+		handover = handover.AddDate(0, 0, -1)
+	}
+
+	idx := floorDiv(daysBetween(s.firstHandover, handover), s.periodDays)
+	start := s.firstHandover.AddDate(0, 0, idx*s.periodDays)
+
+	return Shift{
+		Assignee: s.Participants[floorMod(idx, len(s.Participants))],
+		Start:    start,
+		End:      start.AddDate(0, 0, s.periodDays),
+	}
+}
+
+// Location returns the schedule time zone.
+func (s Schedule) Location() *time.Location {
+	return s.location
+}
+
+// IsParticipant returns true if a given user takes part in the rotation.
+func (s Schedule) IsParticipant(id string) bool {
+	_, found := s.participantByID(id)
+	return found
+}
+
+func (s Schedule) participantByID(id string) (Assignee, bool) {
+	for _, item := range s.Participants {
+		if item.ID == id {
+			return item, true
+		}
+	}
+	return Assignee{}, false
+}
+
+// parseAssignee parses assignee in format {id}:{name}. If the name is not specified, the ID is used.
+func parseAssignee(in string) Assignee {
+	id, displayName, found := strings.Cut(in, ":")
+	if !found {
+		displayName = id
+	}
+	return Assignee{ID: id, DisplayName: displayName}
+}
+
+// daysBetween returns the number of calendar days between given dates, ignoring the time of day and DST changes.
+func daysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+func floorDiv(a, b int) int {
+	out := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		out--
+	}
+	return out
+}
+
+func floorMod(a, b int) int {
+	return (a%b + b) % b
+}
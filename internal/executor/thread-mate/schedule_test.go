@@ -0,0 +1,148 @@
+package thread_mate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleShiftAt(t *testing.T) {
+	// given
+	participants := []string{"U1:Alice", "U2:Bob", "U3"}
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		cfg           OnCallScheduleConfig
+		at            time.Time
+		expAssignee   Assignee
+		expShiftStart time.Time
+	}{
+		{
+			name: "weekly rotation before the first handover of the week",
+			cfg:  OnCallScheduleConfig{Name: "primary", Start: "2023-08-07", Participants: participants},
+			at:   time.Date(2023, 8, 14, 8, 59, 0, 0, time.UTC),
+			expAssignee: Assignee{
+				ID:          "U1",
+				DisplayName: "Alice",
+			},
+			expShiftStart: time.Date(2023, 8, 7, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekly rotation after the handover",
+			cfg:  OnCallScheduleConfig{Name: "primary", Start: "2023-08-07", Participants: participants},
+			at:   time.Date(2023, 8, 14, 9, 0, 0, 0, time.UTC),
+			expAssignee: Assignee{
+				ID:          "U2",
+				DisplayName: "Bob",
+			},
+			expShiftStart: time.Date(2023, 8, 14, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekly rotation starts over",
+			cfg:  OnCallScheduleConfig{Name: "primary", Start: "2023-08-07", Participants: participants},
+			at:   time.Date(2023, 8, 28, 12, 0, 0, 0, time.UTC),
+			expAssignee: Assignee{
+				ID:          "U1",
+				DisplayName: "Alice",
+			},
+			expShiftStart: time.Date(2023, 8, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before the start date",
+			cfg:  OnCallScheduleConfig{Name: "primary", Start: "2023-08-07", Participants: participants},
+			at:   time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+			expAssignee: Assignee{
+				ID:          "U3",
+				DisplayName: "U3",
+			},
+			expShiftStart: time.Date(2023, 7, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daily rotation in a given timezone across DST change",
+			cfg: OnCallScheduleConfig{
+				Name:         "primary",
+				Rotation:     RotationTypeDaily,
+				Start:        "2023-10-27",
+				Handover:     "08:30",
+				Timezone:     "Europe/Warsaw",
+				Participants: participants,
+			},
+			at: time.Date(2023, 10, 30, 7, 30, 0, 0, time.UTC), // 08:30 CET
+			expAssignee: Assignee{
+				ID:          "U1",
+				DisplayName: "Alice",
+			},
+			expShiftStart: time.Date(2023, 10, 30, 8, 30, 0, 0, warsaw),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := tc.cfg.Parse()
+			require.NoError(t, err)
+
+			// when
+			shift := schedule.ShiftAt(tc.at)
+
+			// then
+			assert.Equal(t, tc.expAssignee, shift.Assignee)
+			assert.True(t, tc.expShiftStart.Equal(shift.Start), "expected shift start %s, got %s", tc.expShiftStart, shift.Start)
+		})
+	}
+}
+
+func TestOnCallScheduleConfigParseErrors(t *testing.T) {
+	// given
+	cfg := OnCallScheduleConfig{
+		Rotation: "monthly",
+		Start:    "07.08.2023",
+		Handover: "9am",
+		Timezone: "Mars/Olympus",
+	}
+
+	// when
+	_, err := cfg.Parse()
+
+	// then
+	require.Error(t, err)
+	for _, exp := range []string{"name cannot be empty", "participants list cannot be empty", `unknown rotation "monthly"`, "while loading timezone", "while parsing handover time", "while parsing start date"} {
+		assert.Contains(t, err.Error(), exp)
+	}
+}
+
+func TestThreadMateOnCallWithOverrides(t *testing.T) {
+	// given
+	schedule, err := OnCallScheduleConfig{Name: "primary", Start: "2023-08-07", Participants: []string{"U1:Alice", "U2:Bob"}}.Parse()
+	require.NoError(t, err)
+	svc := &ThreadMate{schedules: []Schedule{schedule}}
+
+	now := time.Date(2023, 8, 8, 12, 0, 0, 0, time.UTC)
+	svc.onCallOverrides.Append(Override{
+		Schedule: "primary",
+		Assignee: Assignee{ID: "U2"},
+		From:     now.Add(time.Hour),
+		Until:    now.Add(24 * time.Hour),
+	})
+
+	// when
+	current := svc.onCallAt(schedule, now)
+	overridden := svc.onCallAt(schedule, now.Add(2*time.Hour))
+
+	// then
+	assert.Equal(t, "U1", current.Assignee.ID)
+	assert.Nil(t, current.Override)
+	assert.Equal(t, now.Add(time.Hour), current.Until)
+
+	assert.Equal(t, "U2", overridden.Assignee.ID)
+	require.NotNil(t, overridden.Override)
+	assert.Equal(t, now.Add(24*time.Hour), overridden.Until)
+
+	// when the override ends
+	svc.onCallOverrides.Prune(now.Add(25 * time.Hour))
+
+	// then
+	assert.Empty(t, svc.onCallOverrides.Get())
+}
@@ -27,6 +27,7 @@ const (
 	maxMsgContextLen = 64
 	ongoingCMName    = "ongoing-threads"
 	resolvedCMName   = "resolved-threads"
+	overridesCMName  = "oncall-overrides"
 )
 
 // ThreadMate represents the main component for managing threads and interactions.
@@ -40,6 +41,9 @@ type ThreadMate struct {
 	resolvedThreads Threads
 	ongoingThreads  Threads
 
+	schedules       []Schedule
+	onCallOverrides Overrides
+
 	btnBuilder            *api.ButtonBuilder
 	cfgDumper             *ConfigMapDumper
 	cfg                   Config
@@ -50,17 +54,23 @@ type ThreadMate struct {
 func New(cfg Config, cfgDumper *ConfigMapDumper) *ThreadMate {
 	var assignees []Assignee
 	for _, item := range cfg.RoundRobin.Assignees {
-		id, displayName, found := strings.Cut(item, ":")
-		if !found {
-			displayName = id
+		assignees = append(assignees, parseAssignee(item))
+	}
+
+	var schedules []Schedule
+	for _, item := range cfg.OnCall.Schedules {
+		schedule, err := item.Parse()
+		if err != nil { // already validated when merging configuration
+			continue
 		}
-		assignees = append(assignees, Assignee{ID: id, DisplayName: displayName})
+		schedules = append(schedules, schedule)
 	}
 
 	return &ThreadMate{
 		log:       loggerx.New(cfg.Logger),
 		cfg:       cfg,
 		assignees: assignees,
+		schedules: schedules,
 		systemData: SystemData{
 			roundRobin: RoundRobin{
 				//nolint:gosec // false positive
@@ -78,11 +88,13 @@ func New(cfg Config, cfgDumper *ConfigMapDumper) *ThreadMate {
 func (t *ThreadMate) Start() {
 	t.ongoingThreads = t.tryToLoadOldThreads(ongoingCMName)
 	t.resolvedThreads = t.tryToLoadOldThreads(resolvedCMName)
+	t.onCallOverrides = t.tryToLoadOverrides()
 
 	go func() {
 		for range time.Tick(t.cfg.Persistence.SyncInterval) {
 			t.tryToDumpThreads(ongoingCMName, &t.ongoingThreads)
 			t.tryToDumpThreads(resolvedCMName, &t.resolvedThreads)
+			t.tryToDumpOverrides()
 		}
 	}()
 }
@@ -90,6 +90,24 @@ func (c *Collector) GetAllEnabledAndUsedPlugins(cfg *config.Config) ([]string, [
 				}
 			}
 		}
+
+		if commGroupCfg.Loki.Enabled {
+			for _, name := range commGroupCfg.Loki.Bindings.Sources {
+				boundSources[name] = struct{}{}
+			}
+		}
+
+		if commGroupCfg.OTLPLogs.Enabled {
+			for _, name := range commGroupCfg.OTLPLogs.Bindings.Sources {
+				boundSources[name] = struct{}{}
+			}
+		}
+
+		if commGroupCfg.Kafka.Enabled {
+			for _, name := range commGroupCfg.Kafka.Bindings.Sources {
+				boundSources[name] = struct{}{}
+			}
+		}
 	}
 
 	// Collect all used executors/sources by actions
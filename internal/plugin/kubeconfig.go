@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"fmt"
+
 	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
@@ -14,7 +16,13 @@ const (
 )
 
 type KubeConfigInput struct {
-	Channel string
+	Channel   string
+	UserID    string
+	UserEmail string
+	// UserMapping maps user IDs and emails to Kubernetes subjects. It's used only for user based policy subjects.
+	UserMapping map[string]config.K8sSubjectMapping
+	// DefaultUserMapping is used for users without a mapping. If nil, unmapped users are denied.
+	DefaultUserMapping *config.K8sSubjectMapping
 }
 
 func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx config.PluginContext, input KubeConfigInput) ([]byte, error) {
@@ -32,6 +40,15 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 		return nil, nil
 	}
 
+	user, err := generateUserSubject(rbac.User, rbac.Group, input)
+	if err != nil {
+		return nil, fmt.Errorf("while generating user subject: %w", err)
+	}
+	groups, err := generateGroupSubject(rbac.Group, input)
+	if err != nil {
+		return nil, fmt.Errorf("while generating group subject: %w", err)
+	}
+
 	apiCfg := clientcmdapi.Config{
 		Kind:       "Config",
 		APIVersion: "v1",
@@ -64,8 +81,8 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 					TokenFile:             restCfg.BearerTokenFile,
 					ClientCertificateData: restCfg.CertData,
 					ClientKeyData:         restCfg.KeyData,
-					Impersonate:           generateUserSubject(rbac.User, rbac.Group, input),
-					ImpersonateGroups:     generateGroupSubject(rbac.Group, input),
+					Impersonate:           user,
+					ImpersonateGroups:     groups,
 				},
 			},
 		},
@@ -79,12 +96,26 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 	return yamlKubeConfig, nil
 }
 
-func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicySubject, input KubeConfigInput) (user string) {
+func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicySubject, input KubeConfigInput) (user string, err error) {
 	switch rbac.Type {
 	case config.StaticPolicySubjectType:
 		user = rbac.Prefix + rbac.Static.Value
 	case config.ChannelNamePolicySubjectType:
 		user = rbac.Prefix + input.Channel
+	case config.UserIDPolicySubjectType, config.UserEmailPolicySubjectType:
+		key, err := userMappingKey(rbac.Type, input)
+		if err != nil {
+			return "", err
+		}
+		mapping, err := userMappingFor(key, input)
+		if err != nil {
+			return "", err
+		}
+		user = key
+		if mapping.User != "" {
+			user = mapping.User
+		}
+		user = rbac.Prefix + user
 	default:
 		if group.Type != config.EmptyPolicySubjectType {
 			user = "botkube-internal-static-user"
@@ -93,7 +124,7 @@ func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicy
 	return
 }
 
-func generateGroupSubject(rbac config.GroupPolicySubject, input KubeConfigInput) (group []string) {
+func generateGroupSubject(rbac config.GroupPolicySubject, input KubeConfigInput) (group []string, err error) {
 	switch rbac.Type {
 	case config.StaticPolicySubjectType:
 		for _, value := range rbac.Static.Values {
@@ -101,6 +132,40 @@ func generateGroupSubject(rbac config.GroupPolicySubject, input KubeConfigInput)
 		}
 	case config.ChannelNamePolicySubjectType:
 		group = append(group, rbac.Prefix+input.Channel)
+	case config.UserIDPolicySubjectType, config.UserEmailPolicySubjectType:
+		key, err := userMappingKey(rbac.Type, input)
+		if err != nil {
+			return nil, err
+		}
+		mapping, err := userMappingFor(key, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range mapping.Groups {
+			group = append(group, rbac.Prefix+value)
+		}
 	}
 	return
 }
+
+// userMappingFor returns the mapping for a given user. Unmapped users get the default mapping, if configured.
+func userMappingFor(key string, input KubeConfigInput) (config.K8sSubjectMapping, error) {
+	if mapping, found := input.UserMapping[key]; found {
+		return mapping, nil
+	}
+	if input.DefaultUserMapping != nil {
+		return *input.DefaultUserMapping, nil
+	}
+	return config.K8sSubjectMapping{}, fmt.Errorf("the user %q is not mapped to Kubernetes subjects, add it to the user mapping or configure the default one", key)
+}
+
+func userMappingKey(subjectType config.PolicySubjectType, input KubeConfigInput) (string, error) {
+	key := input.UserID
+	if subjectType == config.UserEmailPolicySubjectType {
+		key = input.UserEmail
+	}
+	if key == "" {
+		return "", fmt.Errorf("the %q policy subject type requires the user identity, which is not available for this platform or execution", subjectType)
+	}
+	return key, nil
+}
@@ -0,0 +1,123 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestGenerateKubeConfigUserBasedSubjects(t *testing.T) {
+	mapping := map[string]config.K8sSubjectMapping{
+		"U123": {
+			User:   "jane",
+			Groups: []string{"sre", "developers"},
+		},
+		"john@example.com": {
+			Groups: []string{"developers"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		rbac      config.PolicyRule
+		input     KubeConfigInput
+		expUser   string
+		expGroups []string
+	}{
+		{
+			name: "mapped user ID",
+			rbac: config.PolicyRule{
+				User:  config.UserPolicySubject{Type: config.UserIDPolicySubjectType, Prefix: "chat:"},
+				Group: config.GroupPolicySubject{Type: config.UserIDPolicySubjectType},
+			},
+			input:     KubeConfigInput{UserID: "U123", UserMapping: mapping},
+			expUser:   "chat:jane",
+			expGroups: []string{"sre", "developers"},
+		},
+		{
+			name: "unmapped user ID with default mapping",
+			rbac: config.PolicyRule{
+				User:  config.UserPolicySubject{Type: config.UserIDPolicySubjectType},
+				Group: config.GroupPolicySubject{Type: config.UserIDPolicySubjectType},
+			},
+			input: KubeConfigInput{UserID: "U999", UserMapping: mapping, DefaultUserMapping: &config.K8sSubjectMapping{
+				User:   "botkube-guest",
+				Groups: []string{"viewers"},
+			}},
+			expUser:   "botkube-guest",
+			expGroups: []string{"viewers"},
+		},
+		{
+			name: "user email without explicit user mapping",
+			rbac: config.PolicyRule{
+				User:  config.UserPolicySubject{Type: config.UserEmailPolicySubjectType},
+				Group: config.GroupPolicySubject{Type: config.UserEmailPolicySubjectType, Prefix: "oidc:"},
+			},
+			input:     KubeConfigInput{UserID: "U456", UserEmail: "john@example.com", UserMapping: mapping},
+			expUser:   "john@example.com",
+			expGroups: []string{"oidc:developers"},
+		},
+		{
+			name: "user based groups with static user",
+			rbac: config.PolicyRule{
+				User:  config.UserPolicySubject{Type: config.StaticPolicySubjectType, Static: config.UserStaticSubject{Value: "botkube"}},
+				Group: config.GroupPolicySubject{Type: config.UserIDPolicySubjectType},
+			},
+			input:     KubeConfigInput{UserID: "U123", UserMapping: mapping},
+			expUser:   "botkube",
+			expGroups: []string{"sre", "developers"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out, err := GenerateKubeConfig(&rest.Config{Host: "https://localhost"}, "dev", config.PluginContext{RBAC: &tc.rbac}, tc.input)
+
+			// then
+			require.NoError(t, err)
+
+			var kubeconfig clientcmdapi.Config
+			require.NoError(t, yaml.Unmarshal(out, &kubeconfig))
+			require.Len(t, kubeconfig.AuthInfos, 1)
+			assert.Equal(t, tc.expUser, kubeconfig.AuthInfos[0].AuthInfo.Impersonate)
+			assert.Equal(t, tc.expGroups, kubeconfig.AuthInfos[0].AuthInfo.ImpersonateGroups)
+		})
+	}
+}
+
+func TestGenerateKubeConfigUnmappedUser(t *testing.T) {
+	// given
+	rbac := config.PolicyRule{
+		User: config.UserPolicySubject{Type: config.UserIDPolicySubjectType},
+	}
+
+	// when
+	_, err := GenerateKubeConfig(&rest.Config{}, "dev", config.PluginContext{RBAC: &rbac}, KubeConfigInput{
+		UserID: "U999",
+		UserMapping: map[string]config.K8sSubjectMapping{
+			"U123": {User: "jane"},
+		},
+	})
+
+	// then
+	assert.EqualError(t, err, `while generating user subject: the user "U999" is not mapped to Kubernetes subjects, add it to the user mapping or configure the default one`)
+}
+
+func TestGenerateKubeConfigMissingUserIdentity(t *testing.T) {
+	// given
+	rbac := config.PolicyRule{
+		User: config.UserPolicySubject{Type: config.UserEmailPolicySubjectType},
+	}
+
+	// when
+	_, err := GenerateKubeConfig(&rest.Config{}, "dev", config.PluginContext{RBAC: &rbac}, KubeConfigInput{UserID: "U123"})
+
+	// then
+	assert.EqualError(t, err, `while generating user subject: the "UserEmail" policy subject type requires the user identity, which is not available for this platform or execution`)
+}
@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// UserMappingConfigMapKey is the ConfigMap data key under which user mappings are stored.
+// Mappings are stored as a single YAML document, as user emails are not valid ConfigMap keys.
+const UserMappingConfigMapKey = "mapping.yaml"
+
+// UserMappingLoader loads mappings of communication platform users to Kubernetes subjects.
+type UserMappingLoader struct {
+	log         logrus.FieldLogger
+	k8sCli      kubernetes.Interface
+	static      map[string]config.K8sSubjectMapping
+	defaultUser *config.K8sSubjectMapping
+	ref         config.K8sResourceRef
+}
+
+// NewUserMappingLoader returns a new UserMappingLoader instance.
+func NewUserMappingLoader(log logrus.FieldLogger, cfg config.Settings, k8sCli kubernetes.Interface) *UserMappingLoader {
+	ref := cfg.UserMapping.ConfigMap
+	if ref.Name != "" && ref.Namespace == "" {
+		ref.Namespace = cfg.SystemConfigMap.Namespace
+	}
+
+	return &UserMappingLoader{
+		log:         log,
+		k8sCli:      k8sCli,
+		static:      cfg.UserMapping.Static,
+		defaultUser: cfg.UserMapping.Default,
+		ref:         ref,
+	}
+}
+
+// Default returns the mapping for users without a mapping. Returns nil if not configured.
+func (l *UserMappingLoader) Default() *config.K8sSubjectMapping {
+	if l == nil {
+		return nil
+	}
+	return l.defaultUser
+}
+
+// Load returns static mappings merged with the ones from the ConfigMap.
+// The ConfigMap is read on each call, so the mapping changes are applied without restarting Botkube.
+func (l *UserMappingLoader) Load(ctx context.Context) (map[string]config.K8sSubjectMapping, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	out := make(map[string]config.K8sSubjectMapping, len(l.static))
+	for key, mapping := range l.static {
+		out[key] = mapping
+	}
+
+	if l.ref.Name == "" {
+		return out, nil
+	}
+
+	cm, err := l.k8sCli.CoreV1().ConfigMaps(l.ref.Namespace).Get(ctx, l.ref.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		l.log.Debugf("User mapping ConfigMap %s/%s not found. Using only static mappings.", l.ref.Namespace, l.ref.Name)
+		return out, nil
+	default:
+		return nil, fmt.Errorf("while getting the user mapping ConfigMap: %w", err)
+	}
+
+	data, found := cm.Data[UserMappingConfigMapKey]
+	if !found {
+		return out, nil
+	}
+
+	var mappings map[string]config.K8sSubjectMapping
+	if err := yaml.Unmarshal([]byte(data), &mappings); err != nil {
+		return nil, fmt.Errorf("while unmarshaling the user mapping data: %w", err)
+	}
+	for key, mapping := range mappings {
+		out[key] = mapping
+	}
+	return out, nil
+}
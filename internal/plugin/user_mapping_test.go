@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestUserMappingLoaderLoad(t *testing.T) {
+	// given
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "botkube-user-mapping",
+			Namespace: "botkube",
+		},
+		Data: map[string]string{
+			UserMappingConfigMapKey: heredoc.Doc(`
+				U123:
+				  user: jane
+				  groups: [sre]
+				john@example.com:
+				  groups: [developers]`),
+		},
+	}
+	cfg := config.Settings{
+		SystemConfigMap: config.K8sResourceRef{Namespace: "botkube"},
+		UserMapping: config.UserMapping{
+			Static: map[string]config.K8sSubjectMapping{
+				"U123": {User: "jane-static"},
+				"U456": {User: "bob"},
+			},
+			ConfigMap: config.K8sResourceRef{Name: "botkube-user-mapping"},
+		},
+	}
+	loader := NewUserMappingLoader(loggerx.NewNoop(), cfg, fake.NewSimpleClientset(cm))
+
+	// when
+	out, err := loader.Load(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]config.K8sSubjectMapping{
+		"U123":             {User: "jane", Groups: []string{"sre"}},
+		"U456":             {User: "bob"},
+		"john@example.com": {Groups: []string{"developers"}},
+	}, out)
+}
+
+func TestUserMappingLoaderLoadMissingConfigMap(t *testing.T) {
+	// given
+	cfg := config.Settings{
+		UserMapping: config.UserMapping{
+			Static: map[string]config.K8sSubjectMapping{
+				"U456": {User: "bob"},
+			},
+			ConfigMap: config.K8sResourceRef{Name: "botkube-user-mapping", Namespace: "botkube"},
+		},
+	}
+	loader := NewUserMappingLoader(loggerx.NewNoop(), cfg, fake.NewSimpleClientset())
+
+	// when
+	out, err := loader.Load(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]config.K8sSubjectMapping{
+		"U456": {User: "bob"},
+	}, out)
+}
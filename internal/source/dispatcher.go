@@ -4,17 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/client-go/rest"
 
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/eventhistory"
 	"github.com/kubeshop/botkube/internal/plugin"
 	"github.com/kubeshop/botkube/pkg/action"
+	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
 	"github.com/kubeshop/botkube/pkg/bot"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
@@ -30,17 +35,35 @@ type Dispatcher struct {
 	actionProvider       ActionProvider
 	reporter             AnalyticsReporter
 	auditReporter        audit.AuditReporter
+	eventHistory         eventhistory.Store
 	markdownNotifiers    []notifier.Bot
 	interactiveNotifiers []notifier.Bot
 	sinkNotifiers        []notifier.Sink
 	restCfg              *rest.Config
 	clusterName          string
+
+	throttlersMu sync.Mutex
+	throttlers   map[string]*eventThrottler
+
+	incidentTrackersMu sync.Mutex
+	incidentTrackers   map[string]*incidentTracker
+
+	// actionWorkers limits the number of automated actions executed at the same time.
+	actionWorkers chan struct{}
 }
 
+const (
+	// throttlingSummaryTimeout is the timeout for sending a throttling summary message.
+	throttlingSummaryTimeout = 30 * time.Second
+	// maxActionWorkers is the maximum number of automated actions executed at the same time for all sources.
+	// Once reached, dispatching next events waits for a free worker.
+	maxActionWorkers = 20
+)
+
 // ActionProvider defines a provider that is responsible for automated actions.
 type ActionProvider interface {
 	RenderedActions(data any, sourceBindings []string) ([]action.Action, error)
-	ExecuteAction(ctx context.Context, action action.Action) interactive.CoreMessage
+	ExecuteAction(ctx context.Context, action action.Action) (action.Result, error)
 }
 
 // AnalyticsReporter defines a reporter that collects analytics data.
@@ -59,7 +82,7 @@ type AnalyticsReporter interface {
 }
 
 // NewDispatcher create a new Dispatcher instance.
-func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[string]bot.Bot, sinkNotifiers []notifier.Sink, manager *plugin.Manager, actionProvider ActionProvider, reporter AnalyticsReporter, auditReporter audit.AuditReporter, restCfg *rest.Config) *Dispatcher {
+func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[string]bot.Bot, sinkNotifiers []notifier.Sink, manager *plugin.Manager, actionProvider ActionProvider, reporter AnalyticsReporter, auditReporter audit.AuditReporter, eventHistory eventhistory.Store, restCfg *rest.Config) *Dispatcher {
 	var (
 		interactiveNotifiers []notifier.Bot
 		markdownNotifiers    []notifier.Bot
@@ -79,11 +102,15 @@ func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[str
 		actionProvider:       actionProvider,
 		reporter:             reporter,
 		auditReporter:        auditReporter,
+		eventHistory:         eventHistory,
 		interactiveNotifiers: interactiveNotifiers,
 		markdownNotifiers:    markdownNotifiers,
 		sinkNotifiers:        sinkNotifiers,
 		restCfg:              restCfg,
 		clusterName:          clusterName,
+		throttlers:           map[string]*eventThrottler{},
+		incidentTrackers:     map[string]*incidentTracker{},
+		actionWorkers:        make(chan struct{}, maxActionWorkers),
 	}
 }
 
@@ -97,6 +124,7 @@ func (d *Dispatcher) Dispatch(dispatch PluginDispatch) error {
 	})
 
 	log.Info("Start source streaming...")
+	d.resetSourceState(dispatch)
 
 	sourceClient, err := d.manager.GetSource(dispatch.pluginName)
 	if err != nil {
@@ -165,6 +193,11 @@ func (d *Dispatcher) DispatchExternalRequest(dispatch ExternalRequestDispatch) e
 		return fmt.Errorf(`while handling external request for "%s.%s" source: %w`, dispatch.sourceName, dispatch.pluginName, err)
 	}
 
+	if out.Event.Message.IsEmpty() {
+		d.log.Debugf("External request for %s produced no event. Skipping...", dispatch.pluginName)
+		return nil
+	}
+
 	d.dispatchMsg(ctx, out.Event, dispatch.PluginDispatch)
 
 	return nil
@@ -177,6 +210,153 @@ func (d *Dispatcher) getBotNotifiers(dispatch PluginDispatch) []notifier.Bot {
 	return d.markdownNotifiers
 }
 
+// getThrottledBotNotifiers returns bot notifiers that should receive a given event according to the source deduplication configuration,
+// together with the throttler limiting the notifications rate. The returned throttler is nil if throttling is not enabled.
+func (d *Dispatcher) getThrottledBotNotifiers(event source.Event, dispatch PluginDispatch) ([]notifier.Bot, *eventThrottler) {
+	notifiers := d.getBotNotifiers(dispatch)
+	throttler := d.getThrottler(dispatch)
+	if throttler == nil {
+		return notifiers, nil
+	}
+
+	if throttler.ShouldSuppress(event) {
+		d.log.WithField("sourceName", dispatch.sourceName).Debug("Suppressing similar event...")
+		return nil, throttler
+	}
+	return notifiers, throttler
+}
+
+// sendEventNotification sends a given event notification. Rate limits are applied per channel for bots which support it,
+// and per bot otherwise.
+func (d *Dispatcher) sendEventNotification(ctx context.Context, n notifier.Bot, msg interactive.CoreMessage, sources []string, throttler *eventThrottler) error {
+	if throttler == nil {
+		return n.SendMessage(ctx, msg, sources)
+	}
+
+	if gated, ok := n.(notifier.GatedBot); ok {
+		return gated.SendGatedMessage(ctx, msg, sources, throttler.ChannelGate(n))
+	}
+
+	if !throttler.Allow(n, "") {
+		d.log.WithFields(logrus.Fields{
+			"sources":  sources,
+			"platform": n.IntegrationName(),
+		}).Debug("Notification rate limit exceeded. Dropping event...")
+		return nil
+	}
+	return n.SendMessage(ctx, msg, sources)
+}
+
+// getThrottler returns a throttler for a given source. Returns nil if throttling is not enabled.
+func (d *Dispatcher) getThrottler(dispatch PluginDispatch) *eventThrottler {
+	if dispatch.cfg == nil {
+		return nil
+	}
+	cfg := dispatch.cfg.Sources[dispatch.sourceName].Throttling
+	if !cfg.IsEnabled() {
+		return nil
+	}
+
+	key := sourceStateKey(dispatch)
+
+	d.throttlersMu.Lock()
+	defer d.throttlersMu.Unlock()
+
+	throttler, found := d.throttlers[key]
+	if !found {
+		throttler = newEventThrottler(d.log, cfg, dispatch.sourceName, d.getBotNotifiers(dispatch), d.sendThrottlingSummaryFn(dispatch))
+		d.throttlers[key] = throttler
+	}
+	return throttler
+}
+
+// trackIncident returns the incident a given event belongs to. Returns nil if incident grouping is not enabled for the source.
+func (d *Dispatcher) trackIncident(meta interactive.EventMetadata, dispatch PluginDispatch) (*interactive.Incident, *openIncident) {
+	tracker := d.getIncidentTracker(dispatch)
+	if tracker == nil {
+		return nil, nil
+	}
+
+	incident, state, err := tracker.Track(meta)
+	if err != nil {
+		d.log.WithField("sourceName", dispatch.sourceName).Errorf("while grouping event into incident: %s", err.Error())
+		return nil, nil
+	}
+	return incident, state
+}
+
+// getIncidentTracker returns an incident tracker for a given source. Returns nil if incident grouping is not enabled.
+func (d *Dispatcher) getIncidentTracker(dispatch PluginDispatch) *incidentTracker {
+	if dispatch.cfg == nil {
+		return nil
+	}
+	cfg := dispatch.cfg.Sources[dispatch.sourceName].Incidents
+	if !cfg.Enabled {
+		return nil
+	}
+
+	key := sourceStateKey(dispatch)
+
+	d.incidentTrackersMu.Lock()
+	defer d.incidentTrackersMu.Unlock()
+
+	tracker, found := d.incidentTrackers[key]
+	if !found {
+		var err error
+		tracker, err = newIncidentTracker(cfg)
+		if err != nil {
+			d.log.WithField("sourceName", dispatch.sourceName).Errorf("while creating incident tracker: %s", err.Error())
+			return nil
+		}
+		tracker.StartCleanup(dispatch.ctx)
+		d.incidentTrackers[key] = tracker
+	}
+	return tracker
+}
+
+// resetSourceState drops the throttling and incident state of a given source, so a (re)started source uses its current configuration.
+func (d *Dispatcher) resetSourceState(dispatch PluginDispatch) {
+	key := sourceStateKey(dispatch)
+
+	d.throttlersMu.Lock()
+	delete(d.throttlers, key)
+	d.throttlersMu.Unlock()
+
+	d.incidentTrackersMu.Lock()
+	if tracker, found := d.incidentTrackers[key]; found {
+		tracker.Stop()
+		delete(d.incidentTrackers, key)
+	}
+	d.incidentTrackersMu.Unlock()
+}
+
+// sourceStateKey returns the key of the in-memory source state.
+// The same source is started separately for interactive and non-interactive platforms.
+func sourceStateKey(dispatch PluginDispatch) string {
+	return fmt.Sprintf("%s/interactive/%v", dispatch.sourceName, dispatch.isInteractivitySupported)
+}
+
+func (d *Dispatcher) sendThrottlingSummaryFn(dispatch PluginDispatch) sendToNotifierFn {
+	sources := []string{dispatch.sourceName}
+	return func(n notifier.Bot, channel string, msg interactive.CoreMessage) {
+		defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+
+		// the original dispatch context may be already cancelled, e.g. for external requests
+		ctx, cancel := context.WithTimeout(context.Background(), throttlingSummaryTimeout)
+		defer cancel()
+
+		var err error
+		if channel == "" {
+			err = n.SendMessage(ctx, msg, sources)
+		} else {
+			err = n.SendMessageToChannels(ctx, msg, []string{channel})
+		}
+		if err != nil {
+			d.log.Errorf("while sending throttling summary message: %s", err.Error())
+		}
+	}
+}
+
 func (d *Dispatcher) getSinkNotifiers(dispatch PluginDispatch) []notifier.Sink {
 	if dispatch.isInteractivitySupported {
 		return nil // we shouldn't forward interactive events
@@ -190,13 +370,34 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 		sources    = []string{dispatch.sourceName}
 	)
 
-	for _, n := range d.getBotNotifiers(dispatch) {
+	d.recordEvent(ctx, event, dispatch)
+
+	// correlationID allows sending action results in the thread of the event notification
+	correlationID := uuid.New().String()
+	meta := eventMetadata(event, dispatch.sourceName)
+	meta.CorrelationID = correlationID
+
+	notifiers, throttler := d.getThrottledBotNotifiers(event, dispatch)
+	var incident *openIncident
+	if len(notifiers) > 0 {
+		meta.Incident, incident = d.trackIncident(meta, dispatch)
+	}
+
+	var notificationsSent sync.WaitGroup
+	for _, n := range notifiers {
+		notificationsSent.Add(1)
 		go func(n notifier.Bot) {
 			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+			defer notificationsSent.Done()
+			if meta.Incident != nil && meta.Incident.FollowUp {
+				// the first incident notification must be sent first, so the thread reference is known
+				incident.WaitNotified(ctx)
+			}
 			msg := interactive.CoreMessage{
-				Message: event.Message,
+				Message:  event.Message,
+				Metadata: meta,
 			}
-			err := n.SendMessage(ctx, msg, sources)
+			err := d.sendEventNotification(ctx, n, msg, sources, throttler)
 			if err != nil {
 				reportErr := d.reportError(err, n, pluginName, event)
 				if reportErr != nil {
@@ -213,6 +414,12 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 			}
 		}(n)
 	}
+	if incident != nil && !meta.Incident.FollowUp {
+		go func() {
+			notificationsSent.Wait()
+			incident.MarkNotified()
+		}()
+	}
 
 	for _, n := range d.getSinkNotifiers(dispatch) {
 		go func(n notifier.Sink) {
@@ -246,23 +453,98 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 		return
 	}
 	for _, act := range actions {
-		log := d.log.WithFields(logrus.Fields{
-			"name":    act.DisplayName,
-			"command": act.Command,
-		})
-		log.Infof("Executing automated action...")
-		genericMsg := d.actionProvider.ExecuteAction(ctx, act)
-		log.WithField("message", fmt.Sprintf("%+v", genericMsg)).Debug("Automated action executed. Printing output message...")
-
-		for _, n := range d.getBotNotifiers(dispatch) {
-			go func(n notifier.Bot) {
-				defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
-				err := n.SendMessage(ctx, genericMsg, sources)
-				if err != nil {
-					d.log.Errorf("while sending action result message: %s", err.Error())
-				}
-			}(n)
+		// actions are executed in the background, so long-running commands don't block the source stream,
+		// and the action concurrency and deduplication limits can take effect
+		select {
+		case d.actionWorkers <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		go func(act action.Action) {
+			defer func() { <-d.actionWorkers }()
+			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+			d.executeAction(ctx, act, correlationID, &notificationsSent, dispatch)
+		}(act)
+	}
+}
+
+// executeAction executes a given automated action and sends its result.
+func (d *Dispatcher) executeAction(ctx context.Context, act action.Action, correlationID string, notificationsSent *sync.WaitGroup, dispatch PluginDispatch) {
+	log := d.log.WithFields(logrus.Fields{
+		"name":    act.DisplayName,
+		"command": act.Command,
+	})
+	log.Infof("Executing automated action...")
+	result, err := d.actionProvider.ExecuteAction(ctx, act)
+	switch {
+	case action.IsSkippedActionError(err):
+		log.Info(err.Error())
+		return
+	case err != nil:
+		log.Errorf("while executing automated action: %s", err.Error())
+		return
+	}
+	log.WithField("message", fmt.Sprintf("%+v", result.Message)).Debug("Automated action executed. Printing output message...")
+
+	if act.Output.OnlyOnFailure && !result.Failed {
+		log.Debug("Action output is sent only on failure. Skipping...")
+		return
+	}
+
+	d.sendActionResult(ctx, act, result, correlationID, notificationsSent, dispatch)
+}
+
+// sendActionResult sends the action result to the configured output destinations.
+func (d *Dispatcher) sendActionResult(ctx context.Context, act action.Action, result action.Result, correlationID string, notificationsSent *sync.WaitGroup, dispatch PluginDispatch) {
+	sources := []string{dispatch.sourceName}
+
+	for _, n := range d.getSinkNotifiers(dispatch) {
+		if !slices.Contains(act.Output.Sinks, n.IntegrationName()) {
+			continue
 		}
+		go func(n notifier.Sink) {
+			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+			err := n.SendEvent(ctx, result.SinkEvent(act), sources)
+			if err != nil {
+				d.log.Errorf("while sending action result to %s sink: %s", n.IntegrationName(), err.Error())
+			}
+		}(n)
+	}
+
+	msg := result.Message
+	msg.Metadata = interactive.ActionMetadata{
+		ActionName:         act.Name,
+		EventCorrelationID: correlationID,
+	}
+	switch act.Output.Mode {
+	case config.NoneActionOutputMode:
+		return
+	case config.ThreadActionOutputMode:
+		msg.Type = api.ThreadMessage
+		// the event notification must be sent first, so the thread reference is known.
+		// Wait for it in the background, so the source stream is not blocked.
+		go func() {
+			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+			notificationsSent.Wait()
+			d.sendActionMessage(ctx, msg, dispatch)
+		}()
+		return
+	}
+
+	d.sendActionMessage(ctx, msg, dispatch)
+}
+
+// sendActionMessage sends the action result message to bots bound to the dispatched source.
+func (d *Dispatcher) sendActionMessage(ctx context.Context, msg interactive.CoreMessage, dispatch PluginDispatch) {
+	sources := []string{dispatch.sourceName}
+	for _, n := range d.getBotNotifiers(dispatch) {
+		go func(n notifier.Bot) {
+			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+			err := n.SendMessage(ctx, msg, sources)
+			if err != nil {
+				d.log.Errorf("while sending action result message: %s", err.Error())
+			}
+		}(n)
 	}
 }
 
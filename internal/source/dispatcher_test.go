@@ -0,0 +1,327 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/eventhistory"
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/action"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+func TestDispatcherActionOutput(t *testing.T) {
+	// given
+	testCases := []struct {
+		name             string
+		output           config.ActionOutput
+		failed           bool
+		expMessagesCount int
+		expMessageType   api.MessageType
+		expSinkEvents    int
+	}{
+		{
+			name:             "Default mode posts a new message",
+			expMessagesCount: 2,
+			expMessageType:   api.DefaultMessage,
+		},
+		{
+			name: "Thread mode replies in the event notification thread",
+			output: config.ActionOutput{
+				Mode: config.ThreadActionOutputMode,
+			},
+			expMessagesCount: 2,
+			expMessageType:   api.ThreadMessage,
+		},
+		{
+			name: "None mode sends output only to sinks",
+			output: config.ActionOutput{
+				Mode:  config.NoneActionOutputMode,
+				Sinks: []config.CommPlatformIntegration{config.WebhookCommPlatformIntegration},
+			},
+			expMessagesCount: 1,
+			expSinkEvents:    1,
+		},
+		{
+			name: "Successful output is suppressed",
+			output: config.ActionOutput{
+				OnlyOnFailure: true,
+				Sinks:         []config.CommPlatformIntegration{config.WebhookCommPlatformIntegration},
+			},
+			expMessagesCount: 1,
+		},
+		{
+			name: "Failed output is sent",
+			output: config.ActionOutput{
+				OnlyOnFailure: true,
+				Sinks:         []config.CommPlatformIntegration{config.WebhookCommPlatformIntegration},
+			},
+			failed:           true,
+			expMessagesCount: 2,
+			expMessageType:   api.DefaultMessage,
+			expSinkEvents:    1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			botNotifier := &fakeBotNotifier{}
+			sink := &fakeSink{}
+			actionProvider := &fakeActionProvider{
+				actions: []action.Action{
+					{Name: "logs", DisplayName: "Logs", Command: "kubectl logs foo", Output: tc.output},
+				},
+				result: action.Result{
+					Message: interactive.CoreMessage{
+						Message: api.NewCodeBlockMessage("panic: oops", false),
+					},
+					Failed: tc.failed,
+				},
+			}
+			dispatcher := NewDispatcher(
+				loggerx.NewNoop(),
+				"cluster",
+				map[string]bot.Bot{"fake": botNotifier},
+				[]notifier.Sink{sink},
+				nil,
+				actionProvider,
+				analytics.NewNoopReporter(),
+				audit.GetReporter(false, loggerx.NewNoop(), nil),
+				eventhistory.NewNoopStore(),
+				nil,
+			)
+
+			// when
+			dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), fixPluginDispatch(config.SourceThrottling{}))
+
+			// then
+			require.Eventually(t, func() bool {
+				return botNotifier.Count() == tc.expMessagesCount && sink.Count() == tc.expSinkEvents
+			}, time.Second, 10*time.Millisecond)
+
+			if tc.expMessagesCount < 2 {
+				return
+			}
+
+			botNotifier.mu.Lock()
+			defer botNotifier.mu.Unlock()
+			var eventMsg, actionMsg interactive.CoreMessage
+			for _, msg := range botNotifier.messages {
+				if _, ok := msg.Metadata.(interactive.ActionMetadata); ok {
+					actionMsg = msg
+					continue
+				}
+				eventMsg = msg
+			}
+
+			eventMeta, ok := eventMsg.Metadata.(interactive.EventMetadata)
+			require.True(t, ok)
+			assert.NotEmpty(t, eventMeta.CorrelationID)
+			assert.Equal(t, interactive.ActionMetadata{
+				ActionName:         "logs",
+				EventCorrelationID: eventMeta.CorrelationID,
+			}, actionMsg.Metadata)
+			assert.Equal(t, tc.expMessageType, actionMsg.Type)
+		})
+	}
+}
+
+func TestDispatcherThreadActionOutputDoesNotBlockDispatch(t *testing.T) {
+	// given
+	botNotifier := &fakeBotNotifier{blockEvents: make(chan struct{})}
+	actionProvider := &fakeActionProvider{
+		actions: []action.Action{
+			{Name: "logs", DisplayName: "Logs", Command: "kubectl logs foo", Output: config.ActionOutput{Mode: config.ThreadActionOutputMode}},
+		},
+		result: action.Result{
+			Message: interactive.CoreMessage{
+				Message: api.NewCodeBlockMessage("panic: oops", false),
+			},
+		},
+	}
+	dispatcher := NewDispatcher(
+		loggerx.NewNoop(),
+		"cluster",
+		map[string]bot.Bot{"fake": botNotifier},
+		nil,
+		nil,
+		actionProvider,
+		analytics.NewNoopReporter(),
+		audit.GetReporter(false, loggerx.NewNoop(), nil),
+		eventhistory.NewNoopStore(),
+		nil,
+	)
+
+	// when
+	dispatched := make(chan struct{})
+	go func() {
+		dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), fixPluginDispatch(config.SourceThrottling{}))
+		close(dispatched)
+	}()
+
+	// then the dispatch doesn't wait for the event notification
+	select {
+	case <-dispatched:
+	case <-time.After(time.Second):
+		t.Fatal("dispatch is blocked by the pending event notification")
+	}
+	assert.Zero(t, botNotifier.Count())
+
+	// when
+	close(botNotifier.blockEvents)
+
+	// then the action output is sent after the event notification
+	require.Eventually(t, func() bool {
+		return botNotifier.Count() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	botNotifier.mu.Lock()
+	defer botNotifier.mu.Unlock()
+	assert.IsType(t, interactive.EventMetadata{}, botNotifier.messages[0].Metadata)
+	assert.IsType(t, interactive.ActionMetadata{}, botNotifier.messages[1].Metadata)
+}
+
+func TestDispatcherActionLimits(t *testing.T) {
+	// given
+	testCases := []struct {
+		name      string
+		cfg       config.Action
+		secondPod string
+		expReason string
+	}{
+		{
+			name: "Concurrency limit",
+			cfg: config.Action{
+				MaxConcurrent: 1,
+			},
+			secondPod: "other-pod",
+			expReason: "max_concurrent",
+		},
+		{
+			name: "Deduplication of running executions",
+			cfg: config.Action{
+				DedupKey: "{{ .Event.Namespace }}/{{ .Event.Name }}",
+			},
+			secondPod: "crashing-pod",
+			expReason: "duplicate",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionCfg := tc.cfg
+			actionCfg.Enabled = true
+			actionCfg.DisplayName = "Logs"
+			actionCfg.Command = "kubectl logs {{ .Event.Name }}"
+			actionCfg.Bindings.Sources = []string{"k8s-events"}
+			actionCfg.Output.Mode = config.NoneActionOutputMode
+
+			execFactory := &blockingExecutorFactory{
+				started: make(chan struct{}, 2),
+				release: make(chan struct{}),
+			}
+			actionProvider := &observedActionProvider{
+				ActionProvider: action.NewProvider(loggerx.NewNoop(), config.Actions{"logs": actionCfg}, execFactory),
+				results:        make(chan error, 2),
+			}
+			dispatcher := newTestDispatcher(&fakeBotNotifier{})
+			dispatcher.actionProvider = actionProvider
+
+			// when
+			dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), fixPluginDispatch(config.SourceThrottling{}))
+
+			// then the action is still running, but the dispatch is not blocked
+			<-execFactory.started
+
+			// when
+			dispatcher.dispatchMsg(context.Background(), fixK8sEvent(tc.secondPod, "BackOff"), fixPluginDispatch(config.SourceThrottling{}))
+
+			// then
+			err := <-actionProvider.results
+			assert.EqualError(t, err, fmt.Sprintf(`execution of Action "Logs" skipped (reason: %s)`, tc.expReason))
+
+			// when
+			close(execFactory.release)
+
+			// then
+			require.NoError(t, <-actionProvider.results)
+		})
+	}
+}
+
+type observedActionProvider struct {
+	ActionProvider
+	results chan error
+}
+
+func (o *observedActionProvider) ExecuteAction(ctx context.Context, act action.Action) (action.Result, error) {
+	result, err := o.ActionProvider.ExecuteAction(ctx, act)
+	o.results <- err
+	return result, err
+}
+
+type blockingExecutorFactory struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (f *blockingExecutorFactory) NewDefault(execute.NewDefaultInput) execute.Executor {
+	return f
+}
+
+func (f *blockingExecutorFactory) Execute(context.Context) interactive.CoreMessage {
+	f.started <- struct{}{}
+	<-f.release
+	return interactive.CoreMessage{Message: api.NewPlaintextMessage("logs", false)}
+}
+
+type fakeSink struct {
+	mu     sync.Mutex
+	events []any
+}
+
+func (f *fakeSink) SendEvent(_ context.Context, event any, _ []string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeSink) IntegrationName() config.CommPlatformIntegration {
+	return config.WebhookCommPlatformIntegration
+}
+
+func (f *fakeSink) Type() config.IntegrationType {
+	return config.SinkIntegrationType
+}
+
+func (f *fakeSink) GetStatus() health.PlatformStatus {
+	return health.PlatformStatus{}
+}
+
+func (f *fakeSink) Count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	count := 0
+	for _, event := range f.events {
+		if _, ok := event.(action.ResultEvent); ok {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,36 @@
+package source
+
+import (
+	"fmt"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+var (
+	namespaceFieldPaths = []string{"namespace", "labels.namespace"}
+	levelFieldPaths     = []string{"level", "labels.severity"}
+)
+
+// eventMetadata returns metadata for a given event based on the most common event fields.
+func eventMetadata(event source.Event, sourceName string) interactive.EventMetadata {
+	obj := maputil.AsGeneric(event.RawObject)
+	return interactive.EventMetadata{
+		SourceName: sourceName,
+		Namespace:  firstFieldAsString(obj, namespaceFieldPaths),
+		Level:      firstFieldAsString(obj, levelFieldPaths),
+		Event:      obj,
+	}
+}
+
+func firstFieldAsString(obj map[string]any, paths []string) string {
+	for _, path := range paths {
+		val, found := maputil.Lookup(obj, path)
+		if !found {
+			continue
+		}
+		return fmt.Sprint(val)
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/eventhistory"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+// maxEventTitleLength is the maximum length of the event title stored in the event history.
+const maxEventTitleLength = 100
+
+// recordEvent stores a given event in the event history. Raw objects bigger than eventhistory.MaxRawObjectSize are not stored.
+func (d *Dispatcher) recordEvent(ctx context.Context, event source.Event, dispatch PluginDispatch) {
+	if !eventhistory.IsEnabled(d.eventHistory) {
+		return
+	}
+
+	meta := eventMetadata(event, dispatch.sourceName)
+
+	rawObject, err := json.Marshal(event.RawObject)
+	if err != nil {
+		d.log.Errorf("while marshaling event for history: %s", err.Error())
+		return
+	}
+	if len(rawObject) > eventhistory.MaxRawObjectSize {
+		d.log.Debugf("Skipping raw object of %d bytes in event history for source %q.", len(rawObject), dispatch.sourceName)
+		rawObject = nil
+	}
+
+	timestamp := event.Message.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	_, err = d.eventHistory.Add(ctx, eventhistory.Event{
+		SourceName:  dispatch.sourceName,
+		Interactive: dispatch.isInteractivitySupported,
+		Timestamp:   timestamp,
+		Namespace:   meta.Namespace,
+		Level:       meta.Level,
+		Title:       eventTitle(event.Message),
+		RawObject:   rawObject,
+	})
+	if err != nil {
+		d.log.Errorf("while storing event in history for source %q: %s", dispatch.sourceName, err.Error())
+	}
+}
+
+// eventTitle returns a short, single line summary of a given message.
+func eventTitle(msg api.Message) string {
+	candidates := []string{msg.BaseBody.Plaintext, msg.BaseBody.CodeBlock}
+	for _, section := range msg.Sections {
+		candidates = append(candidates, section.Header, section.Description, section.Body.Plaintext, section.Body.CodeBlock)
+	}
+
+	for _, candidate := range candidates {
+		line, _, _ := strings.Cut(strings.TrimSpace(candidate), "\n")
+		if line == "" {
+			continue
+		}
+
+		runes := []rune(line)
+		if len(runes) > maxEventTitleLength {
+			return string(runes[:maxEventTitleLength-1]) + "…"
+		}
+		return line
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package source
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/eventhistory"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDispatcherRecordsEventHistory(t *testing.T) {
+	// given
+	store := eventhistory.NewConfigMapStore(loggerx.NewNoop(), fake.NewSimpleClientset(), config.K8sResourceRef{Name: "events", Namespace: "botkube"}, config.EventHistoryRetention{})
+	dispatcher := newTestDispatcher(&fakeBotNotifier{})
+	dispatcher.eventHistory = store
+
+	// when
+	dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), fixPluginDispatch(config.SourceThrottling{}))
+
+	// then
+	events, err := store.List(context.Background(), eventhistory.Query{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	assert.Equal(t, "1", event.ID)
+	assert.Equal(t, "k8s-events", event.SourceName)
+	assert.Equal(t, "default", event.Namespace)
+	assert.Equal(t, "crashing-pod", event.Title)
+	assert.False(t, event.Timestamp.IsZero())
+	assert.JSONEq(t, `{"Kind":"Pod","Namespace":"default","Name":"crashing-pod","Reason":"BackOff"}`, string(event.RawObject))
+}
+
+func TestDispatcherSkipsLargeRawObjectsInEventHistory(t *testing.T) {
+	// given
+	store := eventhistory.NewConfigMapStore(loggerx.NewNoop(), fake.NewSimpleClientset(), config.K8sResourceRef{Name: "events", Namespace: "botkube"}, config.EventHistoryRetention{})
+	dispatcher := newTestDispatcher(&fakeBotNotifier{})
+	dispatcher.eventHistory = store
+
+	event := fixK8sEvent("crashing-pod", "BackOff")
+	event.RawObject = map[string]any{
+		"Kind": "Pod",
+		"Data": strings.Repeat("x", eventhistory.MaxRawObjectSize),
+	}
+
+	// when
+	dispatcher.dispatchMsg(context.Background(), event, fixPluginDispatch(config.SourceThrottling{}))
+
+	// then
+	events, err := store.List(context.Background(), eventhistory.Query{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "crashing-pod", events[0].Title)
+	assert.Empty(t, events[0].RawObject)
+}
+
+func TestEventTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      api.Message
+		expected string
+	}{
+		{
+			name: "section header",
+			msg: api.Message{
+				Sections: []api.Section{
+					{Base: api.Base{Header: "🔴 v1/pods error"}},
+				},
+			},
+			expected: "🔴 v1/pods error",
+		},
+		{
+			name:     "first line of plaintext",
+			msg:      api.Message{BaseBody: api.Body{Plaintext: "\nAlert firing\nsecond line"}},
+			expected: "Alert firing",
+		},
+		{
+			name:     "long title",
+			msg:      api.Message{BaseBody: api.Body{CodeBlock: strings.Repeat("a", 150)}},
+			expected: strings.Repeat("a", 99) + "…",
+		},
+		{
+			name:     "empty message",
+			msg:      api.Message{},
+			expected: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, eventTitle(tc.msg))
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// incidentTemplateData holds data available in the incident key and resolve condition templates.
+type incidentTemplateData struct {
+	Event      any
+	SourceName string
+	Namespace  string
+	Level      string
+}
+
+// incidentTracker groups related events of a single source into incidents.
+type incidentTracker struct {
+	cfg        config.IncidentGrouping
+	keyTpl     *template.Template
+	resolveTpl *template.Template
+	now        func() time.Time
+
+	mu          sync.Mutex
+	incidents   map[string]*openIncident
+	stopCleanup context.CancelFunc
+}
+
+// openIncident holds the state of an incident which is not yet resolved.
+type openIncident struct {
+	id         string
+	openedAt   time.Time
+	lastEvent  time.Time
+	eventCount int
+
+	notifiedOnce sync.Once
+	notified     chan struct{}
+}
+
+// MarkNotified marks the notification of the first incident event as sent.
+func (i *openIncident) MarkNotified() {
+	i.notifiedOnce.Do(func() {
+		close(i.notified)
+	})
+}
+
+// WaitNotified blocks until the notification of the first incident event is sent, so follow-up events can be sent in its thread.
+func (i *openIncident) WaitNotified(ctx context.Context) {
+	select {
+	case <-i.notified:
+	case <-ctx.Done():
+	}
+}
+
+func newIncidentTracker(cfg config.IncidentGrouping) (*incidentTracker, error) {
+	keyTpl, err := cfg.ParseKey()
+	if err != nil {
+		return nil, fmt.Errorf("while parsing incident key: %w", err)
+	}
+
+	var resolveTpl *template.Template
+	if cfg.ResolveWhen != "" {
+		resolveTpl, err = cfg.ParseResolveCondition()
+		if err != nil {
+			return nil, fmt.Errorf("while parsing incident resolve condition: %w", err)
+		}
+	}
+
+	return &incidentTracker{
+		cfg:        cfg,
+		keyTpl:     keyTpl,
+		resolveTpl: resolveTpl,
+		now:        time.Now,
+		incidents:  map[string]*openIncident{},
+	}, nil
+}
+
+// Track returns the incident a given event belongs to. A new incident is opened if there is no open incident
+// with the same key. An event resolving the incident closes it. Returns nil if the event has an empty key,
+// or it resolves an incident which is not open.
+func (t *incidentTracker) Track(meta interactive.EventMetadata) (*interactive.Incident, *openIncident, error) {
+	data := incidentTemplateData{
+		Event:      meta.Event,
+		SourceName: meta.SourceName,
+		Namespace:  meta.Namespace,
+		Level:      meta.Level,
+	}
+
+	key, err := renderIncidentTemplate(t.keyTpl, data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("while rendering incident key: %w", err)
+	}
+	if key == "" {
+		return nil, nil, nil
+	}
+
+	var resolved bool
+	if t.resolveTpl != nil {
+		out, err := renderIncidentTemplate(t.resolveTpl, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while evaluating incident resolve condition: %w", err)
+		}
+		resolved = out == "true"
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	incident, found := t.incidents[key]
+	if found && t.isInactive(incident, now) {
+		// the incident is not removed by the periodic cleanup yet
+		delete(t.incidents, key)
+		found = false
+	}
+
+	switch {
+	case !found && resolved:
+		return nil, nil, nil
+	case !found:
+		incident = &openIncident{
+			id:       uuid.New().String(),
+			openedAt: now,
+			notified: make(chan struct{}),
+		}
+		t.incidents[key] = incident
+	}
+
+	incident.eventCount++
+	incident.lastEvent = now
+	if resolved {
+		delete(t.incidents, key)
+	}
+
+	return &interactive.Incident{
+		ID:         incident.id,
+		FollowUp:   incident.eventCount > 1,
+		Resolved:   resolved,
+		EventCount: incident.eventCount,
+		OpenedAt:   incident.openedAt,
+	}, incident, nil
+}
+
+// StartCleanup periodically removes inactive incidents in the background, until a given context is cancelled
+// or the tracker is stopped.
+func (t *incidentTracker) StartCleanup(ctx context.Context) {
+	if t.cfg.InactivityTimeout <= 0 {
+		return
+	}
+
+	ctx, t.stopCleanup = context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(t.cfg.InactivityTimeout)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.closeInactive()
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic cleanup of inactive incidents.
+func (t *incidentTracker) Stop() {
+	if t.stopCleanup != nil {
+		t.stopCleanup()
+	}
+}
+
+// closeInactive removes incidents without new events within the inactivity timeout.
+func (t *incidentTracker) closeInactive() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for key, incident := range t.incidents {
+		if !t.isInactive(incident, now) {
+			continue
+		}
+		delete(t.incidents, key)
+	}
+}
+
+func (t *incidentTracker) isInactive(incident *openIncident, now time.Time) bool {
+	return now.Sub(incident.lastEvent) >= t.cfg.InactivityTimeout
+}
+
+func renderIncidentTemplate(tpl *template.Template, data incidentTemplateData) (string, error) {
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buff.String()), nil
+}
@@ -0,0 +1,172 @@
+package source
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestIncidentTracker(t *testing.T) {
+	// given
+	now := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	tracker, err := newIncidentTracker(config.IncidentGrouping{
+		Enabled:           true,
+		Key:               `{{ .Namespace }}/{{ .Event.labels.app }}`,
+		ResolveWhen:       `{{ eq .Event.status "resolved" }}`,
+		InactivityTimeout: time.Hour,
+	})
+	require.NoError(t, err)
+	tracker.now = func() time.Time { return now }
+
+	event := func(app, status string) interactive.EventMetadata {
+		return interactive.EventMetadata{
+			SourceName: "prometheus",
+			Namespace:  "default",
+			Event: map[string]any{
+				"status": status,
+				"labels": map[string]any{"app": app},
+			},
+		}
+	}
+
+	// when
+	first, _, err := tracker.Track(event("api", "firing"))
+	require.NoError(t, err)
+	other, _, err := tracker.Track(event("worker", "firing"))
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	followUp, _, err := tracker.Track(event("api", "firing"))
+	require.NoError(t, err)
+	resolved, _, err := tracker.Track(event("api", "resolved"))
+	require.NoError(t, err)
+
+	// then
+	require.NotNil(t, first)
+	assert.False(t, first.FollowUp)
+	assert.Equal(t, 1, first.EventCount)
+	assert.NotEqual(t, first.ID, other.ID)
+
+	assert.Equal(t, &interactive.Incident{
+		ID:         first.ID,
+		FollowUp:   true,
+		EventCount: 2,
+		OpenedAt:   first.OpenedAt,
+	}, followUp)
+	assert.Equal(t, &interactive.Incident{
+		ID:         first.ID,
+		FollowUp:   true,
+		Resolved:   true,
+		EventCount: 3,
+		OpenedAt:   first.OpenedAt,
+	}, resolved)
+
+	// when the incident is already resolved
+	orphaned, _, err := tracker.Track(event("api", "resolved"))
+	require.NoError(t, err)
+
+	// then
+	assert.Nil(t, orphaned)
+
+	// when the next event is received after the inactivity timeout
+	now = now.Add(time.Hour)
+	reopened, _, err := tracker.Track(event("worker", "firing"))
+	require.NoError(t, err)
+
+	// then
+	assert.NotEqual(t, other.ID, reopened.ID)
+	assert.False(t, reopened.FollowUp)
+}
+
+func TestIncidentTrackerCleanup(t *testing.T) {
+	// given
+	tracker, err := newIncidentTracker(config.IncidentGrouping{
+		Enabled:           true,
+		Key:               `{{ .Event.fingerprint }}`,
+		InactivityTimeout: 20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, _, err = tracker.Track(interactive.EventMetadata{
+		Event: map[string]any{"fingerprint": "abc"},
+	})
+	require.NoError(t, err)
+
+	// when
+	tracker.StartCleanup(ctx)
+
+	// then inactive incidents are removed without new events
+	assert.Eventually(t, func() bool {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+		return len(tracker.incidents) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestIncidentTrackerEmptyKey(t *testing.T) {
+	// given
+	tracker, err := newIncidentTracker(config.IncidentGrouping{
+		Enabled:           true,
+		Key:               `{{ .Event.fingerprint }}`,
+		InactivityTimeout: time.Hour,
+	})
+	require.NoError(t, err)
+
+	// when
+	incident, _, err := tracker.Track(interactive.EventMetadata{
+		Event: map[string]any{"fingerprint": ""},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, incident)
+}
+
+func TestDispatcherIncidents(t *testing.T) {
+	// given
+	notifier := &fakeBotNotifier{}
+	dispatcher := newTestDispatcher(notifier)
+	dispatch := fixPluginDispatch(config.SourceThrottling{})
+	dispatch.cfg.Sources[dispatch.sourceName] = config.Sources{
+		Incidents: config.IncidentGrouping{
+			Enabled:           true,
+			Key:               `{{ .Event.Name }}`,
+			ResolveWhen:       `{{ eq .Event.Reason "Started" }}`,
+			InactivityTimeout: time.Hour,
+		},
+	}
+
+	// when
+	dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "Started"), dispatch)
+
+	// then
+	require.Eventually(t, func() bool {
+		return notifier.Count() == 3
+	}, time.Second, 10*time.Millisecond)
+
+	var incidents []*interactive.Incident
+	for _, msg := range notifier.Messages() {
+		meta, ok := msg.Metadata.(interactive.EventMetadata)
+		require.True(t, ok)
+		require.NotNil(t, meta.Incident)
+		incidents = append(incidents, meta.Incident)
+	}
+
+	// the first notification is always sent before follow-ups
+	assert.False(t, incidents[0].FollowUp)
+	for _, incident := range incidents[1:] {
+		assert.Equal(t, incidents[0].ID, incident.ID)
+		assert.True(t, incident.FollowUp)
+	}
+	assert.True(t, incidents[1].Resolved || incidents[2].Resolved)
+}
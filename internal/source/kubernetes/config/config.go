@@ -18,6 +18,7 @@ type Config struct {
 	InformerResyncPeriod time.Duration      `yaml:"informerResyncPeriod"`
 	Log                  config.Logger      `yaml:"log"`
 	Recommendations      *Recommendations   `yaml:"recommendations"`
+	Enrichment           *Enrichment        `yaml:"enrichment"`
 	Event                *KubernetesEvent   `yaml:"event"`
 	Resources            []Resource         `yaml:"resources" validate:"dive"`
 	Commands             Commands           `yaml:"commands"`
@@ -25,6 +26,7 @@ type Config struct {
 	Annotations          *map[string]string `yaml:"annotations"`
 	Labels               *map[string]string `yaml:"labels"`
 	Filters              *Filters           `yaml:"filters"`
+	UpdateInPlace        *UpdateInPlace     `yaml:"updateInPlace"`
 }
 
 type (
@@ -50,8 +52,37 @@ type Commands struct {
 
 // Recommendations contains configuration for various recommendation insights.
 type Recommendations struct {
-	Ingress IngressRecommendations `yaml:"ingress"`
-	Pod     PodRecommendations     `yaml:"pod"`
+	Ingress               IngressRecommendations               `yaml:"ingress"`
+	Pod                   PodRecommendations                   `yaml:"pod"`
+	Deployment            DeploymentRecommendations            `yaml:"deployment"`
+	Service               ServiceRecommendations               `yaml:"service"`
+	PersistentVolumeClaim PersistentVolumeClaimRecommendations `yaml:"persistentVolumeClaim"`
+	Custom                []CustomRecommendation               `yaml:"custom,omitempty"`
+}
+
+// RecommendationSeverity defines how a recommendation result is reported.
+type RecommendationSeverity string
+
+const (
+	// RecommendationSeverityInfo reports result as a recommendation.
+	RecommendationSeverityInfo RecommendationSeverity = "recommendation"
+	// RecommendationSeverityWarning reports result as a warning.
+	RecommendationSeverityWarning RecommendationSeverity = "warning"
+)
+
+// CustomRecommendation contains a user-defined recommendation rule.
+type CustomRecommendation struct {
+	// Name is the unique rule name.
+	Name string `yaml:"name"`
+	// Type is the Kubernetes resource type the rule applies to, e.g. `v1/pods`. The rule is checked for created resources.
+	Type string `yaml:"type"`
+	// Expression is a CEL expression evaluated against the created resource available as `object`.
+	// If it evaluates to true, the message is reported.
+	Expression string `yaml:"expression"`
+	// Severity defines if the message is reported as a recommendation or a warning. Defaults to recommendation.
+	Severity RecommendationSeverity `yaml:"severity,omitempty"`
+	// Message is a Go template of the reported message. The resource is available as `.Object` and the event as `.Event`.
+	Message string `yaml:"message"`
 }
 
 // IngressRecommendations contains configuration for ingress recommendations.
@@ -70,6 +101,87 @@ type PodRecommendations struct {
 
 	// LabelsSet notifies about Pod resources created without labels.
 	LabelsSet *bool `yaml:"labelsSet,omitempty"`
+
+	// ResourcesSet notifies about Pod containers without resource requests or limits.
+	ResourcesSet *bool `yaml:"resourcesSet,omitempty"`
+
+	// ProbesSet notifies about Pod containers without liveness or readiness probes.
+	ProbesSet *bool `yaml:"probesSet,omitempty"`
+
+	// NoPrivilegedContainers notifies about Pod containers running in privileged mode or as root user.
+	NoPrivilegedContainers *bool `yaml:"noPrivilegedContainers,omitempty"`
+
+	// NoHostPathVolumes notifies about Pod resources with `hostPath` volumes.
+	NoHostPathVolumes *bool `yaml:"noHostPathVolumes,omitempty"`
+}
+
+// DeploymentRecommendations contains configuration for deployments recommendations.
+type DeploymentRecommendations struct {
+	// PodDisruptionBudgetSet notifies about single-replica Deployments without a PodDisruptionBudget.
+	PodDisruptionBudgetSet *bool `yaml:"podDisruptionBudgetSet,omitempty"`
+}
+
+// ServiceRecommendations contains configuration for services recommendations.
+type ServiceRecommendations struct {
+	// SelectorMatchesPods notifies about Services which selector doesn't match any Pod.
+	SelectorMatchesPods *bool `yaml:"selectorMatchesPods,omitempty"`
+}
+
+// PersistentVolumeClaimRecommendations contains configuration for persistent volume claims recommendations.
+type PersistentVolumeClaimRecommendations struct {
+	// Bound notifies about PersistentVolumeClaims stuck in Pending phase.
+	// It is checked for PersistentVolumeClaim error events, so they must be watched.
+	Bound *bool `yaml:"bound,omitempty"`
+}
+
+// Enrichment contains configuration for attaching additional context to error event notifications.
+type Enrichment struct {
+	PodLogs       PodLogsEnrichment       `yaml:"podLogs"`
+	RelatedEvents RelatedEventsEnrichment `yaml:"relatedEvents"`
+	OwnerChain    OwnerChainEnrichment    `yaml:"ownerChain"`
+}
+
+// PodLogsEnrichment contains configuration for attaching logs of failing Pod containers.
+type PodLogsEnrichment struct {
+	Enabled bool `yaml:"enabled"`
+	// TailLines is the number of the last log lines fetched for each failing container.
+	TailLines int64 `yaml:"tailLines"`
+	// MaxBytes limits the size of logs fetched for each failing container.
+	MaxBytes int64 `yaml:"maxBytes"`
+}
+
+// RelatedEventsEnrichment contains configuration for attaching recent events of the involved object.
+type RelatedEventsEnrichment struct {
+	Enabled bool `yaml:"enabled"`
+	// Limit is the maximum number of attached events.
+	Limit int `yaml:"limit"`
+}
+
+// OwnerChainEnrichment contains configuration for attaching the owner chain of the involved object, e.g. Pod -> ReplicaSet -> Deployment.
+type OwnerChainEnrichment struct {
+	Enabled bool `yaml:"enabled"`
+	// MaxDepth is the maximum number of resolved owners.
+	MaxDepth int `yaml:"maxDepth"`
+}
+
+// IsEnabled returns true if any of the enrichments is enabled.
+func (e *Enrichment) IsEnabled() bool {
+	if e == nil {
+		return false
+	}
+	return e.PodLogs.Enabled || e.RelatedEvents.Enabled || e.OwnerChain.Enabled
+}
+
+// UpdateInPlace contains configuration for updating the notification about a given object, instead of sending a new one.
+type UpdateInPlace struct {
+	Enabled bool `yaml:"enabled"`
+	// Expiry is the time after which a new notification is started for the object.
+	Expiry time.Duration `yaml:"expiry"`
+}
+
+// IsEnabled returns true if notifications are updated in place.
+func (u *UpdateInPlace) IsEnabled() bool {
+	return u != nil && u.Enabled
 }
 
 // KubernetesEvent contains configuration for Kubernetes events.
@@ -218,13 +330,41 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 		InformerResyncPeriod: 30 * time.Minute,
 		Recommendations: &Recommendations{
 			Pod: PodRecommendations{
-				NoLatestImageTag: ptr.FromType(false),
-				LabelsSet:        ptr.FromType(false),
+				NoLatestImageTag:       ptr.FromType(false),
+				LabelsSet:              ptr.FromType(false),
+				ResourcesSet:           ptr.FromType(false),
+				ProbesSet:              ptr.FromType(false),
+				NoPrivilegedContainers: ptr.FromType(false),
+				NoHostPathVolumes:      ptr.FromType(false),
 			},
 			Ingress: IngressRecommendations{
 				BackendServiceValid: ptr.FromType(false),
 				TLSSecretValid:      ptr.FromType(false),
 			},
+			Deployment: DeploymentRecommendations{
+				PodDisruptionBudgetSet: ptr.FromType(false),
+			},
+			Service: ServiceRecommendations{
+				SelectorMatchesPods: ptr.FromType(false),
+			},
+			PersistentVolumeClaim: PersistentVolumeClaimRecommendations{
+				Bound: ptr.FromType(false),
+			},
+		},
+		UpdateInPlace: &UpdateInPlace{
+			Expiry: time.Hour,
+		},
+		Enrichment: &Enrichment{
+			PodLogs: PodLogsEnrichment{
+				TailLines: 20,
+				MaxBytes:  4096,
+			},
+			RelatedEvents: RelatedEventsEnrichment{
+				Limit: 5,
+			},
+			OwnerChain: OwnerChainEnrichment{
+				MaxDepth: 5,
+			},
 		},
 		Commands: Commands{
 			Verbs:     []string{"api-resources", "api-versions", "cluster-info", "describe", "explain", "get", "logs", "top"},
@@ -0,0 +1,91 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+// Enricher attaches additional context to a given event.
+type Enricher interface {
+	Do(ctx context.Context, event *event.Event) error
+	Name() string
+}
+
+// AggregatedRunner contains multiple enrichers to run.
+type AggregatedRunner struct {
+	log       logrus.FieldLogger
+	enrichers []Enricher
+}
+
+// NewAggregatedRunner creates a new AggregatedRunner with enrichers enabled in a given configuration.
+func NewAggregatedRunner(log logrus.FieldLogger, cfg *config.Enrichment, k8sCli kubernetes.Interface, dynamicCli dynamic.Interface, mapper meta.RESTMapper) AggregatedRunner {
+	var enrichers []Enricher
+	if cfg != nil && cfg.PodLogs.Enabled {
+		enrichers = append(enrichers, NewPodLogs(k8sCli, cfg.PodLogs))
+	}
+	if cfg != nil && cfg.RelatedEvents.Enabled {
+		enrichers = append(enrichers, NewRelatedEvents(k8sCli, cfg.RelatedEvents))
+	}
+	if cfg != nil && cfg.OwnerChain.Enabled {
+		enrichers = append(enrichers, NewOwnerChain(dynamicCli, mapper, cfg.OwnerChain))
+	}
+
+	return AggregatedRunner{log: log, enrichers: enrichers}
+}
+
+// Do runs all enrichers for a given event. Only error events are enriched.
+func (s AggregatedRunner) Do(ctx context.Context, event *event.Event) error {
+	if len(s.enrichers) == 0 {
+		return nil
+	}
+
+	if event == nil {
+		return errors.New("event is nil")
+	}
+
+	if event.Level != config.Error {
+		s.log.Debug("Skipping enrichment for non-error event")
+		return nil
+	}
+
+	errs := multierror.New()
+	for _, e := range s.enrichers {
+		s.log.Debugf("Running enrichment %q...", e.Name())
+		if err := e.Do(ctx, event); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while running enrichment %q: %w", e.Name(), err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// enrichmentFor returns event enrichment, initializing it if necessary.
+func enrichmentFor(e *event.Event) *event.Enrichment {
+	if e.Enrichment == nil {
+		e.Enrichment = &event.Enrichment{}
+	}
+	return e.Enrichment
+}
+
+// handleAccessError adds a note to a given event if the error is caused by missing RBAC permissions.
+// Such errors are not returned, as missing permissions for enrichment is a valid setup.
+func handleAccessError(e *event.Event, subject string, err error) error {
+	if !apierrors.IsForbidden(err) {
+		return err
+	}
+
+	enrichment := enrichmentFor(e)
+	enrichment.Notes = append(enrichment.Notes, fmt.Sprintf("Unable to get %s due to missing permissions.", subject))
+	return nil
+}
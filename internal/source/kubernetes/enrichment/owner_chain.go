@@ -0,0 +1,94 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const ownerChainName = "OwnerChain"
+
+// OwnerChain attaches the controller owner chain of the involved object, e.g. Pod -> ReplicaSet -> Deployment.
+type OwnerChain struct {
+	dynamicCli dynamic.Interface
+	mapper     meta.RESTMapper
+	cfg        config.OwnerChainEnrichment
+}
+
+// NewOwnerChain creates a new OwnerChain instance.
+func NewOwnerChain(dynamicCli dynamic.Interface, mapper meta.RESTMapper, cfg config.OwnerChainEnrichment) *OwnerChain {
+	return &OwnerChain{dynamicCli: dynamicCli, mapper: mapper, cfg: cfg}
+}
+
+// Do executes the enrichment.
+func (o *OwnerChain) Do(ctx context.Context, e *event.Event) error {
+	if e.Kind == "" || e.Name == "" || e.APIVersion == "" {
+		return nil
+	}
+
+	current := metaV1.OwnerReference{APIVersion: e.APIVersion, Kind: e.Kind, Name: e.Name}
+	chain := []string{formatOwner(current)}
+	for i := 0; o.cfg.MaxDepth <= 0 || i < o.cfg.MaxDepth; i++ {
+		owner, found, err := o.getControllerOwner(ctx, current, e.Namespace)
+		if err != nil {
+			if err := handleAccessError(e, fmt.Sprintf("%s owner", formatOwner(current)), err); err != nil {
+				return err
+			}
+			break
+		}
+		if !found {
+			break
+		}
+
+		chain = append(chain, formatOwner(owner))
+		current = owner
+	}
+
+	if len(chain) < 2 {
+		return nil
+	}
+
+	enrichmentFor(e).OwnerChain = chain
+	return nil
+}
+
+// Name returns the enrichment name.
+func (o *OwnerChain) Name() string {
+	return ownerChainName
+}
+
+func (o *OwnerChain) getControllerOwner(ctx context.Context, ref metaV1.OwnerReference, namespace string) (metaV1.OwnerReference, bool, error) {
+	gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+	mapping, err := o.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return metaV1.OwnerReference{}, false, fmt.Errorf("while getting REST mapping for %q: %w", gvk.String(), err)
+	}
+
+	resource := o.dynamicCli.Resource(mapping.Resource)
+	var obj metaV1.Object
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj, err = resource.Namespace(namespace).Get(ctx, ref.Name, metaV1.GetOptions{})
+	} else {
+		obj, err = resource.Get(ctx, ref.Name, metaV1.GetOptions{})
+	}
+	if err != nil {
+		return metaV1.OwnerReference{}, false, fmt.Errorf("while getting %s: %w", formatOwner(ref), err)
+	}
+
+	owner := metaV1.GetControllerOfNoCopy(obj)
+	if owner == nil {
+		return metaV1.OwnerReference{}, false, nil
+	}
+	return *owner, true, nil
+}
+
+func formatOwner(ref metaV1.OwnerReference) string {
+	return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+}
@@ -0,0 +1,62 @@
+package enrichment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/enrichment"
+)
+
+func TestOwnerChain_Do(t *testing.T) {
+	// given
+	pod := fixFailingPod()
+	pod.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"}
+	rs := &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-5d4f8",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Controller: boolPtr(true)},
+			},
+		},
+	}
+	deploy := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "default",
+		},
+	}
+
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, pod, rs, deploy)
+	enricher := enrichment.NewOwnerChain(dynamicCli, fixRESTMapper(), config.OwnerChainEnrichment{Enabled: true, MaxDepth: 5})
+
+	e := fixPodErrorEvent()
+
+	// when
+	err := enricher.Do(context.Background(), &e)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, e.Enrichment)
+	assert.Equal(t, []string{"Pod/crashing-pod", "ReplicaSet/app-5d4f8", "Deployment/app"}, e.Enrichment.OwnerChain)
+}
+
+func fixRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	return mapper
+}
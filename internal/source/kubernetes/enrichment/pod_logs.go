@@ -0,0 +1,127 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+const (
+	podLogsName      = "PodLogs"
+	maxLogLineLength = 512
+)
+
+// PodLogs attaches the last log lines of failing Pod containers.
+type PodLogs struct {
+	k8sCli kubernetes.Interface
+	cfg    config.PodLogsEnrichment
+}
+
+// NewPodLogs creates a new PodLogs instance.
+func NewPodLogs(k8sCli kubernetes.Interface, cfg config.PodLogsEnrichment) *PodLogs {
+	return &PodLogs{k8sCli: k8sCli, cfg: cfg}
+}
+
+// Do executes the enrichment.
+func (p *PodLogs) Do(ctx context.Context, e *event.Event) error {
+	if e.Kind != "Pod" || e.Name == "" {
+		return nil
+	}
+
+	pod, err := p.k8sCli.CoreV1().Pods(e.Namespace).Get(ctx, e.Name, metaV1.GetOptions{})
+	if err != nil {
+		return handleAccessError(e, "Pod details", fmt.Errorf("while getting Pod: %w", err))
+	}
+
+	var statuses []v1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+
+	errs := multierror.New()
+	for _, status := range statuses {
+		if !isContainerFailing(status) {
+			continue
+		}
+
+		lines, err := p.getLogs(ctx, pod, status)
+		if err != nil {
+			if err := handleAccessError(e, fmt.Sprintf("logs for %q container", status.Name), err); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("while getting logs for container %q: %w", status.Name, err))
+			}
+			continue
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		enrichment := enrichmentFor(e)
+		enrichment.Logs = append(enrichment.Logs, event.ContainerLogs{
+			Container: status.Name,
+			Lines:     lines,
+		})
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Name returns the enrichment name.
+func (p *PodLogs) Name() string {
+	return podLogsName
+}
+
+func (p *PodLogs) getLogs(ctx context.Context, pod *v1.Pod, status v1.ContainerStatus) ([]string, error) {
+	opts := &v1.PodLogOptions{
+		Container: status.Name,
+		// if container is restarting, logs of the failed run are available in the previous instance
+		Previous: status.State.Waiting != nil && status.LastTerminationState.Terminated != nil,
+	}
+	if p.cfg.TailLines > 0 {
+		opts.TailLines = ptr.FromType(p.cfg.TailLines)
+	}
+	if p.cfg.MaxBytes > 0 {
+		opts.LimitBytes = ptr.FromType(p.cfg.MaxBytes)
+	}
+
+	stream, err := p.k8sCli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	raw, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("while reading logs: %w", err)
+	}
+
+	var out []string
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		if line == "" {
+			continue
+		}
+		if len(line) > maxLogLineLength {
+			line = line[:maxLogLineLength] + "..."
+		}
+		out = append(out, line)
+	}
+	return out, nil
+}
+
+func isContainerFailing(status v1.ContainerStatus) bool {
+	switch {
+	case status.State.Terminated != nil:
+		return status.State.Terminated.ExitCode != 0
+	case status.State.Waiting != nil:
+		return status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.ExitCode != 0
+	}
+	return false
+}
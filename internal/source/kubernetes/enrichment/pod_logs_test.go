@@ -0,0 +1,105 @@
+package enrichment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/enrichment"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestPodLogs_Do_HappyPath(t *testing.T) {
+	// given
+	k8sCli := fake.NewSimpleClientset(fixFailingPod())
+	enricher := enrichment.NewPodLogs(k8sCli, config.PodLogsEnrichment{Enabled: true, TailLines: 10})
+
+	e := fixPodErrorEvent()
+
+	// when
+	err := enricher.Do(context.Background(), &e)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, e.Enrichment)
+	assert.Equal(t, []event.ContainerLogs{
+		{
+			Container: "crashing",
+			Lines:     []string{"fake logs"}, // returned by the fake client
+		},
+	}, e.Enrichment.Logs)
+}
+
+func TestPodLogs_Do_Forbidden(t *testing.T) {
+	// given
+	k8sCli := fake.NewSimpleClientset(fixFailingPod())
+	k8sCli.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "crashing-pod", nil)
+	})
+	enricher := enrichment.NewPodLogs(k8sCli, config.PodLogsEnrichment{Enabled: true})
+
+	e := fixPodErrorEvent()
+
+	// when
+	err := enricher.Do(context.Background(), &e)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, e.Enrichment)
+	assert.Empty(t, e.Enrichment.Logs)
+	assert.Equal(t, []string{"Unable to get Pod details due to missing permissions."}, e.Enrichment.Notes)
+}
+
+func fixPodErrorEvent() event.Event {
+	return event.Event{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       "crashing-pod",
+		Namespace:  "default",
+		Level:      config.Error,
+		Reason:     "BackOff",
+		Messages:   []string{"Back-off restarting failed container"},
+	}
+}
+
+func fixFailingPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "crashing-pod",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8", Controller: boolPtr(true)},
+			},
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name:  "healthy",
+					Ready: true,
+					State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+				},
+				{
+					Name:  "crashing",
+					State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{ExitCode: 1},
+					},
+				},
+			},
+		},
+	}
+}
+
+func boolPtr(in bool) *bool {
+	return &in
+}
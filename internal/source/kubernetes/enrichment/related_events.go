@@ -0,0 +1,102 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/strings/slices"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const relatedEventsName = "RelatedEvents"
+
+// RelatedEvents attaches recent Kubernetes events of the involved object.
+type RelatedEvents struct {
+	k8sCli kubernetes.Interface
+	cfg    config.RelatedEventsEnrichment
+}
+
+// NewRelatedEvents creates a new RelatedEvents instance.
+func NewRelatedEvents(k8sCli kubernetes.Interface, cfg config.RelatedEventsEnrichment) *RelatedEvents {
+	return &RelatedEvents{k8sCli: k8sCli, cfg: cfg}
+}
+
+// Do executes the enrichment.
+func (r *RelatedEvents) Do(ctx context.Context, e *event.Event) error {
+	if e.Kind == "" || e.Name == "" {
+		return nil
+	}
+
+	selector := fields.Set{
+		"involvedObject.kind": e.Kind,
+		"involvedObject.name": e.Name,
+	}.AsSelector().String()
+
+	list, err := r.k8sCli.CoreV1().Events(e.Namespace).List(ctx, metaV1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return handleAccessError(e, "related events", fmt.Errorf("while listing events: %w", err))
+	}
+
+	var related []v1.Event
+	for _, item := range list.Items {
+		// field selectors are not supported by all clients, so double-check it
+		if item.InvolvedObject.Kind != e.Kind || item.InvolvedObject.Name != e.Name {
+			continue
+		}
+		// skip the event which is already reported
+		if item.Reason == e.Reason && slices.Contains(e.Messages, item.Message) {
+			continue
+		}
+		related = append(related, item)
+	}
+
+	sort.SliceStable(related, func(i, j int) bool {
+		return lastSeen(related[i]).After(lastSeen(related[j]))
+	})
+
+	if r.cfg.Limit > 0 && len(related) > r.cfg.Limit {
+		related = related[:r.cfg.Limit]
+	}
+	if len(related) == 0 {
+		return nil
+	}
+
+	enrichment := enrichmentFor(e)
+	for _, item := range related {
+		enrichment.RelatedEvents = append(enrichment.RelatedEvents, formatRelatedEvent(item))
+	}
+	return nil
+}
+
+// Name returns the enrichment name.
+func (r *RelatedEvents) Name() string {
+	return relatedEventsName
+}
+
+func formatRelatedEvent(in v1.Event) string {
+	out := fmt.Sprintf("%s (%s): %s", in.Reason, in.Type, in.Message)
+	if in.Count > 1 {
+		out = fmt.Sprintf("%s (x%d)", out, in.Count)
+	}
+	return out
+}
+
+func lastSeen(in v1.Event) time.Time {
+	switch {
+	case !in.LastTimestamp.IsZero():
+		return in.LastTimestamp.Time
+	case in.Series != nil:
+		return in.Series.LastObservedTime.Time
+	case !in.EventTime.IsZero():
+		return in.EventTime.Time
+	}
+	return in.CreationTimestamp.Time
+}
@@ -0,0 +1,57 @@
+package enrichment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/enrichment"
+)
+
+func TestRelatedEvents_Do(t *testing.T) {
+	// given
+	now := time.Now()
+	k8sCli := fake.NewSimpleClientset(
+		fixEvent("pulled", "Pod", "crashing-pod", "Pulled", "Container image already present", now.Add(-3*time.Minute)),
+		fixEvent("started", "Pod", "crashing-pod", "Started", "Started container crashing", now.Add(-2*time.Minute)),
+		fixEvent("backoff", "Pod", "crashing-pod", "BackOff", "Back-off restarting failed container", now.Add(-time.Minute)),
+		fixEvent("other", "Pod", "other-pod", "Killing", "Stopping container", now),
+	)
+	enricher := enrichment.NewRelatedEvents(k8sCli, config.RelatedEventsEnrichment{Enabled: true, Limit: 1})
+
+	e := fixPodErrorEvent()
+
+	// when
+	err := enricher.Do(context.Background(), &e)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, e.Enrichment)
+	assert.Equal(t, []string{"Started (Normal): Started container crashing"}, e.Enrichment.RelatedEvents)
+}
+
+func fixEvent(name, kind, objName, reason, msg string, lastSeen time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      kind,
+			Name:      objName,
+			Namespace: "default",
+		},
+		Reason:        reason,
+		Message:       msg,
+		Type:          "Normal",
+		Count:         1,
+		LastTimestamp: metav1.NewTime(lastSeen),
+	}
+}
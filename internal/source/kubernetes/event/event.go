@@ -8,6 +8,7 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
@@ -15,11 +16,13 @@ import (
 
 // Event stores data about a given event for Kubernetes object.
 type Event struct {
-	APIVersion      string
-	Kind            string
-	Title           string
-	Name            string
-	Namespace       string
+	APIVersion string
+	Kind       string
+	Title      string
+	Name       string
+	Namespace  string
+	// UID is the UID of the object the event is related to.
+	UID             types.UID `json:",omitempty"`
 	Messages        []string
 	Type            config.EventType
 	Reason          string
@@ -32,6 +35,7 @@ type Event struct {
 	Resource        string
 	Recommendations []string
 	Warnings        []string
+	Enrichment      *Enrichment `json:",omitempty"`
 
 	// The following fields are ignored when marshalling the event by purpose.
 	// We send the whole Event struct via sink.Elasticsearch integration.
@@ -40,6 +44,21 @@ type Event struct {
 	Object     interface{}       `json:"-"`
 }
 
+// Enrichment contains additional context attached to a given event.
+type Enrichment struct {
+	Logs          []ContainerLogs `json:",omitempty"`
+	RelatedEvents []string        `json:",omitempty"`
+	OwnerChain    []string        `json:",omitempty"`
+	// Notes describe context that couldn't be attached, e.g. due to missing permissions.
+	Notes []string `json:",omitempty"`
+}
+
+// ContainerLogs holds the last log lines of a given container.
+type ContainerLogs struct {
+	Container string
+	Lines     []string
+}
+
 // Action describes an automated action for a given event.
 type Action struct {
 	// Command is the command to be executed, with the api.MessageBotNamePlaceholder prefix.
@@ -75,6 +94,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		Object:     object,
 		Name:       objectMeta.Name,
 		Namespace:  objectMeta.Namespace,
+		UID:        objectMeta.UID,
 		Level:      LevelMap[eventType],
 		Type:       eventType,
 		Resource:   resource,
@@ -123,6 +143,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		event.APIVersion = eventObj.InvolvedObject.APIVersion
 		event.Name = eventObj.InvolvedObject.Name
 		event.Namespace = eventObj.InvolvedObject.Namespace
+		event.UID = eventObj.InvolvedObject.UID
 		event.Level = LevelMap[config.EventType(strings.ToLower(eventObj.Type))]
 		event.Count = eventObj.Count
 		event.Action = eventObj.Action
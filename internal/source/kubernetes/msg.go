@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	sprig "github.com/go-task/slim-sprig"
@@ -47,10 +48,16 @@ func (m *MessageBuilder) FromEvent(event event.Event, actions []config.ExtraButt
 	}
 
 	if !m.isInteractivitySupported {
+		// non-interactive platforms render only a single section, so enrichment is appended to it
+		msg.Sections[0].BulletLists = append(msg.Sections[0].BulletLists, m.enrichmentBulletLists(event.Enrichment)...)
 		msg.Type = api.NonInteractiveSingleSection
 		return msg, nil
 	}
 
+	if enrichmentSection := m.enrichmentSection(event.Enrichment); enrichmentSection != nil {
+		msg.Sections = append(msg.Sections, *enrichmentSection)
+	}
+
 	cmdSection, err := m.getCommandSelectIfShould(event)
 	if err != nil {
 		return api.Message{}, err
@@ -154,6 +161,78 @@ func (m *MessageBuilder) baseNotificationSection(event event.Event) api.Section
 	return section
 }
 
+func (m *MessageBuilder) enrichmentSection(enrichment *event.Enrichment) *api.Section {
+	if enrichment == nil {
+		return nil
+	}
+
+	var logs []string
+	for _, containerLogs := range enrichment.Logs {
+		logs = append(logs, fmt.Sprintf("# %s container logs:\n%s", containerLogs.Container, strings.Join(containerLogs.Lines, "\n")))
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: "Additional context",
+			Body: api.Body{
+				CodeBlock: strings.Join(logs, "\n\n"),
+			},
+		},
+	}
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Owner chain", ownerChainItems(enrichment))
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Related events", enrichment.RelatedEvents)
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Notes", enrichment.Notes)
+
+	if section.Body.CodeBlock == "" && len(section.BulletLists) == 0 {
+		return nil
+	}
+	return &section
+}
+
+func (m *MessageBuilder) enrichmentBulletLists(enrichment *event.Enrichment) api.BulletLists {
+	if enrichment == nil {
+		return nil
+	}
+
+	var out api.BulletLists
+	out = m.appendBulletListIfNotEmpty(out, "Owner chain", ownerChainItems(enrichment))
+	out = m.appendBulletListIfNotEmpty(out, "Related events", enrichment.RelatedEvents)
+	for _, containerLogs := range enrichment.Logs {
+		out = m.appendBulletListIfNotEmpty(out, fmt.Sprintf("Logs (%s)", containerLogs.Container), containerLogs.Lines)
+	}
+	out = m.appendBulletListIfNotEmpty(out, "Notes", enrichment.Notes)
+	return out
+}
+
+// inPlaceUpdate returns details for updating the notification about the event object in place.
+// Returns nil if updating in place is disabled, or the object UID is unknown.
+func inPlaceUpdate(cfg *config.UpdateInPlace, e event.Event) *api.InPlaceUpdate {
+	if !cfg.IsEnabled() || e.UID == "" {
+		return nil
+	}
+
+	change := e.Title
+	if e.Reason != "" {
+		change += fmt.Sprintf(" (%s)", e.Reason)
+	}
+	if len(e.Messages) > 0 {
+		change += ": " + strings.Join(e.Messages, ", ")
+	}
+
+	return &api.InPlaceUpdate{
+		Key:    string(e.UID),
+		Change: change,
+		Expiry: cfg.Expiry,
+	}
+}
+
+func ownerChainItems(enrichment *event.Enrichment) []string {
+	if len(enrichment.OwnerChain) == 0 {
+		return nil
+	}
+	return []string{strings.Join(enrichment.OwnerChain, " → ")}
+}
+
 func (m *MessageBuilder) appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) []api.TextField {
 	if value == "" {
 		return fields
@@ -0,0 +1,78 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestInPlaceUpdate(t *testing.T) {
+	enabled := &config.UpdateInPlace{Enabled: true, Expiry: time.Hour}
+
+	tests := []struct {
+		name     string
+		cfg      *config.UpdateInPlace
+		event    event.Event
+		expected *api.InPlaceUpdate
+	}{
+		{
+			name: "update event",
+			cfg:  enabled,
+			event: event.Event{
+				UID:      "6f1c5c43-6d2a-4b9e-a7a4-2d3e1f1c9b6d",
+				Title:    "v1/pods updated",
+				Messages: []string{"status.phase: Pending -> Running"},
+			},
+			expected: &api.InPlaceUpdate{
+				Key:    "6f1c5c43-6d2a-4b9e-a7a4-2d3e1f1c9b6d",
+				Change: "v1/pods updated: status.phase: Pending -> Running",
+				Expiry: time.Hour,
+			},
+		},
+		{
+			name: "error event",
+			cfg:  enabled,
+			event: event.Event{
+				UID:    "6f1c5c43-6d2a-4b9e-a7a4-2d3e1f1c9b6d",
+				Title:  "v1/pods error",
+				Reason: "BackOff",
+			},
+			expected: &api.InPlaceUpdate{
+				Key:    "6f1c5c43-6d2a-4b9e-a7a4-2d3e1f1c9b6d",
+				Change: "v1/pods error (BackOff)",
+				Expiry: time.Hour,
+			},
+		},
+		{
+			name: "unknown object UID",
+			cfg:  enabled,
+			event: event.Event{
+				Title: "v1/pods error",
+			},
+			expected: nil,
+		},
+		{
+			name: "disabled",
+			cfg:  &config.UpdateInPlace{Enabled: false, Expiry: time.Hour},
+			event: event.Event{
+				UID:   "6f1c5c43-6d2a-4b9e-a7a4-2d3e1f1c9b6d",
+				Title: "v1/pods created",
+			},
+			expected: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out := inPlaceUpdate(tc.cfg, tc.event)
+
+			// then
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
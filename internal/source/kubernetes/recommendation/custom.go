@@ -0,0 +1,163 @@
+package recommendation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+
+	sprig "github.com/go-task/slim-sprig"
+	"github.com/google/cel-go/cel"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+const customObjectVariable = "object"
+
+// Custom adds a recommendation or a warning if a user-defined CEL expression is matched for a created resource.
+type Custom struct {
+	log      logrus.FieldLogger
+	rule     config.CustomRecommendation
+	program  cel.Program
+	template *template.Template
+}
+
+type customMessageData struct {
+	Object map[string]any
+	Event  event.Event
+}
+
+// CompileCustom compiles user-defined recommendation rules. All rule errors are returned at once.
+func CompileCustom(log logrus.FieldLogger, rules []config.CustomRecommendation) ([]Recommendation, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	env, err := cel.NewEnv(cel.Variable(customObjectVariable, cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("while creating CEL environment: %w", err)
+	}
+
+	var out []Recommendation
+	names := map[string]struct{}{}
+	errs := multierror.New()
+	for idx, rule := range rules {
+		if _, found := names[rule.Name]; found {
+			errs = multierror.Append(errs, fmt.Errorf("rule %q: name must be unique", rule.Name))
+			continue
+		}
+		names[rule.Name] = struct{}{}
+
+		custom, err := newCustom(log, env, rule)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("rule %q (index %d): %w", rule.Name, idx, err))
+			continue
+		}
+		out = append(out, custom)
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func newCustom(log logrus.FieldLogger, env *cel.Env, rule config.CustomRecommendation) (*Custom, error) {
+	switch {
+	case rule.Name == "":
+		return nil, errors.New("name is required")
+	case rule.Type == "":
+		return nil, errors.New("type is required")
+	case rule.Expression == "":
+		return nil, errors.New("expression is required")
+	case rule.Message == "":
+		return nil, errors.New("message is required")
+	}
+
+	switch rule.Severity {
+	case "":
+		rule.Severity = config.RecommendationSeverityInfo
+	case config.RecommendationSeverityInfo, config.RecommendationSeverityWarning:
+	default:
+		return nil, fmt.Errorf("unknown severity %q, allowed values: %q, %q", rule.Severity, config.RecommendationSeverityInfo, config.RecommendationSeverityWarning)
+	}
+
+	ast, issues := env.Compile(rule.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("while compiling expression: %w", issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("while creating program for expression: %w", err)
+	}
+
+	tpl, err := template.New(rule.Name).Funcs(sprig.TxtFuncMap()).Parse(rule.Message)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing message template: %w", err)
+	}
+
+	return &Custom{
+		log:      log,
+		rule:     rule,
+		program:  program,
+		template: tpl,
+	}, nil
+}
+
+// Do executes the recommendation checks.
+func (c *Custom) Do(_ context.Context, event event.Event) (Result, error) {
+	if event.Resource != c.rule.Type || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	val, _, err := c.program.Eval(map[string]any{
+		customObjectVariable: unstrObj.Object,
+	})
+	if err != nil {
+		// expressions often refer to optional fields which are not set, so it's not an error
+		c.log.Debugf("Expression of custom recommendation %q not matched: %s", c.rule.Name, err.Error())
+		return Result{}, nil
+	}
+
+	matched, ok := val.Value().(bool)
+	if !ok {
+		return Result{}, fmt.Errorf("expression evaluated to %T instead of bool", val.Value())
+	}
+	if !matched {
+		return Result{}, nil
+	}
+
+	var buff bytes.Buffer
+	err = c.template.Execute(&buff, customMessageData{
+		Object: unstrObj.Object,
+		Event:  event,
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("while rendering message: %w", err)
+	}
+
+	if c.rule.Severity == config.RecommendationSeverityWarning {
+		return Result{Warnings: []string{buff.String()}}, nil
+	}
+	return Result{Info: []string{buff.String()}}, nil
+}
+
+// Name returns the recommendation name.
+func (c *Custom) Name() string {
+	return c.rule.Name
+}
@@ -0,0 +1,99 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestCustom_Do(t *testing.T) {
+	// given
+	rules := []config.CustomRecommendation{
+		{
+			Name:       "PodHostNetwork",
+			Type:       "v1/pods",
+			Expression: `has(object.spec.hostNetwork) && object.spec.hostNetwork`,
+			Severity:   config.RecommendationSeverityWarning,
+			Message:    `Pod '{{ .Object.metadata.namespace }}/{{ .Object.metadata.name }}' uses host network.`,
+		},
+		{
+			Name:       "PodTeamLabel",
+			Type:       "v1/pods",
+			Expression: `!has(object.metadata.labels) || !("team" in object.metadata.labels)`,
+			Message:    `Pod '{{ .Event.Namespace }}/{{ .Event.Name }}' should have the 'team' label.`,
+		},
+		{
+			Name:       "ServiceType",
+			Type:       "v1/services",
+			Expression: `object.spec.type == "NodePort"`,
+			Message:    `Not a Pod rule.`,
+		},
+	}
+
+	recomms, err := recommendation.CompileCustom(loggerx.NewNoop(), rules)
+	require.NoError(t, err)
+	require.Len(t, recomms, 3)
+
+	pod := &v1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: "default",
+		},
+		Spec: v1.PodSpec{HostNetwork: true},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+
+	e, err := event.New(pod.ObjectMeta, &unstructured.Unstructured{Object: unstrObj}, config.CreateEvent, recommendation.PodResourceType())
+	require.NoError(t, err)
+
+	// when
+	var actual recommendation.Result
+	for _, r := range recomms {
+		res, err := r.Do(context.Background(), e)
+		require.NoError(t, err)
+		actual.Info = append(actual.Info, res.Info...)
+		actual.Warnings = append(actual.Warnings, res.Warnings...)
+	}
+
+	// then
+	assert.Equal(t, recommendation.Result{
+		Info:     []string{"Pod 'default/pod' should have the 'team' label."},
+		Warnings: []string{"Pod 'default/pod' uses host network."},
+	}, actual)
+}
+
+func TestCompileCustom_Errors(t *testing.T) {
+	// given
+	rules := []config.CustomRecommendation{
+		{Name: "InvalidExpression", Type: "v1/pods", Expression: `object.spec.`, Message: "foo"},
+		{Name: "NonBoolExpression", Type: "v1/pods", Expression: `"foo"`, Message: "foo"},
+		{Name: "InvalidSeverity", Type: "v1/pods", Expression: `true`, Message: "foo", Severity: "critical"},
+		{Name: "InvalidSeverity", Type: "v1/pods", Expression: `true`, Message: "foo"},
+		{Name: "MissingType", Expression: `true`, Message: "foo"},
+	}
+
+	// when
+	recomms, err := recommendation.CompileCustom(loggerx.NewNoop(), rules)
+
+	// then
+	require.Error(t, err)
+	assert.Nil(t, recomms)
+	assert.Contains(t, err.Error(), `rule "InvalidExpression" (index 0): while compiling expression`)
+	assert.Contains(t, err.Error(), `rule "NonBoolExpression" (index 1): expression must evaluate to bool, got string`)
+	assert.Contains(t, err.Error(), `rule "InvalidSeverity" (index 2): unknown severity "critical"`)
+	assert.Contains(t, err.Error(), `rule "InvalidSeverity": name must be unique`)
+	assert.Contains(t, err.Error(), `rule "MissingType" (index 4): type is required`)
+}
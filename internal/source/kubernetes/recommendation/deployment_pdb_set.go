@@ -0,0 +1,102 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const deploymentPodDisruptionBudgetSetName = "DeploymentPodDisruptionBudgetSet"
+
+// DeploymentPodDisruptionBudgetSet adds recommendations if a single-replica Deployment is not covered by any PodDisruptionBudget.
+type DeploymentPodDisruptionBudgetSet struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewDeploymentPodDisruptionBudgetSet creates a new DeploymentPodDisruptionBudgetSet instance.
+func NewDeploymentPodDisruptionBudgetSet(dynamicCli dynamic.Interface) *DeploymentPodDisruptionBudgetSet {
+	return &DeploymentPodDisruptionBudgetSet{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *DeploymentPodDisruptionBudgetSet) Do(ctx context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Deployment" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var deploy appsv1.Deployment
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &deploy)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, deploy, err)
+	}
+
+	// replicas defaults to 1 if not specified
+	if deploy.Spec.Replicas != nil && *deploy.Spec.Replicas != 1 {
+		return Result{}, nil
+	}
+
+	covered, err := f.isCoveredByPDB(ctx, deploy)
+	if err != nil {
+		return Result{}, fmt.Errorf("while checking PodDisruptionBudgets: %w", err)
+	}
+	if covered {
+		return Result{}, nil
+	}
+
+	recommendationMsg := fmt.Sprintf("Deployment '%s/%s' has a single replica and no PodDisruptionBudget. Consider increasing the number of replicas and defining a PodDisruptionBudget, to avoid downtime during voluntary disruptions.", deploy.Namespace, deploy.Name)
+	return Result{
+		Info: []string{recommendationMsg},
+	}, nil
+}
+
+func (f *DeploymentPodDisruptionBudgetSet) isCoveredByPDB(ctx context.Context, deploy appsv1.Deployment) (bool, error) {
+	pdbGVR := schema.GroupVersionResource{
+		Group:    "policy",
+		Version:  "v1",
+		Resource: "poddisruptionbudgets",
+	}
+	list, err := f.dynamicCli.Resource(pdbGVR).Namespace(deploy.Namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	podLabels := labels.Set(deploy.Spec.Template.Labels)
+	for i := range list.Items {
+		var pdb policyv1.PodDisruptionBudget
+		err := k8sutil.TransformIntoTypedObject(&list.Items[i], &pdb)
+		if err != nil {
+			return false, fmt.Errorf("while transforming object type %T into type: %T: %w", list.Items[i], pdb, err)
+		}
+
+		selector, err := metaV1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return false, fmt.Errorf("while parsing PodDisruptionBudget %q selector: %w", pdb.Name, err)
+		}
+		if !selector.Empty() && selector.Matches(podLabels) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Name returns the recommendation name.
+func (f *DeploymentPodDisruptionBudgetSet) Name() string {
+	return deploymentPodDisruptionBudgetSetName
+}
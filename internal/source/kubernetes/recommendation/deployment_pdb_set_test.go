@@ -0,0 +1,107 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestDeploymentPodDisruptionBudgetSet_Do_HappyPath(t *testing.T) {
+	// given
+	tests := []struct {
+		name     string
+		deploy   *appsv1.Deployment
+		expected recommendation.Result
+	}{
+		{
+			name:   "Single replica without PDB",
+			deploy: fixDeployment("not-covered", nil),
+			expected: recommendation.Result{
+				Info: []string{
+					"Deployment 'foo/not-covered' has a single replica and no PodDisruptionBudget. Consider increasing the number of replicas and defining a PodDisruptionBudget, to avoid downtime during voluntary disruptions.",
+				},
+			},
+		},
+		{
+			name:     "Single replica with PDB",
+			deploy:   fixDeployment("covered", ptr.FromType[int32](1)),
+			expected: recommendation.Result{},
+		},
+		{
+			name:     "Multiple replicas",
+			deploy:   fixDeployment("not-covered", ptr.FromType[int32](3)),
+			expected: recommendation.Result{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixPodDisruptionBudget())
+			recomm := recommendation.NewDeploymentPodDisruptionBudgetSet(dynamicCli)
+
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.deploy)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(tc.deploy.ObjectMeta, unstr, config.CreateEvent, "apps/v1/deployments")
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func fixDeployment(app string, replicas *int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app,
+			Namespace: "foo",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: replicas,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": app},
+				},
+			},
+		},
+	}
+}
+
+func fixPodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "covered",
+			Namespace: "foo",
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "covered"},
+			},
+		},
+	}
+}
@@ -27,11 +27,13 @@ type Result struct {
 type Factory struct {
 	logger     logrus.FieldLogger
 	dynamicCli dynamic.Interface
+	custom     []Recommendation
 }
 
 // NewFactory creates a new Factory instance.
-func NewFactory(logger logrus.FieldLogger, dynamicCli dynamic.Interface) *Factory {
-	return &Factory{logger: logger, dynamicCli: dynamicCli}
+// Custom recommendations are compiled upfront with CompileCustom, so they are not recompiled for each event.
+func NewFactory(logger logrus.FieldLogger, dynamicCli dynamic.Interface, custom []Recommendation) *Factory {
+	return &Factory{logger: logger, dynamicCli: dynamicCli, custom: custom}
 }
 
 // New creates a new AggregatedRunner.
@@ -50,6 +52,22 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewPodNoLatestImageTag())
 	}
 
+	if ptr.ToValue(cfg.Pod.ResourcesSet) {
+		recommendations = append(recommendations, NewPodResourcesSet())
+	}
+
+	if ptr.ToValue(cfg.Pod.ProbesSet) {
+		recommendations = append(recommendations, NewPodProbesSet())
+	}
+
+	if ptr.ToValue(cfg.Pod.NoPrivilegedContainers) {
+		recommendations = append(recommendations, NewPodNoPrivilegedContainers())
+	}
+
+	if ptr.ToValue(cfg.Pod.NoHostPathVolumes) {
+		recommendations = append(recommendations, NewPodNoHostPathVolumes())
+	}
+
 	if ptr.ToValue(cfg.Ingress.BackendServiceValid) {
 		recommendations = append(recommendations, NewIngressBackendServiceValid(f.dynamicCli))
 	}
@@ -58,5 +76,19 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewIngressTLSSecretValid(f.dynamicCli))
 	}
 
+	if ptr.ToValue(cfg.Deployment.PodDisruptionBudgetSet) {
+		recommendations = append(recommendations, NewDeploymentPodDisruptionBudgetSet(f.dynamicCli))
+	}
+
+	if ptr.ToValue(cfg.Service.SelectorMatchesPods) {
+		recommendations = append(recommendations, NewServiceSelectorMatchesPods(f.dynamicCli))
+	}
+
+	if ptr.ToValue(cfg.PersistentVolumeClaim.Bound) {
+		recommendations = append(recommendations, NewPersistentVolumeClaimBound(f.dynamicCli))
+	}
+
+	recommendations = append(recommendations, f.custom...)
+
 	return recommendations
 }
@@ -42,7 +42,7 @@ func TestFactory_New(t *testing.T) {
 		},
 	}
 
-	factory := recommendation.NewFactory(loggerx.NewNoop(), nil)
+	factory := recommendation.NewFactory(loggerx.NewNoop(), nil, nil)
 
 	// when
 	recRunner, recCfg := factory.New(cfg)
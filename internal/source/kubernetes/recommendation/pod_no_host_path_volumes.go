@@ -0,0 +1,58 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const podNoHostPathVolumesName = "PodNoHostPathVolumes"
+
+// PodNoHostPathVolumes adds warnings if Pod uses hostPath volumes.
+type PodNoHostPathVolumes struct{}
+
+// NewPodNoHostPathVolumes creates a new PodNoHostPathVolumes instance.
+func NewPodNoHostPathVolumes() *PodNoHostPathVolumes {
+	return &PodNoHostPathVolumes{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoHostPathVolumes) Do(_ context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Pod" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var pod coreV1.Pod
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &pod)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, pod, err)
+	}
+
+	var warningMsgs []string
+	for _, v := range pod.Spec.Volumes {
+		if v.HostPath == nil {
+			continue
+		}
+		warningMsgs = append(warningMsgs, fmt.Sprintf("Pod '%s/%s' uses hostPath volume '%s' with '%s' path. Avoid it, as it exposes the node filesystem to the Pod.", pod.Namespace, pod.Name, v.Name, v.HostPath.Path))
+	}
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodNoHostPathVolumes) Name() string {
+	return podNoHostPathVolumesName
+}
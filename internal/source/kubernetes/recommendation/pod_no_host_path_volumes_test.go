@@ -0,0 +1,71 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodNoHostPathVolumes_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Warnings: []string{
+			"Pod 'foo/pod-name' uses hostPath volume 'docker-sock' with '/var/run/docker.sock' path. Avoid it, as it exposes the node filesystem to the Pod.",
+		},
+	}
+
+	recomm := recommendation.NewPodNoHostPathVolumes()
+
+	pod := fixPodWithVolumes()
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func fixPodWithVolumes() *v1.Pod {
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-name",
+			Namespace: "foo",
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "first", Image: "foo:v1"},
+			},
+			Volumes: []v1.Volume{
+				{
+					Name:         "cache",
+					VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+				},
+				{
+					Name:         "docker-sock",
+					VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/run/docker.sock"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const podNoPrivilegedContainersName = "PodNoPrivilegedContainers"
+
+// PodNoPrivilegedContainers adds warnings if Pod containers run in privileged mode or as root user.
+type PodNoPrivilegedContainers struct{}
+
+// NewPodNoPrivilegedContainers creates a new PodNoPrivilegedContainers instance.
+func NewPodNoPrivilegedContainers() *PodNoPrivilegedContainers {
+	return &PodNoPrivilegedContainers{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoPrivilegedContainers) Do(_ context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Pod" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var pod coreV1.Pod
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &pod)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, pod, err)
+	}
+
+	podIdentifier := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+
+	warningMsgs := f.checkContainers("initContainer", pod.Spec.InitContainers, pod.Spec.SecurityContext, podIdentifier)
+	warningMsgs = append(warningMsgs, f.checkContainers("container", pod.Spec.Containers, pod.Spec.SecurityContext, podIdentifier)...)
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+func (f *PodNoPrivilegedContainers) checkContainers(fieldName string, containers []coreV1.Container, podSecCtx *coreV1.PodSecurityContext, podIdentifier string) []string {
+	var warnings []string
+	for _, c := range containers {
+		if c.SecurityContext != nil && c.SecurityContext.Privileged != nil && *c.SecurityContext.Privileged {
+			warnings = append(warnings, fmt.Sprintf("Pod '%s' %s '%s' runs in privileged mode. Avoid it unless the container needs full access to the host.", podIdentifier, fieldName, c.Name))
+		}
+
+		if runsAsRoot(c.SecurityContext, podSecCtx) {
+			warnings = append(warnings, fmt.Sprintf("Pod '%s' %s '%s' runs as root user. Consider setting a non-root user in the security context.", podIdentifier, fieldName, c.Name))
+		}
+	}
+
+	return warnings
+}
+
+// runsAsRoot returns true if the container is explicitly configured to run as root user.
+// Container security context takes precedence over the Pod one.
+func runsAsRoot(containerSecCtx *coreV1.SecurityContext, podSecCtx *coreV1.PodSecurityContext) bool {
+	var runAsUser *int64
+	if podSecCtx != nil {
+		runAsUser = podSecCtx.RunAsUser
+	}
+	if containerSecCtx != nil && containerSecCtx.RunAsUser != nil {
+		runAsUser = containerSecCtx.RunAsUser
+	}
+
+	return runAsUser != nil && *runAsUser == 0
+}
+
+// Name returns the recommendation name.
+func (f *PodNoPrivilegedContainers) Name() string {
+	return podNoPrivilegedContainersName
+}
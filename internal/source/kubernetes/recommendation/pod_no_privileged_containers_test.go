@@ -0,0 +1,92 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodNoPrivilegedContainers_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Warnings: []string{
+			"Pod 'foo/pod-name' initContainer 'privileged-init' runs in privileged mode. Avoid it unless the container needs full access to the host.",
+			"Pod 'foo/pod-name' container 'root-from-pod' runs as root user. Consider setting a non-root user in the security context.",
+			"Pod 'foo/pod-name' container 'privileged-root' runs in privileged mode. Avoid it unless the container needs full access to the host.",
+			"Pod 'foo/pod-name' container 'privileged-root' runs as root user. Consider setting a non-root user in the security context.",
+		},
+	}
+
+	recomm := recommendation.NewPodNoPrivilegedContainers()
+
+	pod := fixPodWithSecurityContext()
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func fixPodWithSecurityContext() *v1.Pod {
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-name",
+			Namespace: "foo",
+		},
+		Spec: v1.PodSpec{
+			SecurityContext: &v1.PodSecurityContext{
+				RunAsUser: ptr.FromType[int64](0),
+			},
+			InitContainers: []v1.Container{
+				{
+					Name:  "privileged-init",
+					Image: "foo:v1",
+					SecurityContext: &v1.SecurityContext{
+						Privileged: ptr.FromType(true),
+						RunAsUser:  ptr.FromType[int64](1000),
+					},
+				},
+			},
+			Containers: []v1.Container{
+				{Name: "root-from-pod", Image: "foo:v1"},
+				{
+					Name:  "non-root",
+					Image: "foo:v1",
+					SecurityContext: &v1.SecurityContext{
+						RunAsUser: ptr.FromType[int64](1000),
+					},
+				},
+				{
+					Name:  "privileged-root",
+					Image: "foo:v1",
+					SecurityContext: &v1.SecurityContext{
+						Privileged: ptr.FromType(true),
+					},
+				},
+			},
+		},
+	}
+}
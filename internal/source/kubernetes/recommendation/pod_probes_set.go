@@ -0,0 +1,60 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const podProbesSetName = "PodProbesSet"
+
+// PodProbesSet adds recommendations if Pod containers don't define liveness or readiness probes.
+type PodProbesSet struct{}
+
+// NewPodProbesSet creates a new PodProbesSet instance.
+func NewPodProbesSet() *PodProbesSet {
+	return &PodProbesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodProbesSet) Do(_ context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Pod" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var pod coreV1.Pod
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &pod)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, pod, err)
+	}
+
+	var infoMsgs []string
+	for _, c := range pod.Spec.Containers {
+		if c.LivenessProbe == nil {
+			infoMsgs = append(infoMsgs, fmt.Sprintf("Container '%s' of Pod '%s/%s' doesn't define liveness probe. Consider setting it, to restart the container when it becomes unresponsive.", c.Name, pod.Namespace, pod.Name))
+		}
+		if c.ReadinessProbe == nil {
+			infoMsgs = append(infoMsgs, fmt.Sprintf("Container '%s' of Pod '%s/%s' doesn't define readiness probe. Consider setting it, to receive traffic only when the container is ready.", c.Name, pod.Namespace, pod.Name))
+		}
+	}
+
+	return Result{
+		Info: infoMsgs,
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodProbesSet) Name() string {
+	return podProbesSetName
+}
@@ -0,0 +1,73 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodProbesSet_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Info: []string{
+			"Container 'no-probes' of Pod 'foo/pod-name' doesn't define liveness probe. Consider setting it, to restart the container when it becomes unresponsive.",
+			"Container 'no-probes' of Pod 'foo/pod-name' doesn't define readiness probe. Consider setting it, to receive traffic only when the container is ready.",
+			"Container 'liveness-only' of Pod 'foo/pod-name' doesn't define readiness probe. Consider setting it, to receive traffic only when the container is ready.",
+		},
+	}
+
+	recomm := recommendation.NewPodProbesSet()
+
+	pod := fixPodWithProbes()
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func fixPodWithProbes() *v1.Pod {
+	probe := &v1.Probe{
+		ProbeHandler: v1.ProbeHandler{
+			HTTPGet: &v1.HTTPGetAction{Path: "/healthz"},
+		},
+	}
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-name",
+			Namespace: "foo",
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{
+				{Name: "init", Image: "foo:v1"},
+			},
+			Containers: []v1.Container{
+				{Name: "no-probes", Image: "foo:v1"},
+				{Name: "liveness-only", Image: "foo:v1", LivenessProbe: probe},
+				{Name: "all-set", Image: "foo:v1", LivenessProbe: probe, ReadinessProbe: probe},
+			},
+		},
+	}
+}
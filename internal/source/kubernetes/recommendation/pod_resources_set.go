@@ -0,0 +1,60 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const podResourcesSetName = "PodResourcesSet"
+
+// PodResourcesSet adds recommendations if Pod containers don't define resource requests or limits.
+type PodResourcesSet struct{}
+
+// NewPodResourcesSet creates a new PodResourcesSet instance.
+func NewPodResourcesSet() *PodResourcesSet {
+	return &PodResourcesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodResourcesSet) Do(_ context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Pod" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var pod coreV1.Pod
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &pod)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, pod, err)
+	}
+
+	var infoMsgs []string
+	for _, c := range pod.Spec.Containers {
+		if len(c.Resources.Requests) == 0 {
+			infoMsgs = append(infoMsgs, fmt.Sprintf("Container '%s' of Pod '%s/%s' doesn't define resource requests. Consider setting them, to ensure proper scheduling.", c.Name, pod.Namespace, pod.Name))
+		}
+		if len(c.Resources.Limits) == 0 {
+			infoMsgs = append(infoMsgs, fmt.Sprintf("Container '%s' of Pod '%s/%s' doesn't define resource limits. Consider setting them, to prevent excessive resource usage.", c.Name, pod.Namespace, pod.Name))
+		}
+	}
+
+	return Result{
+		Info: infoMsgs,
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodResourcesSet) Name() string {
+	return podResourcesSetName
+}
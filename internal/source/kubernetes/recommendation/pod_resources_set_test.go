@@ -0,0 +1,70 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodResourcesSet_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Info: []string{
+			"Container 'no-resources' of Pod 'foo/pod-name' doesn't define resource requests. Consider setting them, to ensure proper scheduling.",
+			"Container 'no-resources' of Pod 'foo/pod-name' doesn't define resource limits. Consider setting them, to prevent excessive resource usage.",
+			"Container 'requests-only' of Pod 'foo/pod-name' doesn't define resource limits. Consider setting them, to prevent excessive resource usage.",
+		},
+	}
+
+	recomm := recommendation.NewPodResourcesSet()
+
+	pod := fixPodWithResources()
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func fixPodWithResources() *v1.Pod {
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("100m"),
+		v1.ResourceMemory: resource.MustParse("128Mi"),
+	}
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-name",
+			Namespace: "foo",
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "no-resources", Image: "foo:v1"},
+				{Name: "requests-only", Image: "foo:v1", Resources: v1.ResourceRequirements{Requests: resources}},
+				{Name: "all-set", Image: "foo:v1", Resources: v1.ResourceRequirements{Requests: resources, Limits: resources}},
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const persistentVolumeClaimBoundName = "PersistentVolumeClaimBound"
+
+// PersistentVolumeClaimBound adds warnings if PersistentVolumeClaim related to an error event is stuck in Pending phase.
+type PersistentVolumeClaimBound struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewPersistentVolumeClaimBound creates a new PersistentVolumeClaimBound instance.
+func NewPersistentVolumeClaimBound(dynamicCli dynamic.Interface) *PersistentVolumeClaimBound {
+	return &PersistentVolumeClaimBound{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *PersistentVolumeClaimBound) Do(ctx context.Context, event event.Event) (Result, error) {
+	// Newly created claims are always Pending, so we check only the ones with error events, e.g. ProvisioningFailed.
+	if event.Kind != "PersistentVolumeClaim" || event.Type != config.ErrorEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind != "Event" {
+		return Result{}, nil
+	}
+
+	pvcGVR := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "persistentvolumeclaims",
+	}
+	unstrObj, err := f.dynamicCli.Resource(pvcGVR).Namespace(event.Namespace).Get(ctx, event.Name, metaV1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return Result{}, nil
+		}
+		return Result{}, fmt.Errorf("while getting PersistentVolumeClaim: %w", err)
+	}
+
+	var pvc coreV1.PersistentVolumeClaim
+	err = k8sutil.TransformIntoTypedObject(unstrObj, &pvc)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", unstrObj, pvc, err)
+	}
+
+	if pvc.Status.Phase != coreV1.ClaimPending {
+		return Result{}, nil
+	}
+
+	storageClass := ptr.ToValue(pvc.Spec.StorageClassName)
+	if storageClass == "" {
+		storageClass = "default"
+	}
+
+	warningMsg := fmt.Sprintf("PersistentVolumeClaim '%s/%s' is stuck in Pending phase. Verify that the '%s' StorageClass exists and can provision volumes, or that a matching PersistentVolume is available.", pvc.Namespace, pvc.Name, storageClass)
+	return Result{
+		Warnings: []string{warningMsg},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PersistentVolumeClaimBound) Name() string {
+	return persistentVolumeClaimBoundName
+}
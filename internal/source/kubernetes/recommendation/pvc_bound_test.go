@@ -0,0 +1,103 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPersistentVolumeClaimBound_Do_HappyPath(t *testing.T) {
+	// given
+	tests := []struct {
+		name     string
+		phase    v1.PersistentVolumeClaimPhase
+		expected recommendation.Result
+	}{
+		{
+			name:  "Pending claim",
+			phase: v1.ClaimPending,
+			expected: recommendation.Result{
+				Warnings: []string{
+					"PersistentVolumeClaim 'foo/data' is stuck in Pending phase. Verify that the 'fast' StorageClass exists and can provision volumes, or that a matching PersistentVolume is available.",
+				},
+			},
+		},
+		{
+			name:     "Bound claim",
+			phase:    v1.ClaimBound,
+			expected: recommendation.Result{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixPVC(tc.phase))
+			recomm := recommendation.NewPersistentVolumeClaimBound(dynamicCli)
+
+			k8sEvent := fixPVCProvisioningFailedEvent()
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(k8sEvent)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(k8sEvent.ObjectMeta, unstr, config.ErrorEvent, "v1/persistentvolumeclaims")
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func fixPVC(phase v1.PersistentVolumeClaimPhase) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data",
+			Namespace: "foo",
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.FromType("fast"),
+		},
+		Status: v1.PersistentVolumeClaimStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func fixPVCProvisioningFailedEvent() *v1.Event {
+	return &v1.Event{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Event",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data.1234",
+			Namespace: "foo",
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+			Name:       "data",
+			Namespace:  "foo",
+		},
+		Reason:  "ProvisioningFailed",
+		Message: `storageclass.storage.k8s.io "fast" not found`,
+		Type:    "Warning",
+	}
+}
@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	podsResourceType    = "v1/pods"
-	ingressResourceType = "networking.k8s.io/v1/ingresses"
+	podsResourceType        = "v1/pods"
+	ingressResourceType     = "networking.k8s.io/v1/ingresses"
+	deploymentsResourceType = "apps/v1/deployments"
+	servicesResourceType    = "v1/services"
 )
 
 // ResourceEventsForConfig returns the resource event map for a given source recommendations config.
@@ -22,10 +24,27 @@ func ResourceEventsForConfig(recCfg *config.Recommendations) map[string]config.E
 		resTypes[ingressResourceType] = config.CreateEvent
 	}
 
-	if ptr.ToValue(recCfg.Pod.NoLatestImageTag) || ptr.ToValue(recCfg.Pod.LabelsSet) {
+	if ptr.ToValue(recCfg.Pod.NoLatestImageTag) || ptr.ToValue(recCfg.Pod.LabelsSet) ||
+		ptr.ToValue(recCfg.Pod.ResourcesSet) || ptr.ToValue(recCfg.Pod.ProbesSet) ||
+		ptr.ToValue(recCfg.Pod.NoPrivilegedContainers) || ptr.ToValue(recCfg.Pod.NoHostPathVolumes) {
 		resTypes[podsResourceType] = config.CreateEvent
 	}
 
+	if ptr.ToValue(recCfg.Deployment.PodDisruptionBudgetSet) {
+		resTypes[deploymentsResourceType] = config.CreateEvent
+	}
+
+	if ptr.ToValue(recCfg.Service.SelectorMatchesPods) {
+		resTypes[servicesResourceType] = config.CreateEvent
+	}
+
+	// PersistentVolumeClaim recommendations are checked only for already watched error events,
+	// so other claim error events are not filtered out.
+
+	for _, rule := range recCfg.Custom {
+		resTypes[rule.Type] = config.CreateEvent
+	}
+
 	return resTypes
 }
 
@@ -62,6 +62,48 @@ func TestResourceEventsForConfig(t *testing.T) {
 				recommendation.IngressResourceType(): config.CreateEvent,
 			},
 		},
+		{
+			Name: "Pod Probes Set",
+			RecCfg: config.Recommendations{
+				Pod: config.PodRecommendations{
+					ProbesSet: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.PodResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Deployment Pod Disruption Budget Set",
+			RecCfg: config.Recommendations{
+				Deployment: config.DeploymentRecommendations{
+					PodDisruptionBudgetSet: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.DeploymentResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Service Selector Matches Pods",
+			RecCfg: config.Recommendations{
+				Service: config.ServiceRecommendations{
+					SelectorMatchesPods: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.ServiceResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Persistent Volume Claim Bound",
+			RecCfg: config.Recommendations{
+				PersistentVolumeClaim: config.PersistentVolumeClaimRecommendations{
+					Bound: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{},
+		},
 		{
 			Name: "All",
 			RecCfg: config.Recommendations{
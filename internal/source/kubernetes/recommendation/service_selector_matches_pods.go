@@ -0,0 +1,79 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const serviceSelectorMatchesPodsName = "ServiceSelectorMatchesPods"
+
+// ServiceSelectorMatchesPods adds warnings if Service selector doesn't match any Pod.
+type ServiceSelectorMatchesPods struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewServiceSelectorMatchesPods creates a new ServiceSelectorMatchesPods instance.
+func NewServiceSelectorMatchesPods(dynamicCli dynamic.Interface) *ServiceSelectorMatchesPods {
+	return &ServiceSelectorMatchesPods{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *ServiceSelectorMatchesPods) Do(ctx context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Service" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var svc coreV1.Service
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &svc)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, svc, err)
+	}
+
+	// Services without selector, e.g. ExternalName ones, have endpoints managed in a different way
+	if len(svc.Spec.Selector) == 0 {
+		return Result{}, nil
+	}
+
+	podGVR := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "pods",
+	}
+	selector := labels.SelectorFromSet(svc.Spec.Selector).String()
+	pods, err := f.dynamicCli.Resource(podGVR).Namespace(svc.Namespace).List(ctx, metaV1.ListOptions{
+		LabelSelector: selector,
+		Limit:         1,
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("while listing Pods: %w", err)
+	}
+
+	if len(pods.Items) > 0 {
+		return Result{}, nil
+	}
+
+	warningMsg := fmt.Sprintf("Selector '%s' of Service '%s/%s' doesn't match any Pod.", selector, svc.Namespace, svc.Name)
+	return Result{
+		Warnings: []string{warningMsg},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *ServiceSelectorMatchesPods) Name() string {
+	return serviceSelectorMatchesPodsName
+}
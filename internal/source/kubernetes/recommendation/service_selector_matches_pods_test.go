@@ -0,0 +1,87 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestServiceSelectorMatchesPods_Do_HappyPath(t *testing.T) {
+	// given
+	tests := []struct {
+		name     string
+		svc      *v1.Service
+		expected recommendation.Result
+	}{
+		{
+			name: "Selector doesn't match any Pod",
+			svc:  fixServiceWithSelector(map[string]string{"app": "other"}),
+			expected: recommendation.Result{
+				Warnings: []string{
+					"Selector 'app=other' of Service 'foo/svc' doesn't match any Pod.",
+				},
+			},
+		},
+		{
+			name:     "Selector matches Pod",
+			svc:      fixServiceWithSelector(map[string]string{"app": "foo"}),
+			expected: recommendation.Result{},
+		},
+		{
+			name:     "No selector",
+			svc:      fixServiceWithSelector(nil),
+			expected: recommendation.Result{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := fixPod()
+			pod.Labels = map[string]string{"app": "foo"}
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, pod)
+			recomm := recommendation.NewServiceSelectorMatchesPods(dynamicCli)
+
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.svc)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(tc.svc.ObjectMeta, unstr, config.CreateEvent, "v1/services")
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func fixServiceWithSelector(selector map[string]string) *v1.Service {
+	return &v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc",
+			Namespace: "foo",
+		},
+		Spec: v1.ServiceSpec{
+			Selector: selector,
+		},
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/commander"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/enrichment"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/filterengine"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
@@ -57,6 +58,8 @@ type Source struct {
 	eventCh                  chan source.Event
 	startTime                time.Time
 	recommFactory            RecommendationFactory
+	customRecomms            []recommendation.Recommendation
+	enrichmentRunner         enrichment.AggregatedRunner
 	commandGuard             *command.CommandGuard
 	filterEngine             filterengine.FilterEngine
 	clusterName              string
@@ -84,13 +87,21 @@ func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.Str
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
+	logger := loggerx.New(pkgConfig.Logger{
+		Level: cfg.Log.Level,
+	})
+
+	customRecomms, err := recommendation.CompileCustom(logger.WithField(componentLogFieldKey, "Recommendations"), cfg.Recommendations.Custom)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while compiling custom recommendations: %w", err)
+	}
+
 	s := Source{
-		startTime: time.Now(),
-		eventCh:   make(chan source.Event),
-		config:    cfg,
-		logger: loggerx.New(pkgConfig.Logger{
-			Level: cfg.Log.Level,
-		}),
+		startTime:                time.Now(),
+		eventCh:                  make(chan source.Event),
+		config:                   cfg,
+		logger:                   logger,
+		customRecomms:            customRecomms,
 		clusterName:              input.Context.ClusterName,
 		kubeConfig:               input.Context.KubeConfig,
 		isInteractivitySupported: input.Context.IsInteractivitySupported,
@@ -120,7 +131,8 @@ func consumeEvents(ctx context.Context, s Source) {
 	dynamicKubeInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(client.dynamicCli, s.config.InformerResyncPeriod)
 	router := NewRouter(client.mapper, client.dynamicCli, s.logger)
 	router.BuildTable(&s.config)
-	s.recommFactory = recommendation.NewFactory(s.logger.WithField("component", "Recommendations"), client.dynamicCli)
+	s.recommFactory = recommendation.NewFactory(s.logger.WithField("component", "Recommendations"), client.dynamicCli, s.customRecomms)
+	s.enrichmentRunner = enrichment.NewAggregatedRunner(s.logger.WithField(componentLogFieldKey, "Enrichment"), s.config.Enrichment, client.k8sCli, client.dynamicCli, client.mapper)
 	s.commandGuard = command.NewCommandGuard(s.logger.WithField(componentLogFieldKey, "Command Guard"), client.discoveryCli)
 	cmdr := commander.NewCommander(s.logger.WithField(componentLogFieldKey, "Commander"), s.commandGuard, s.config.Commands)
 	s.messageBuilder = NewMessageBuilder(s.isInteractivitySupported, s.logger.WithField(componentLogFieldKey, "Message Builder"), cmdr)
@@ -232,11 +244,17 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 		return
 	}
 
+	// Enrichment is optional, so the event is sent even if it fails
+	if err := s.enrichmentRunner.Do(ctx, &e); err != nil {
+		s.logger.Errorf("while running enrichment: %s", err.Error())
+	}
+
 	msg, err := s.messageBuilder.FromEvent(e, s.config.ExtraButtons)
 	if err != nil {
 		s.logger.Errorf("while rendering message from event: %w", err)
 		return
 	}
+	msg.InPlaceUpdate = inPlaceUpdate(s.config.UpdateInPlace, e)
 
 	message := source.Event{
 		Message:         msg,
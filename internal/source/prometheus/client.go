@@ -8,6 +8,7 @@ import (
 
 	promClient "github.com/prometheus/client_golang/api"
 	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
 )
 
 // Client prometheus client
@@ -41,6 +42,14 @@ func (a *alert) IsValid(request GetAlertsRequest) bool {
 	return false
 }
 
+func labelSetToMap(in model.LabelSet) map[string]string {
+	out := make(map[string]string, len(in))
+	for key, val := range in {
+		out[string(key)] = string(val)
+	}
+	return out
+}
+
 // NewClient initializes Prometheus client
 func NewClient(url string) (*Client, error) {
 	c, err := promClient.NewClient(promClient.Config{
@@ -11,17 +11,34 @@ import (
 	"github.com/kubeshop/botkube/pkg/pluginx"
 )
 
+// Mode defines how alerts are received by the source.
+type Mode string
+
+const (
+	// PollMode periodically fetches alerts from the Prometheus API.
+	PollMode Mode = "poll"
+
+	// WebhookMode receives alerts pushed by Alertmanager to the Botkube incoming webhook.
+	WebhookMode Mode = "webhook"
+)
+
 // Config prometheus configuration
 type Config struct {
-	URL             string               `yaml:"url,omitempty"`
-	AlertStates     []promApi.AlertState `yaml:"alertStates,omitempty"`
-	IgnoreOldAlerts *bool                `yaml:"ignoreOldAlerts,omitempty"`
-	Log             config.Logger        `yaml:"log"`
+	Mode            Mode                               `yaml:"mode,omitempty"`
+	URL             string                             `yaml:"url,omitempty"`
+	AlertStates     []promApi.AlertState               `yaml:"alertStates,omitempty"`
+	IgnoreOldAlerts *bool                              `yaml:"ignoreOldAlerts,omitempty"`
+	AlertName       config.RegexConstraints            `yaml:"alertName,omitempty"`
+	Labels          map[string]config.RegexConstraints `yaml:"labels,omitempty"`
+	Annotations     map[string]config.RegexConstraints `yaml:"annotations,omitempty"`
+	MessageTemplate string                             `yaml:"messageTemplate,omitempty"`
+	Log             config.Logger                      `yaml:"log"`
 }
 
 // MergeConfigs merges all input configuration.
 func MergeConfigs(configs []*source.Config) (Config, error) {
 	defaults := Config{
+		Mode:            PollMode,
 		AlertStates:     []promApi.AlertState{promApi.AlertStateFiring, promApi.AlertStatePending, promApi.AlertStateInactive},
 		IgnoreOldAlerts: ptr.FromType(true),
 	}
@@ -31,5 +48,9 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 		return Config{}, fmt.Errorf("while merging configuration: %w", err)
 	}
 
+	if _, err := out.parseMessageTemplate(); err != nil {
+		return Config{}, fmt.Errorf("while validating merged configuration: %w", err)
+	}
+
 	return out, nil
 }
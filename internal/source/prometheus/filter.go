@@ -0,0 +1,53 @@
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+const alertNameLabel = "alertname"
+
+// IsAlertAllowed returns true if an alert with given labels and annotations matches the configured
+// alert name, labels and annotations constraints. Missing labels and annotations are matched as empty values.
+func (c Config) IsAlertAllowed(labels, annotations map[string]string) (bool, error) {
+	allowed, err := isAllowed(c.AlertName, labels[alertNameLabel])
+	if err != nil || !allowed {
+		return false, err
+	}
+
+	for _, key := range maputil.SortKeys(c.Labels) {
+		allowed, err := isAllowed(c.Labels[key], labels[key])
+		if err != nil {
+			return false, fmt.Errorf("while checking %q label: %w", key, err)
+		}
+		if !allowed {
+			return false, nil
+		}
+	}
+
+	for _, key := range maputil.SortKeys(c.Annotations) {
+		allowed, err := isAllowed(c.Annotations[key], annotations[key])
+		if err != nil {
+			return false, fmt.Errorf("while checking %q annotation: %w", key, err)
+		}
+		if !allowed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isAllowed checks a given value against the constraints. Unlike config.RegexConstraints.IsAllowed,
+// it allows all values if constraints are not defined, and all non-excluded values if only exclusions are defined.
+func isAllowed(constraints config.RegexConstraints, value string) (bool, error) {
+	if !constraints.AreConstraintsDefined() {
+		return true, nil
+	}
+	if len(constraints.Include) == 0 {
+		constraints.Include = []string{".*"}
+	}
+	return constraints.IsAllowed(value)
+}
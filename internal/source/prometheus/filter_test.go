@@ -0,0 +1,159 @@
+package prometheus
+
+import (
+	"testing"
+	"time"
+
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestConfigIsAlertAllowed(t *testing.T) {
+	labels := map[string]string{
+		"alertname": "KubePodCrashLooping",
+		"namespace": "team-a",
+		"severity":  "critical",
+	}
+	annotations := map[string]string{
+		"runbook_url": "https://runbooks.example.com/KubePodCrashLooping",
+	}
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected bool
+	}{
+		{
+			name:     "no constraints",
+			cfg:      Config{},
+			expected: true,
+		},
+		{
+			name: "included alert name",
+			cfg: Config{
+				AlertName: config.RegexConstraints{Include: []string{"KubePod.*"}},
+			},
+			expected: true,
+		},
+		{
+			name: "excluded alert name",
+			cfg: Config{
+				AlertName: config.RegexConstraints{Exclude: []string{"KubePodCrashLooping"}},
+			},
+			expected: false,
+		},
+		{
+			name: "matching labels",
+			cfg: Config{
+				Labels: map[string]config.RegexConstraints{
+					"severity":  {Include: []string{"warning", "critical"}},
+					"namespace": {Include: []string{"team-.*"}, Exclude: []string{"team-b"}},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "excluded label value",
+			cfg: Config{
+				Labels: map[string]config.RegexConstraints{
+					"namespace": {Include: []string{"team-.*"}, Exclude: []string{"team-a"}},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "missing label",
+			cfg: Config{
+				Labels: map[string]config.RegexConstraints{
+					"team": {Include: []string{"platform"}},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "exclusion of missing label",
+			cfg: Config{
+				Labels: map[string]config.RegexConstraints{
+					"team": {Exclude: []string{"platform"}},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "matching annotation",
+			cfg: Config{
+				Annotations: map[string]config.RegexConstraints{
+					"runbook_url": {Include: []string{"https://runbooks.example.com/.*"}},
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := tc.cfg.IsAlertAllowed(labels, annotations)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, allowed)
+		})
+	}
+}
+
+func TestConfigIsAlertAllowedInvalidRegex(t *testing.T) {
+	// given
+	cfg := Config{
+		Labels: map[string]config.RegexConstraints{
+			"severity": {Include: []string{"("}},
+		},
+	}
+
+	// when
+	_, err := cfg.IsAlertAllowed(map[string]string{"severity": "critical"}, nil)
+
+	// then
+	assert.EqualError(t, err, "while checking \"severity\" label: while matching \"critical\" with include regex \"(\": error parsing regexp: missing closing ): `(`")
+}
+
+func TestRenderMessageTemplate(t *testing.T) {
+	// given
+	cfg := Config{
+		MessageTemplate: `{{ .State | toString | upper }} {{ .Name }} in {{ .Labels.namespace }}: {{ .Annotations.summary }}{{ if .Labels.team }} (team {{ .Labels.team }}){{ end }}`,
+	}
+	alert := promApi.Alert{
+		ActiveAt: time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC),
+		Labels: model.LabelSet{
+			"alertname": "KubePodCrashLooping",
+			"namespace": "team-a",
+		},
+		Annotations: model.LabelSet{
+			"summary": "Pod is crash looping.",
+		},
+		State: promApi.AlertStateFiring,
+	}
+
+	// when
+	tpl, err := cfg.parseMessageTemplate()
+	require.NoError(t, err)
+	out, err := renderMessageTemplate(tpl, alert)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "FIRING KubePodCrashLooping in team-a: Pod is crash looping.", out)
+}
+
+func TestMergeConfigsInvalidMessageTemplate(t *testing.T) {
+	// given
+	configs := []*source.Config{
+		{RawYAML: []byte(`messageTemplate: "{{ .Name "`)},
+	}
+
+	// when
+	_, err := MergeConfigs(configs)
+
+	// then
+	assert.ErrorContains(t, err, "while validating merged configuration: while parsing message template")
+}
@@ -2,10 +2,13 @@ package prometheus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"text/template"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/internal/loggerx"
@@ -19,7 +22,7 @@ const (
 	// PluginName is the name of the Prometheus Botkube plugin.
 	PluginName = "prometheus"
 
-	description = "Get notifications about alerts polled from configured Prometheus AlertManager."
+	description = "Get notifications about alerts polled from configured Prometheus AlertManager or pushed by Alertmanager webhook."
 
 	pollPeriodInSeconds = 5
 )
@@ -28,8 +31,7 @@ const (
 type Source struct {
 	pluginVersion string
 	startedAt     time.Time
-
-	source.HandleExternalRequestUnimplemented
+	renderer      *webhookMessageRenderer
 }
 
 // NewSource returns a new instance of Source.
@@ -37,34 +39,102 @@ func NewSource(version string) *Source {
 	return &Source{
 		pluginVersion: version,
 		startedAt:     time.Now(),
+		renderer:      newWebhookMessageRenderer(),
 	}
 }
 
 // Stream streams prometheus alerts
 func (p *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
-	out := source.StreamOutput{Event: make(chan source.Event)}
 	config, err := MergeConfigs(input.Configs)
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
-	go p.consumeAlerts(ctx, config, out.Event)
+
+	if config.Mode == WebhookMode {
+		// alerts are pushed by Alertmanager and handled by HandleExternalRequest
+		loggerx.New(config.Log).Infof("Webhook mode enabled. Alertmanager should send alerts to %q.", input.Context.IncomingWebhook.FullURLForSource)
+		return source.StreamOutput{}, nil
+	}
+
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	go p.consumeAlerts(ctx, config, input.Context.IsInteractivitySupported, out.Event)
 
 	return out, nil
 }
 
+// HandleExternalRequest handles alerts pushed by the Alertmanager webhook.
+func (p *Source) HandleExternalRequest(_ context.Context, input source.ExternalRequestInput) (source.ExternalRequestOutput, error) {
+	cfg, err := MergeConfigs([]*source.Config{input.Config})
+	if err != nil {
+		return source.ExternalRequestOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if cfg.Mode != WebhookMode {
+		return source.ExternalRequestOutput{}, fmt.Errorf("incoming webhook requests are supported only in %q mode", WebhookMode)
+	}
+
+	log := loggerx.New(cfg.Log)
+	log.WithField("payload", string(input.Payload)).Debug("Handling Alertmanager webhook request...")
+
+	var payload WebhookMessage
+	if err := json.Unmarshal(input.Payload, &payload); err != nil {
+		return source.ExternalRequestOutput{}, fmt.Errorf("while unmarshalling Alertmanager webhook payload: %w", err)
+	}
+
+	var alerts []WebhookAlert
+	for _, alert := range payload.Alerts {
+		allowed, err := cfg.IsAlertAllowed(alert.Labels, alert.Annotations)
+		if err != nil {
+			return source.ExternalRequestOutput{}, fmt.Errorf("while filtering alerts: %w", err)
+		}
+		if !allowed {
+			log.WithField("labels", alert.Labels).Debug("Skipping alert as it doesn't match configured constraints.")
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	if len(alerts) == 0 {
+		log.Debug("All alerts were filtered out. Skipping...")
+		return source.ExternalRequestOutput{}, nil
+	}
+	payload.Alerts = alerts
+
+	tpl, err := cfg.parseMessageTemplate()
+	if err != nil {
+		return source.ExternalRequestOutput{}, err
+	}
+	msg, err := p.renderer.Render(payload, tpl, input.Context.IsInteractivitySupported)
+	if err != nil {
+		return source.ExternalRequestOutput{}, fmt.Errorf("while rendering message: %w", err)
+	}
+
+	return source.ExternalRequestOutput{
+		Event: source.Event{
+			Message:   msg,
+			RawObject: payload,
+		},
+	}, nil
+}
+
 // Metadata returns metadata of prometheus configuration
 func (p *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
 	return api.MetadataOutput{
 		Version:     p.pluginVersion,
 		Description: description,
 		JSONSchema:  jsonSchema(),
+		ExternalRequest: api.ExternalRequestMetadata{
+			Payload: api.ExternalRequestPayload{
+				JSONSchema: webhookPayloadJSONSchema(),
+			},
+		},
 	}, nil
 }
 
-func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source.Event) {
+func (p *Source) consumeAlerts(ctx context.Context, cfg Config, isInteractivitySupported bool, ch chan<- source.Event) {
 	log := loggerx.New(cfg.Log)
 	prometheus, err := NewClient(cfg.URL)
 	exitOnError(err, log)
+	tpl, err := cfg.parseMessageTemplate()
+	exitOnError(err, log)
 
 	for {
 		alerts, err := prometheus.Alerts(ctx, GetAlertsRequest{
@@ -76,26 +146,20 @@ func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source
 			log.Errorf("failed to get alerts. %v", err)
 		}
 		for _, alert := range alerts {
-			msg := api.Message{
-				Type:      api.NonInteractiveSingleSection,
-				Timestamp: time.Now(),
-				Sections: []api.Section{
-					{
-						TextFields: []api.TextField{
-							{Key: "Source", Value: PluginName},
-							{Key: "Alert Name", Value: string(alert.Labels["alertname"])},
-							{Key: "State", Value: string(alert.State)},
-						},
-						BulletLists: []api.BulletList{
-							{
-								Title: "Description",
-								Items: []string{
-									string(alert.Annotations["description"]),
-								},
-							},
-						},
-					},
-				},
+			allowed, err := cfg.IsAlertAllowed(labelSetToMap(alert.Labels), labelSetToMap(alert.Annotations))
+			if err != nil {
+				log.Errorf("failed to filter alert. %v", err)
+				continue
+			}
+			if !allowed {
+				log.WithField("labels", alert.Labels).Debug("Skipping alert as it doesn't match configured constraints.")
+				continue
+			}
+
+			msg, err := p.alertMessage(promApi.Alert(alert), tpl, isInteractivitySupported)
+			if err != nil {
+				log.Errorf("failed to render alert message. %v", err)
+				continue
 			}
 			ch <- source.Event{
 				Message:   msg,
@@ -107,6 +171,46 @@ func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source
 	}
 }
 
+func (p *Source) alertMessage(alert promApi.Alert, tpl *template.Template, isInteractivitySupported bool) (api.Message, error) {
+	section := api.Section{
+		TextFields: []api.TextField{
+			{Key: "Source", Value: PluginName},
+			{Key: "Alert Name", Value: string(alert.Labels[alertNameLabel])},
+			{Key: "State", Value: string(alert.State)},
+		},
+		BulletLists: []api.BulletList{
+			{
+				Title: "Description",
+				Items: []string{
+					string(alert.Annotations["description"]),
+				},
+			},
+		},
+	}
+	if tpl != nil {
+		body, err := renderMessageTemplate(tpl, alert)
+		if err != nil {
+			return api.Message{}, err
+		}
+		section = api.Section{
+			Base: api.Base{
+				Body: api.Body{Plaintext: body},
+			},
+		}
+	}
+
+	msg := api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: time.Now(),
+		Sections:  []api.Section{section},
+	}
+	if isInteractivitySupported && alert.State == promApi.AlertStateFiring {
+		msg.Type = api.DefaultMessage
+		msg.Sections[0].Buttons = silenceButtons(p.renderer.btnBuilder, labelSetToMap(alert.Labels))
+	}
+	return msg, nil
+}
+
 func jsonSchema() api.JSONSchema {
 	return api.JSONSchema{
 		Value: heredoc.Docf(`{
@@ -115,9 +219,25 @@ func jsonSchema() api.JSONSchema {
 		  "description": "%s",
 		  "type": "object",
 		  "properties": {
+			"mode": {
+			  "title": "Mode",
+			  "description": "Defines how alerts are received. In the poll mode, alerts are periodically fetched from the Prometheus endpoint. In the webhook mode, Alertmanager pushes alerts to the Botkube incoming webhook.",
+			  "type": "string",
+			  "default": "poll",
+			  "oneOf": [
+				{
+				  "const": "poll",
+				  "title": "Poll"
+				},
+				{
+				  "const": "webhook",
+				  "title": "Webhook"
+				}
+			  ]
+			},
 			"url": {
 			  "title": "Endpoint",
-			  "description": "Prometheus endpoint without API version and resource.",
+			  "description": "Prometheus endpoint without API version and resource. Required in the poll mode.",
 			  "type": "string",
 			  "format": "uri"
 			},
@@ -157,6 +277,32 @@ func jsonSchema() api.JSONSchema {
 			  "uniqueItems": true,
 			  "minItems": 1
 			},
+			"alertName": {
+			  "title": "Alert name",
+			  "description": "Include and exclude alerts by name. Exact values or regular expressions are supported.",
+			  "$ref": "#/definitions/regexConstraints"
+			},
+			"labels": {
+			  "title": "Labels",
+			  "description": "Include and exclude alerts by label values, e.g. severity, namespace or team. Missing labels are matched as empty values.",
+			  "type": "object",
+			  "additionalProperties": {
+				"$ref": "#/definitions/regexConstraints"
+			  }
+			},
+			"annotations": {
+			  "title": "Annotations",
+			  "description": "Include and exclude alerts by annotation values. Missing annotations are matched as empty values.",
+			  "type": "object",
+			  "additionalProperties": {
+				"$ref": "#/definitions/regexConstraints"
+			  }
+			},
+			"messageTemplate": {
+			  "title": "Message template",
+			  "description": "Go template used to render alert details. The Prometheus alert fields are available together with the .Name, .Labels and .Annotations shortcuts, e.g. {{ .Name }} in {{ .Labels.namespace }}. Sprig functions are supported.",
+			  "type": "string"
+			},
 			"log": {
 			  "title": "Logging",
 			  "description": "Logging configuration for the plugin.",
@@ -207,11 +353,78 @@ func jsonSchema() api.JSONSchema {
 			  }
 			}
 		  },
-		  "required": ["url"]
+		  "definitions": {
+			"regexConstraints": {
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			}
+		  },
+		  "if": {
+			"properties": {
+			  "mode": {
+				"const": "poll"
+			  }
+			}
+		  },
+		  "then": {
+			"required": ["url"]
+		  }
 		}`, description),
 	}
 }
 
+func webhookPayloadJSONSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Doc(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Alertmanager webhook",
+		  "description": "Alertmanager webhook payload in version 4.",
+		  "type": "object",
+		  "properties": {
+			"version": {
+			  "type": "string"
+			},
+			"status": {
+			  "type": "string"
+			},
+			"alerts": {
+			  "type": "array",
+			  "items": {
+				"type": "object",
+				"properties": {
+				  "status": {
+					"type": "string"
+				  },
+				  "labels": {
+					"type": "object"
+				  },
+				  "annotations": {
+					"type": "object"
+				  }
+				}
+			  }
+			}
+		  },
+		  "required": ["alerts"]
+		}`),
+	}
+}
+
 func exitOnError(err error, log logrus.FieldLogger) {
 	if err != nil {
 		log.Fatal(err)
@@ -0,0 +1,52 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+// MessageTemplateData holds data available in the custom message template.
+// Labels and Annotations shadow the embedded alert fields, so they can be accessed directly, e.g. {{ .Labels.severity }}.
+type MessageTemplateData struct {
+	promApi.Alert
+
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+func newMessageTemplateData(alert promApi.Alert) MessageTemplateData {
+	labels := labelSetToMap(alert.Labels)
+	return MessageTemplateData{
+		Alert:       alert,
+		Name:        labels[alertNameLabel],
+		Labels:      labels,
+		Annotations: labelSetToMap(alert.Annotations),
+	}
+}
+
+// parseMessageTemplate returns the compiled message template. It returns nil if the template is not configured.
+func (c Config) parseMessageTemplate() (*template.Template, error) {
+	if strings.TrimSpace(c.MessageTemplate) == "" {
+		return nil, nil
+	}
+
+	tpl, err := template.New("message").Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(c.MessageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing message template: %w", err)
+	}
+	return tpl, nil
+}
+
+func renderMessageTemplate(tpl *template.Template, alert promApi.Alert) (string, error) {
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, newMessageTemplateData(alert)); err != nil {
+		return "", fmt.Errorf("while rendering message template: %w", err)
+	}
+	return strings.TrimSpace(buff.String()), nil
+}
@@ -0,0 +1,343 @@
+package prometheus
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+const (
+	alertStatusFiring   = "firing"
+	alertStatusResolved = "resolved"
+
+	// maxRenderedAlerts is the maximum number of alerts rendered in a single message.
+	maxRenderedAlerts = 10
+
+	acknowledgeDuration = "1h"
+	acknowledgeComment  = "Acknowledged from chat."
+)
+
+var (
+	emojiForStatus = map[string]string{
+		alertStatusFiring:   "🔥",
+		alertStatusResolved: "🟢",
+	}
+	titleForStatus = map[string]string{
+		alertStatusFiring:   "Firing",
+		alertStatusResolved: "Resolved",
+	}
+)
+
+// WebhookMessage is the Alertmanager webhook payload in version 4.
+// See: https://prometheus.io/docs/alerting/latest/configuration/#webhook_config
+type WebhookMessage struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []WebhookAlert    `json:"alerts"`
+}
+
+// WebhookAlert holds a single alert from the Alertmanager webhook payload.
+type WebhookAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Name returns alert name.
+func (a WebhookAlert) Name() string {
+	return a.Labels["alertname"]
+}
+
+// Summary returns the most descriptive alert annotation.
+func (a WebhookAlert) Summary() string {
+	for _, key := range []string{"summary", "description", "message"} {
+		if val := a.Annotations[key]; val != "" {
+			return val
+		}
+	}
+	return ""
+}
+
+// PromAlert converts the webhook alert to the Prometheus API alert, so it can be used in the message template.
+func (a WebhookAlert) PromAlert() promApi.Alert {
+	state := promApi.AlertStateInactive
+	if a.Status == alertStatusFiring {
+		state = promApi.AlertStateFiring
+	}
+
+	return promApi.Alert{
+		ActiveAt:    a.StartsAt,
+		Annotations: mapToLabelSet(a.Annotations),
+		Labels:      mapToLabelSet(a.Labels),
+		State:       state,
+	}
+}
+
+// AlertsWithStatus returns alerts with a given status.
+func (m WebhookMessage) AlertsWithStatus(status string) []WebhookAlert {
+	var out []WebhookAlert
+	for _, alert := range m.Alerts {
+		if alert.Status == status {
+			out = append(out, alert)
+		}
+	}
+	return out
+}
+
+// webhookMessageRenderer renders the Alertmanager webhook payload as Botkube message.
+type webhookMessageRenderer struct {
+	btnBuilder *api.ButtonBuilder
+}
+
+func newWebhookMessageRenderer() *webhookMessageRenderer {
+	return &webhookMessageRenderer{
+		btnBuilder: api.NewMessageButtonBuilder(),
+	}
+}
+
+// Render returns a message for a given alert group. If the message template is provided, it is used to render alert details.
+func (r *webhookMessageRenderer) Render(in WebhookMessage, tpl *template.Template, isInteractivitySupported bool) (api.Message, error) {
+	if isInteractivitySupported {
+		return r.interactiveMessage(in, tpl)
+	}
+	return r.nonInteractiveMessage(in, tpl)
+}
+
+func (r *webhookMessageRenderer) interactiveMessage(in WebhookMessage, tpl *template.Template) (api.Message, error) {
+	header := api.Section{
+		Base: api.Base{
+			Header: r.title(in),
+		},
+		TextFields: r.groupFields(in),
+	}
+	if silenceURL := silenceURL(in.ExternalURL, in.GroupLabels); silenceURL != "" {
+		header.Buttons = append(header.Buttons, r.btnBuilder.ForURL("Silence group", silenceURL))
+	}
+
+	sections := []api.Section{header}
+	rendered := 0
+	for _, status := range []string{alertStatusFiring, alertStatusResolved} {
+		for _, alert := range in.AlertsWithStatus(status) {
+			if rendered >= maxRenderedAlerts {
+				break
+			}
+			section, err := r.alertSection(in, alert, tpl)
+			if err != nil {
+				return api.Message{}, err
+			}
+			sections = append(sections, section)
+			rendered++
+		}
+	}
+
+	if notRendered := len(in.Alerts) - rendered + in.TruncatedAlerts; notRendered > 0 {
+		last := &sections[len(sections)-1]
+		last.Context = append(last.Context, api.ContextItem{
+			Text: fmt.Sprintf("%d more alert(s) not displayed.", notRendered),
+		})
+	}
+
+	return api.Message{
+		Timestamp: time.Now(),
+		Sections:  sections,
+	}, nil
+}
+
+func (r *webhookMessageRenderer) alertSection(in WebhookMessage, alert WebhookAlert, tpl *template.Template) (api.Section, error) {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s %s", emojiForStatus[alert.Status], alert.Name()),
+		},
+	}
+
+	if tpl != nil {
+		body, err := renderMessageTemplate(tpl, alert.PromAlert())
+		if err != nil {
+			return api.Section{}, err
+		}
+		section.Body.Plaintext = body
+	} else {
+		section.Description = alert.Summary()
+		section.BulletLists = []api.BulletList{
+			{Title: "Labels", Items: keyValueItems(alert.Labels)},
+		}
+		if len(alert.Annotations) > 0 {
+			section.BulletLists = append(section.BulletLists, api.BulletList{
+				Title: "Annotations", Items: keyValueItems(alert.Annotations),
+			})
+		}
+	}
+
+	if alert.GeneratorURL != "" {
+		section.Buttons = append(section.Buttons, r.btnBuilder.ForURL("Open in Prometheus", alert.GeneratorURL))
+	}
+	if alert.Status == alertStatusFiring {
+		section.Buttons = append(section.Buttons, silenceButtons(r.btnBuilder, alert.Labels)...)
+	}
+
+	return section, nil
+}
+
+func (r *webhookMessageRenderer) nonInteractiveMessage(in WebhookMessage, tpl *template.Template) (api.Message, error) {
+	fields := append([]api.TextField{{Key: "Alert Group", Value: r.title(in)}}, r.groupFields(in)...)
+	if silenceURL := silenceURL(in.ExternalURL, in.GroupLabels); silenceURL != "" {
+		fields = append(fields, api.TextField{Key: "Silence", Value: silenceURL})
+	}
+
+	section := api.Section{
+		TextFields: fields,
+	}
+
+	rendered := 0
+	for _, status := range []string{alertStatusFiring, alertStatusResolved} {
+		var items []string
+		for _, alert := range in.AlertsWithStatus(status) {
+			if rendered >= maxRenderedAlerts {
+				break
+			}
+			item := alertSummaryLine(alert)
+			if tpl != nil {
+				body, err := renderMessageTemplate(tpl, alert.PromAlert())
+				if err != nil {
+					return api.Message{}, err
+				}
+				item = body
+			}
+			items = append(items, item)
+			rendered++
+		}
+		if len(items) == 0 {
+			continue
+		}
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: fmt.Sprintf("%s alerts", titleForStatus[status]),
+			Items: items,
+		})
+	}
+
+	if notRendered := len(in.Alerts) - rendered + in.TruncatedAlerts; notRendered > 0 {
+		section.TextFields = append(section.TextFields, api.TextField{Key: "Not displayed", Value: fmt.Sprintf("%d alert(s)", notRendered)})
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: time.Now(),
+		Sections:  []api.Section{section},
+	}, nil
+}
+
+// title returns the alert group title similar to the default Alertmanager one, e.g. "[FIRING:2] KubePodCrashLooping" or "[RESOLVED] KubePodCrashLooping".
+func (r *webhookMessageRenderer) title(in WebhookMessage) string {
+	labels := in.GroupLabels
+	if len(labels) == 0 {
+		labels = in.CommonLabels
+	}
+
+	var values []string
+	for _, key := range maputil.SortKeys(labels) {
+		values = append(values, labels[key])
+	}
+
+	status := strings.ToUpper(in.Status)
+	if in.Status == alertStatusFiring {
+		status = fmt.Sprintf("%s:%d", status, len(in.AlertsWithStatus(alertStatusFiring)))
+	}
+	title := fmt.Sprintf("[%s] %s", status, strings.Join(values, " "))
+	return strings.TrimSpace(fmt.Sprintf("%s %s", emojiForStatus[in.Status], title))
+}
+
+func (r *webhookMessageRenderer) groupFields(in WebhookMessage) []api.TextField {
+	fields := []api.TextField{
+		{Key: "Source", Value: PluginName},
+		{Key: "Status", Value: in.Status},
+	}
+	if in.Receiver != "" {
+		fields = append(fields, api.TextField{Key: "Receiver", Value: in.Receiver})
+	}
+	if len(in.GroupLabels) > 0 {
+		fields = append(fields, api.TextField{Key: "Group Labels", Value: strings.Join(keyValueItems(in.GroupLabels), ", ")})
+	}
+	return fields
+}
+
+func alertSummaryLine(alert WebhookAlert) string {
+	out := alert.Name()
+	if summary := alert.Summary(); summary != "" {
+		out = fmt.Sprintf("%s: %s", out, summary)
+	}
+
+	out = fmt.Sprintf("%s (labels: %s)", out, strings.Join(keyValueItems(alert.Labels), ", "))
+	if alert.GeneratorURL != "" {
+		out = fmt.Sprintf("%s, source: %s", out, alert.GeneratorURL)
+	}
+	return out
+}
+
+func mapToLabelSet(in map[string]string) model.LabelSet {
+	out := make(model.LabelSet, len(in))
+	for key, val := range in {
+		out[model.LabelName(key)] = model.LabelValue(val)
+	}
+	return out
+}
+
+func keyValueItems(in map[string]string) []string {
+	var out []string
+	for _, key := range maputil.SortKeys(in) {
+		out = append(out, fmt.Sprintf("%s=%s", key, in[key]))
+	}
+	return out
+}
+
+// silenceButtons returns buttons which create an Alertmanager silence for a given alert using the silence executor plugin.
+// The acknowledge button mutes the alert for a short period of time, so the on-call person can investigate it.
+func silenceButtons(btnBuilder *api.ButtonBuilder, labels map[string]string) []api.Button {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	var matchers []string
+	for _, key := range maputil.SortKeys(labels) {
+		matchers = append(matchers, fmt.Sprintf("--matcher %q", fmt.Sprintf("%s=%s", key, labels[key])))
+	}
+	cmd := fmt.Sprintf("silence create %s", strings.Join(matchers, " "))
+
+	return []api.Button{
+		btnBuilder.ForCommandWithoutDesc("Acknowledge", fmt.Sprintf("%s --duration %s --comment %q", cmd, acknowledgeDuration, acknowledgeComment), api.ButtonStylePrimary),
+		btnBuilder.ForCommandWithoutDesc("Silence", cmd),
+	}
+}
+
+// silenceURL returns Alertmanager UI URL which opens a new silence form with matchers for given labels.
+func silenceURL(externalURL string, labels map[string]string) string {
+	if externalURL == "" || len(labels) == 0 {
+		return ""
+	}
+
+	var matchers []string
+	for _, key := range maputil.SortKeys(labels) {
+		matchers = append(matchers, fmt.Sprintf("%s=%q", key, labels[key]))
+	}
+	filter := fmt.Sprintf("{%s}", strings.Join(matchers, ","))
+
+	return fmt.Sprintf("%s/#/silences/new?filter=%s", strings.TrimSuffix(externalURL, "/"), url.QueryEscape(filter))
+}
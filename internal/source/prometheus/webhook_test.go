@@ -0,0 +1,270 @@
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const fixWebhookPayload = `{
+  "version": "4",
+  "groupKey": "{}:{alertname=\"KubePodCrashLooping\"}",
+  "status": "firing",
+  "receiver": "botkube",
+  "groupLabels": {"alertname": "KubePodCrashLooping"},
+  "commonLabels": {"alertname": "KubePodCrashLooping", "severity": "warning"},
+  "externalURL": "http://alertmanager:9093",
+  "alerts": [
+    {
+      "status": "firing",
+      "labels": {"alertname": "KubePodCrashLooping", "pod": "api-0"},
+      "annotations": {"summary": "Pod is crash looping."},
+      "generatorURL": "http://prometheus:9090/graph?g0.expr=up",
+      "fingerprint": "a1"
+    },
+    {
+      "status": "resolved",
+      "labels": {"alertname": "KubePodCrashLooping", "pod": "api-1"},
+      "annotations": {"description": "Pod was crash looping."},
+      "fingerprint": "a2"
+    }
+  ]
+}`
+
+func TestHandleExternalRequest(t *testing.T) {
+	tests := []struct {
+		name                     string
+		config                   string
+		isInteractivitySupported bool
+
+		expErrMsg        string
+		expEmptyEvent    bool
+		expMsgType       api.MessageType
+		expSectionsCount int
+		expAlertsCount   int
+	}{
+		{
+			name:                     "interactive message",
+			config:                   "mode: webhook",
+			isInteractivitySupported: true,
+			expMsgType:               api.DefaultMessage,
+			expSectionsCount:         3,
+			expAlertsCount:           2,
+		},
+		{
+			name:             "non-interactive message",
+			config:           "mode: webhook",
+			expMsgType:       api.NonInteractiveSingleSection,
+			expSectionsCount: 1,
+			expAlertsCount:   2,
+		},
+		{
+			name: "filtered alerts",
+			config: heredoc.Doc(`
+				mode: webhook
+				labels:
+				  pod:
+				    include: ["api-1"]`),
+			expMsgType:       api.NonInteractiveSingleSection,
+			expSectionsCount: 1,
+			expAlertsCount:   1,
+		},
+		{
+			name: "all alerts filtered out",
+			config: heredoc.Doc(`
+				mode: webhook
+				alertName:
+				  exclude: ["KubePodCrashLooping"]`),
+			expEmptyEvent: true,
+		},
+		{
+			name:      "poll mode",
+			config:    "url: http://localhost:9090",
+			expErrMsg: `incoming webhook requests are supported only in "webhook" mode`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			src := NewSource("dev")
+			input := source.ExternalRequestInput{
+				Payload: []byte(fixWebhookPayload),
+				Config:  &source.Config{RawYAML: []byte(tc.config)},
+				Context: source.ExternalRequestInputContext{
+					CommonSourceContext: source.CommonSourceContext{
+						IsInteractivitySupported: tc.isInteractivitySupported,
+					},
+				},
+			}
+
+			// when
+			out, err := src.HandleExternalRequest(context.Background(), input)
+
+			// then
+			if tc.expErrMsg != "" {
+				assert.EqualError(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expEmptyEvent {
+				assert.True(t, out.Event.Message.IsEmpty())
+				return
+			}
+			assert.Equal(t, tc.expMsgType, out.Event.Message.Type)
+			assert.Len(t, out.Event.Message.Sections, tc.expSectionsCount)
+
+			payload, ok := out.Event.RawObject.(WebhookMessage)
+			require.True(t, ok)
+			assert.Len(t, payload.Alerts, tc.expAlertsCount)
+		})
+	}
+}
+
+func TestWebhookMessageRendererInteractive(t *testing.T) {
+	// given
+	payload := fixWebhookMessage(t)
+
+	// when
+	msg, err := newWebhookMessageRenderer().Render(payload, nil, true)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 3)
+
+	header := msg.Sections[0]
+	assert.Equal(t, "🔥 [FIRING:1] KubePodCrashLooping", header.Header)
+	require.Len(t, header.Buttons, 1)
+	assert.Equal(t, "Silence group", header.Buttons[0].Name)
+
+	firing := msg.Sections[1]
+	assert.Equal(t, "🔥 KubePodCrashLooping", firing.Header)
+	assert.Equal(t, "Pod is crash looping.", firing.Description)
+	assert.Equal(t, []string{"alertname=KubePodCrashLooping", "pod=api-0"}, firing.BulletLists[0].Items)
+	require.Len(t, firing.Buttons, 3)
+	assert.Equal(t, "Open in Prometheus", firing.Buttons[0].Name)
+	assert.Equal(t, "http://prometheus:9090/graph?g0.expr=up", firing.Buttons[0].URL)
+	assert.Equal(t, "Acknowledge", firing.Buttons[1].Name)
+	assert.Equal(t, `{{BotName}} silence create --matcher "alertname=KubePodCrashLooping" --matcher "pod=api-0" --duration 1h --comment "Acknowledged from chat."`, firing.Buttons[1].Command)
+	assert.Equal(t, "Silence", firing.Buttons[2].Name)
+	assert.Equal(t, `{{BotName}} silence create --matcher "alertname=KubePodCrashLooping" --matcher "pod=api-0"`, firing.Buttons[2].Command)
+
+	resolved := msg.Sections[2]
+	assert.Equal(t, "🟢 KubePodCrashLooping", resolved.Header)
+	assert.Equal(t, "Pod was crash looping.", resolved.Description)
+	assert.Empty(t, resolved.Buttons)
+}
+
+func TestWebhookMessageRendererNonInteractive(t *testing.T) {
+	// given
+	payload := fixWebhookMessage(t)
+
+	// when
+	msg, err := newWebhookMessageRenderer().Render(payload, nil, false)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 1)
+	section := msg.Sections[0]
+
+	assert.Equal(t, api.TextFields{
+		{Key: "Alert Group", Value: "🔥 [FIRING:1] KubePodCrashLooping"},
+		{Key: "Source", Value: PluginName},
+		{Key: "Status", Value: "firing"},
+		{Key: "Receiver", Value: "botkube"},
+		{Key: "Group Labels", Value: "alertname=KubePodCrashLooping"},
+		{Key: "Silence", Value: "http://alertmanager:9093/#/silences/new?filter=%7Balertname%3D%22KubePodCrashLooping%22%7D"},
+	}, section.TextFields)
+	assert.Equal(t, api.BulletLists{
+		{
+			Title: "Firing alerts",
+			Items: []string{"KubePodCrashLooping: Pod is crash looping. (labels: alertname=KubePodCrashLooping, pod=api-0), source: http://prometheus:9090/graph?g0.expr=up"},
+		},
+		{
+			Title: "Resolved alerts",
+			Items: []string{"KubePodCrashLooping: Pod was crash looping. (labels: alertname=KubePodCrashLooping, pod=api-1)"},
+		},
+	}, section.BulletLists)
+}
+
+func TestWebhookMessageRendererWithTemplate(t *testing.T) {
+	// given
+	payload := fixWebhookMessage(t)
+	cfg := Config{MessageTemplate: "{{ .Name }} on {{ .Labels.pod }}: {{ .Annotations.summary | default \"n/a\" }}"}
+	tpl, err := cfg.parseMessageTemplate()
+	require.NoError(t, err)
+
+	// when
+	msg, err := newWebhookMessageRenderer().Render(payload, tpl, true)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 3)
+	assert.Equal(t, "KubePodCrashLooping on api-0: Pod is crash looping.", msg.Sections[1].Body.Plaintext)
+	assert.Empty(t, msg.Sections[1].BulletLists)
+	assert.Equal(t, "KubePodCrashLooping on api-1: n/a", msg.Sections[2].Body.Plaintext)
+}
+
+func TestWebhookMessageRendererResolvedTitle(t *testing.T) {
+	// given
+	payload := fixWebhookMessage(t)
+	payload.Status = alertStatusResolved
+
+	// when
+	msg, err := newWebhookMessageRenderer().Render(payload, nil, true)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "🟢 [RESOLVED] KubePodCrashLooping", msg.Sections[0].Header)
+}
+
+func TestSilenceURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		externalURL string
+		labels      map[string]string
+		expected    string
+	}{
+		{
+			name:        "sorted matchers",
+			externalURL: "http://alertmanager:9093/",
+			labels:      map[string]string{"pod": "api-0", "alertname": "KubePodCrashLooping"},
+			expected:    "http://alertmanager:9093/#/silences/new?filter=%7Balertname%3D%22KubePodCrashLooping%22%2Cpod%3D%22api-0%22%7D",
+		},
+		{
+			name:     "no external URL",
+			labels:   map[string]string{"alertname": "KubePodCrashLooping"},
+			expected: "",
+		},
+		{
+			name:        "no labels",
+			externalURL: "http://alertmanager:9093",
+			expected:    "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, silenceURL(tc.externalURL, tc.labels))
+		})
+	}
+}
+
+func fixWebhookMessage(t *testing.T) WebhookMessage {
+	t.Helper()
+
+	src := NewSource("dev")
+	out, err := src.HandleExternalRequest(context.Background(), source.ExternalRequestInput{
+		Payload: []byte(fixWebhookPayload),
+		Config:  &source.Config{RawYAML: []byte("mode: webhook")},
+	})
+	require.NoError(t, err)
+
+	msg, ok := out.Event.RawObject.(WebhookMessage)
+	require.True(t, ok)
+	return msg
+}
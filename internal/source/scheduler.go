@@ -233,6 +233,24 @@ func (d *Scheduler) generateConfigs(ctx context.Context) error {
 				}
 			}
 		}
+
+		if commGroupCfg.Loki.Enabled {
+			if err := d.generateSourceConfigs(ctx, false, commGroupCfg.Loki.Bindings.Sources); err != nil {
+				return err
+			}
+		}
+
+		if commGroupCfg.OTLPLogs.Enabled {
+			if err := d.generateSourceConfigs(ctx, false, commGroupCfg.OTLPLogs.Bindings.Sources); err != nil {
+				return err
+			}
+		}
+
+		if commGroupCfg.Kafka.Enabled {
+			if err := d.generateSourceConfigs(ctx, false, commGroupCfg.Kafka.Bindings.Sources); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Schedule all sources used by actions
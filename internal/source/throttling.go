@@ -0,0 +1,220 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/maputil"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+var defaultFingerprintFields = []string{"kind", "namespace", "name", "reason"}
+
+// sendToNotifierFn sends a given message to a given notifier. If the channel is empty, the message is sent to all channels bound to the source.
+type sendToNotifierFn func(n notifier.Bot, channel string, msg interactive.CoreMessage)
+
+// eventThrottler suppresses similar events and limits the number of notifications sent to bot notifiers.
+// Notifications are limited per channel for bots implementing notifier.GatedBot, and per bot otherwise.
+// Sinks and actions are not throttled.
+type eventThrottler struct {
+	log        logrus.FieldLogger
+	cfg        config.SourceThrottling
+	sourceName string
+	notifiers  []notifier.Bot
+	send       sendToNotifierFn
+
+	mu         sync.Mutex
+	suppressed map[string]*suppressedEvents
+	limiters   map[limiterKey]*notifierLimiter
+}
+
+// limiterKey identifies a single token bucket. The channel is empty for bots which don't support per-channel limits.
+type limiterKey struct {
+	notifier notifier.Bot
+	channel  string
+}
+
+type suppressedEvents struct {
+	description string
+	count       int
+}
+
+type notifierLimiter struct {
+	limiter *rate.Limiter
+	dropped int
+}
+
+func newEventThrottler(log logrus.FieldLogger, cfg config.SourceThrottling, sourceName string, notifiers []notifier.Bot, send sendToNotifierFn) *eventThrottler {
+	return &eventThrottler{
+		log:        log,
+		cfg:        cfg,
+		sourceName: sourceName,
+		notifiers:  notifiers,
+		send:       send,
+		suppressed: map[string]*suppressedEvents{},
+		limiters:   map[limiterKey]*notifierLimiter{},
+	}
+}
+
+// ShouldSuppress returns true if a similar event was already dispatched within the deduplication window.
+// The first event with a given fingerprint opens a new window. Once it is closed, a summary with the number
+// of suppressed events is sent to all notifiers.
+func (t *eventThrottler) ShouldSuppress(event source.Event) bool {
+	if !t.cfg.Deduplication.Enabled {
+		return false
+	}
+
+	fingerprint, description := t.fingerprint(event)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if window, found := t.suppressed[fingerprint]; found {
+		window.count++
+		return true
+	}
+
+	t.suppressed[fingerprint] = &suppressedEvents{description: description}
+	time.AfterFunc(t.cfg.Deduplication.Window, func() {
+		t.closeDeduplicationWindow(fingerprint)
+	})
+	return false
+}
+
+// ChannelGate returns a gate which limits notifications sent by a given notifier separately for each channel.
+func (t *eventThrottler) ChannelGate(n notifier.Bot) notifier.ChannelGate {
+	return func(channel string) bool {
+		return t.Allow(n, channel)
+	}
+}
+
+// Allow returns true if a notification can be sent to a given notifier channel. An empty channel refers to all notifier channels.
+// Dropped notifications are counted and reported to the channel once the next token is available.
+func (t *eventThrottler) Allow(n notifier.Bot, channel string) bool {
+	if !t.cfg.RateLimit.Enabled {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := limiterKey{notifier: n, channel: channel}
+	l, found := t.limiters[key]
+	if !found {
+		l = &notifierLimiter{
+			limiter: rate.NewLimiter(rate.Every(t.cfg.RateLimit.RefillInterval), t.cfg.RateLimit.Burst),
+		}
+		t.limiters[key] = l
+	}
+
+	if l.limiter.Allow() {
+		return true
+	}
+
+	l.dropped++
+	if l.dropped == 1 {
+		// reserve the next token for the summary message
+		delay := l.limiter.Reserve().Delay()
+		time.AfterFunc(delay, func() {
+			t.reportDropped(key)
+		})
+	}
+	return false
+}
+
+func (t *eventThrottler) closeDeduplicationWindow(fingerprint string) {
+	t.mu.Lock()
+	window, found := t.suppressed[fingerprint]
+	delete(t.suppressed, fingerprint)
+	t.mu.Unlock()
+
+	if !found || window.count == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("Suppressed %d similar %s from the %q source in the last %s (%s).",
+		window.count, pluralizeEvents(window.count), t.sourceName, t.cfg.Deduplication.Window, window.description)
+	for _, n := range t.notifiers {
+		t.send(n, "", plaintextCoreMessage(msg))
+	}
+}
+
+func (t *eventThrottler) reportDropped(key limiterKey) {
+	t.mu.Lock()
+	l, found := t.limiters[key]
+	if !found {
+		t.mu.Unlock()
+		return
+	}
+	dropped := l.dropped
+	l.dropped = 0
+	t.mu.Unlock()
+
+	if dropped == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("Dropped %d %s from the %q source due to the notification rate limit.", dropped, pluralizeEvents(dropped), t.sourceName)
+	t.send(key.notifier, key.channel, plaintextCoreMessage(msg))
+}
+
+// fingerprint returns a fingerprint of a given event and its human-readable description.
+// If none of the configured fields is found, the fingerprint is computed from the whole event message.
+func (t *eventThrottler) fingerprint(event source.Event) (string, string) {
+	fields := t.cfg.Deduplication.Fields
+	if len(fields) == 0 {
+		fields = defaultFingerprintFields
+	}
+
+	obj := maputil.AsGeneric(event.RawObject)
+
+	var parts []string
+	for _, field := range fields {
+		val, found := maputil.Lookup(obj, field)
+		if !found {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s: %v", field, val))
+	}
+
+	if len(parts) == 0 {
+		raw, err := json.Marshal(event.Message)
+		if err != nil {
+			t.log.Debugf("while marshaling event message: %s", err.Error())
+		}
+		return hashString(string(raw)), "identical message"
+	}
+
+	description := strings.Join(parts, ", ")
+	return hashString(description), description
+}
+
+func hashString(in string) string {
+	sum := sha256.Sum256([]byte(in))
+	return hex.EncodeToString(sum[:])
+}
+
+func pluralizeEvents(count int) string {
+	if count == 1 {
+		return "event"
+	}
+	return "events"
+}
+
+func plaintextCoreMessage(msg string) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Message: api.NewPlaintextMessage(msg, false),
+	}
+}
@@ -0,0 +1,354 @@
+package source
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/eventhistory"
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/action"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/bot"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+func TestDispatcherDeduplication(t *testing.T) {
+	// given
+	const window = 200 * time.Millisecond
+	notifier := &fakeBotNotifier{}
+	dispatcher := newTestDispatcher(notifier)
+	dispatch := fixPluginDispatch(config.SourceThrottling{
+		Deduplication: config.EventDeduplication{
+			Enabled: true,
+			Window:  window,
+		},
+	})
+
+	// when
+	for i := 0; i < 5; i++ {
+		dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	}
+	dispatcher.dispatchMsg(context.Background(), fixK8sEvent("other-pod", "BackOff"), dispatch)
+
+	// then
+	assert.Eventually(t, func() bool {
+		return notifier.Count() == 2
+	}, window/2, 10*time.Millisecond)
+
+	// the summary is sent once the window is closed
+	assert.Eventually(t, func() bool {
+		return notifier.Count() == 3
+	}, 2*window, 10*time.Millisecond)
+	assert.Equal(t, `Suppressed 4 similar events from the "k8s-events" source in the last 200ms (kind: Pod, namespace: default, name: crashing-pod, reason: BackOff).`, notifier.Last().BaseBody.Plaintext)
+
+	// next event opens a new window
+	dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	assert.Eventually(t, func() bool {
+		return notifier.Count() == 4
+	}, window/2, 10*time.Millisecond)
+}
+
+func TestDispatcherRateLimit(t *testing.T) {
+	// given
+	const refillInterval = 200 * time.Millisecond
+	notifier := &fakeBotNotifier{}
+	dispatcher := newTestDispatcher(notifier)
+	dispatch := fixPluginDispatch(config.SourceThrottling{
+		RateLimit: config.NotificationRateLimit{
+			Enabled:        true,
+			Burst:          2,
+			RefillInterval: refillInterval,
+		},
+	})
+
+	// when
+	for i := 0; i < 5; i++ {
+		dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	}
+
+	// then
+	assert.Eventually(t, func() bool {
+		return notifier.Count() == 2
+	}, refillInterval/2, 10*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		return notifier.Count() == 3
+	}, 2*refillInterval, 10*time.Millisecond)
+	assert.Equal(t, `Dropped 3 events from the "k8s-events" source due to the notification rate limit.`, notifier.Last().BaseBody.Plaintext)
+}
+
+func TestDispatcherRateLimitPerChannel(t *testing.T) {
+	// given
+	const refillInterval = 200 * time.Millisecond
+	notifier := &fakeGatedBotNotifier{channels: []string{"alerts", "ops"}}
+	dispatcher := newTestDispatcher(notifier)
+	dispatch := fixPluginDispatch(config.SourceThrottling{
+		RateLimit: config.NotificationRateLimit{
+			Enabled:        true,
+			Burst:          2,
+			RefillInterval: refillInterval,
+		},
+	})
+
+	// when
+	for i := 0; i < 5; i++ {
+		dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	}
+
+	// then each channel has its own token bucket
+	assert.Eventually(t, func() bool {
+		return notifier.Count("alerts") == 2 && notifier.Count("ops") == 2
+	}, refillInterval/2, 10*time.Millisecond)
+
+	// when events are not sent to one of the channels, e.g. filtered out by routing rules
+	notifier.Mute("alerts")
+	for i := 0; i < 2; i++ {
+		dispatcher.dispatchMsg(context.Background(), fixK8sEvent("crashing-pod", "BackOff"), dispatch)
+	}
+
+	// then they are not counted for this channel, and the summary is sent only to a given channel
+	assert.Eventually(t, func() bool {
+		return notifier.Count("alerts") == 3 && notifier.Count("ops") == 3
+	}, 2*refillInterval, 10*time.Millisecond)
+	assert.Equal(t, `Dropped 3 events from the "k8s-events" source due to the notification rate limit.`, notifier.Last("alerts").BaseBody.Plaintext)
+	assert.Equal(t, `Dropped 5 events from the "k8s-events" source due to the notification rate limit.`, notifier.Last("ops").BaseBody.Plaintext)
+}
+
+func TestDispatcherResetsSourceStateOnStart(t *testing.T) {
+	// given
+	dispatcher := newTestDispatcher(&fakeBotNotifier{})
+	dispatch := fixPluginDispatch(config.SourceThrottling{
+		RateLimit: config.NotificationRateLimit{Enabled: true, Burst: 1, RefillInterval: time.Minute},
+	})
+	throttler := dispatcher.getThrottler(dispatch)
+
+	// when
+	dispatcher.resetSourceState(dispatch)
+
+	// then
+	assert.NotSame(t, throttler, dispatcher.getThrottler(dispatch))
+}
+
+func TestEventThrottlerFingerprint(t *testing.T) {
+	// given
+	throttler := newEventThrottler(loggerx.NewNoop(), config.SourceThrottling{
+		Deduplication: config.EventDeduplication{
+			Enabled: true,
+			Fields:  []string{"labels.alertname", "labels.namespace"},
+		},
+	}, "prometheus", nil, nil)
+
+	event := source.Event{
+		RawObject: map[string]any{
+			"labels": map[string]any{
+				"alertname": "KubePodCrashLooping",
+				"namespace": "default",
+				"pod":       "foo",
+			},
+		},
+	}
+
+	// when
+	fingerprint, desc := throttler.fingerprint(event)
+
+	// then
+	assert.NotEmpty(t, fingerprint)
+	assert.Equal(t, "labels.alertname: KubePodCrashLooping, labels.namespace: default", desc)
+
+	// when no fields found, the message is used
+	_, desc = throttler.fingerprint(source.Event{Message: api.NewPlaintextMessage("foo", false)})
+	assert.Equal(t, "identical message", desc)
+}
+
+func newTestDispatcher(n bot.Bot) *Dispatcher {
+	return NewDispatcher(
+		loggerx.NewNoop(),
+		"cluster",
+		map[string]bot.Bot{"fake": n},
+		nil,
+		nil,
+		&fakeActionProvider{},
+		analytics.NewNoopReporter(),
+		audit.GetReporter(false, loggerx.NewNoop(), nil),
+		eventhistory.NewNoopStore(),
+		nil,
+	)
+}
+
+func fixPluginDispatch(throttling config.SourceThrottling) PluginDispatch {
+	return PluginDispatch{
+		ctx:        context.Background(),
+		pluginName: "botkube/kubernetes",
+		sourceName: "k8s-events",
+		cfg: &config.Config{
+			Sources: map[string]config.Sources{
+				"k8s-events": {
+					Throttling: throttling,
+				},
+			},
+		},
+	}
+}
+
+func fixK8sEvent(name, reason string) source.Event {
+	return source.Event{
+		Message: api.NewPlaintextMessage(name, false),
+		RawObject: map[string]any{
+			"Kind":      "Pod",
+			"Namespace": "default",
+			"Name":      name,
+			"Reason":    reason,
+		},
+	}
+}
+
+type fakeActionProvider struct {
+	actions []action.Action
+	result  action.Result
+}
+
+func (f *fakeActionProvider) RenderedActions(any, []string) ([]action.Action, error) {
+	return f.actions, nil
+}
+
+func (f *fakeActionProvider) ExecuteAction(context.Context, action.Action) (action.Result, error) {
+	return f.result, nil
+}
+
+type fakeBotNotifier struct {
+	mu       sync.Mutex
+	messages []interactive.CoreMessage
+	// blockEvents, if set, blocks sending event notifications until it's closed.
+	blockEvents chan struct{}
+}
+
+func (f *fakeBotNotifier) Start(context.Context) error {
+	return nil
+}
+
+func (f *fakeBotNotifier) GetStatus() health.PlatformStatus {
+	return health.PlatformStatus{}
+}
+
+func (f *fakeBotNotifier) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
+	return f.SendMessage(ctx, msg, nil)
+}
+
+func (f *fakeBotNotifier) SendMessage(_ context.Context, msg interactive.CoreMessage, _ []string) error {
+	if _, isEvent := msg.Metadata.(interactive.EventMetadata); isEvent && f.blockEvents != nil {
+		<-f.blockEvents
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.messages = append(f.messages, msg)
+	return nil
+}
+
+func (f *fakeBotNotifier) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, _ []string) error {
+	return f.SendMessage(ctx, msg, nil)
+}
+
+func (f *fakeBotNotifier) IntegrationName() config.CommPlatformIntegration {
+	return config.MattermostCommPlatformIntegration
+}
+
+func (f *fakeBotNotifier) Type() config.IntegrationType {
+	return config.BotIntegrationType
+}
+
+func (f *fakeBotNotifier) Count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.messages)
+}
+
+func (f *fakeBotNotifier) Last() interactive.CoreMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.messages) == 0 {
+		return interactive.CoreMessage{}
+	}
+	return f.messages[len(f.messages)-1]
+}
+
+// fakeGatedBotNotifier is a bot notifier with multiple channels, which supports per-channel rate limits.
+type fakeGatedBotNotifier struct {
+	fakeBotNotifier
+
+	channels []string
+	muted    map[string]bool
+	received map[string][]interactive.CoreMessage
+}
+
+func (f *fakeGatedBotNotifier) SendMessage(ctx context.Context, msg interactive.CoreMessage, sources []string) error {
+	return f.SendGatedMessage(ctx, msg, sources, nil)
+}
+
+func (f *fakeGatedBotNotifier) SendGatedMessage(_ context.Context, msg interactive.CoreMessage, _ []string, gate notifier.ChannelGate) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, channel := range f.channels {
+		if f.muted[channel] || !gate.Allows(channel) {
+			continue
+		}
+		f.store(channel, msg)
+	}
+	return nil
+}
+
+func (f *fakeGatedBotNotifier) SendMessageToChannels(_ context.Context, msg interactive.CoreMessage, channels []string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, channel := range channels {
+		f.store(channel, msg)
+	}
+	return nil
+}
+
+func (f *fakeGatedBotNotifier) Mute(channel string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.muted == nil {
+		f.muted = map[string]bool{}
+	}
+	f.muted[channel] = true
+}
+
+func (f *fakeGatedBotNotifier) Count(channel string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.received[channel])
+}
+
+func (f *fakeGatedBotNotifier) Last(channel string) interactive.CoreMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	msgs := f.received[channel]
+	if len(msgs) == 0 {
+		return interactive.CoreMessage{}
+	}
+	return msgs[len(msgs)-1]
+}
+
+func (f *fakeGatedBotNotifier) store(channel string, msg interactive.CoreMessage) {
+	if f.received == nil {
+		f.received = map[string][]interactive.CoreMessage{}
+	}
+	f.received[channel] = append(f.received[channel], msg)
+}
+
+func (f *fakeBotNotifier) Messages() []interactive.CoreMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]interactive.CoreMessage{}, f.messages...)
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const digestKeyPrefix = "notification-digest."
+
+// Digest provides functionality to persist notification digests buffered by bots.
+type Digest struct {
+	systemConfigMapName      string
+	systemConfigMapNamespace string
+
+	k8sCli kubernetes.Interface
+}
+
+// NewForDigest returns a new Digest instance.
+func NewForDigest(ns, name string, k8sCli kubernetes.Interface) *Digest {
+	return &Digest{
+		systemConfigMapNamespace: ns,
+		systemConfigMapName:      name,
+		k8sCli:                   k8sCli,
+	}
+}
+
+// Load returns digests persisted under a given key. Returns empty data if they don't exist.
+func (a *Digest) Load(ctx context.Context, key string) ([]byte, error) {
+	obj, err := a.k8sCli.CoreV1().ConfigMaps(a.systemConfigMapNamespace).Get(ctx, a.systemConfigMapName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("while getting the Config Map: %w", err)
+	}
+
+	return []byte(obj.Data[digestKeyPrefix+key]), nil
+}
+
+// Save persists digests under a given key. Empty data removes the key.
+func (a *Digest) Save(ctx context.Context, key string, data []byte) error {
+	dataKey := digestKeyPrefix + key
+
+	old, err := a.k8sCli.CoreV1().ConfigMaps(a.systemConfigMapNamespace).Get(ctx, a.systemConfigMapName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		if len(data) == 0 {
+			return nil
+		}
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      a.systemConfigMapName,
+				Namespace: a.systemConfigMapNamespace,
+			},
+			Data: map[string]string{
+				dataKey: string(data),
+			},
+		}
+		_, err = a.k8sCli.CoreV1().ConfigMaps(a.systemConfigMapNamespace).Create(ctx, cm, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("while creating the ConfigMap with notification digests: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("while getting the Config Map: %w", err)
+	}
+
+	if _, found := old.Data[dataKey]; !found && len(data) == 0 {
+		return nil
+	}
+
+	newCM := old.DeepCopy()
+	if newCM.Data == nil {
+		newCM.Data = map[string]string{}
+	}
+	if len(data) == 0 {
+		delete(newCM.Data, dataKey)
+	} else {
+		newCM.Data[dataKey] = string(data)
+	}
+
+	_, err = a.k8sCli.CoreV1().ConfigMaps(a.systemConfigMapNamespace).Update(ctx, newCM, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("while updating the ConfigMap with notification digests: %w", err)
+	}
+	return nil
+}
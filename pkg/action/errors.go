@@ -0,0 +1,32 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SkippedActionError is an error returned when a given action execution was skipped due to the configured limits.
+type SkippedActionError struct {
+	msg string
+}
+
+// NewSkippedActionError returns a new SkippedActionError instance.
+func NewSkippedActionError(msg string, args ...any) *SkippedActionError {
+	return &SkippedActionError{msg: fmt.Sprintf(msg, args...)}
+}
+
+// Error returns the error message.
+func (e SkippedActionError) Error() string {
+	return e.msg
+}
+
+// Is returns true if target is skipped action error.
+func (e *SkippedActionError) Is(target error) bool {
+	_, ok := target.(*SkippedActionError)
+	return ok
+}
+
+// IsSkippedActionError returns true if one of the error in the chain is the skipped action error instance.
+func IsSkippedActionError(err error) bool {
+	return errors.Is(err, &SkippedActionError{})
+}
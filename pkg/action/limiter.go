@@ -0,0 +1,134 @@
+package action
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+type skipReason string
+
+const (
+	skipReasonCooldown      skipReason = "cooldown"
+	skipReasonDuplicate     skipReason = "duplicate"
+	skipReasonMaxConcurrent skipReason = "max_concurrent"
+)
+
+var skippedActionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "botkube_action_skipped_runs_total",
+	Help: "Total number of automated action executions skipped due to the configured cooldown, deduplication or concurrency limits.",
+}, []string{"action", "reason"})
+
+// actionLimiter enforces cooldown, deduplication and concurrency limits for a single action.
+type actionLimiter struct {
+	cfg config.Action
+	now func() time.Time
+
+	mu       sync.Mutex
+	running  int
+	inFlight map[string]int
+	lastRun  map[string]time.Time
+	skipped  map[skipReason]int
+}
+
+func newActionLimiter(cfg config.Action) *actionLimiter {
+	return &actionLimiter{
+		cfg:      cfg,
+		now:      time.Now,
+		inFlight: map[string]int{},
+		lastRun:  map[string]time.Time{},
+		skipped:  map[skipReason]int{},
+	}
+}
+
+// Acquire checks if the action can be executed for a given deduplication key. If so, it returns the release function
+// which must be called once the execution is finished. Otherwise, it returns the reason why the execution was skipped.
+func (l *actionLimiter) Acquire(key string) (func(), skipReason, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if reason, skip := l.shouldSkip(key); skip {
+		l.skipped[reason]++
+		return nil, reason, false
+	}
+
+	l.running++
+	l.inFlight[key]++
+	if l.cfg.Cooldown > 0 {
+		l.pruneExpiredCooldowns()
+		l.lastRun[key] = l.now()
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			l.running--
+			l.inFlight[key]--
+			if l.inFlight[key] <= 0 {
+				delete(l.inFlight, key)
+			}
+		})
+	}
+	return release, "", true
+}
+
+// PopSkippedSummary returns a summary of executions skipped since the last call and resets the counters.
+// Returns empty string if no execution was skipped.
+func (l *actionLimiter) PopSkippedSummary() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	total := 0
+	for _, count := range l.skipped {
+		total += count
+	}
+	if total == 0 {
+		return ""
+	}
+
+	summary := fmt.Sprintf("Skipped %d %s since the last one (cooldown: %d, duplicate: %d, concurrency limit: %d).",
+		total, pluralizeExecutions(total), l.skipped[skipReasonCooldown], l.skipped[skipReasonDuplicate], l.skipped[skipReasonMaxConcurrent])
+	l.skipped = map[skipReason]int{}
+	return summary
+}
+
+func (l *actionLimiter) shouldSkip(key string) (skipReason, bool) {
+	if l.cfg.DedupKey != "" && l.inFlight[key] > 0 {
+		return skipReasonDuplicate, true
+	}
+
+	if last, found := l.lastRun[key]; found && l.now().Sub(last) < l.cfg.Cooldown {
+		return skipReasonCooldown, true
+	}
+
+	if l.cfg.MaxConcurrent > 0 && l.running >= l.cfg.MaxConcurrent {
+		return skipReasonMaxConcurrent, true
+	}
+
+	return "", false
+}
+
+// pruneExpiredCooldowns removes keys which are not within the cooldown period anymore, so the map doesn't grow indefinitely.
+func (l *actionLimiter) pruneExpiredCooldowns() {
+	now := l.now()
+	for key, last := range l.lastRun {
+		if now.Sub(last) >= l.cfg.Cooldown {
+			delete(l.lastRun, key)
+		}
+	}
+}
+
+func pluralizeExecutions(count int) string {
+	if count == 1 {
+		return "execution"
+	}
+	return "executions"
+}
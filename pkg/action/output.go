@@ -0,0 +1,29 @@
+package action
+
+import (
+	"time"
+)
+
+// ResultEvent is sent to sinks configured as the action output destinations.
+type ResultEvent struct {
+	Type        string    `json:"type"`
+	Action      string    `json:"action"`
+	DisplayName string    `json:"displayName"`
+	Failed      bool      `json:"failed"`
+	Output      string    `json:"output"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+const resultEventType = "actionResult"
+
+// SinkEvent returns the result representation which is sent to sinks.
+func (r Result) SinkEvent(action Action) ResultEvent {
+	return ResultEvent{
+		Type:        resultEventType,
+		Action:      action.Name,
+		DisplayName: action.DisplayName,
+		Failed:      r.Failed,
+		Output:      messageOutput(r.Message),
+		Timestamp:   time.Now(),
+	}
+}
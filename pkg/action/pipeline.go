@@ -0,0 +1,212 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	sprig "github.com/go-task/slim-sprig"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	stepSkippedConditionMsg = "Skipped, as the `when` condition is not met."
+	stepSkippedFailureMsg   = "Skipped, as one of the previous steps failed."
+)
+
+// StepResult holds the result of an executed pipeline step. It is available in templates of the next steps.
+type StepResult struct {
+	Output  string
+	Failed  bool
+	Skipped bool
+}
+
+type pipelineRenderingData struct {
+	Event any
+	// Steps holds results of the previous steps indexed by the step name.
+	Steps map[string]StepResult
+	// Previous holds result of the last executed step.
+	Previous StepResult
+}
+
+// executePipeline executes action steps one by one and returns a message with outputs of all steps.
+// The result is marked as failed if at least one of the steps failed.
+func (p *Provider) executePipeline(ctx context.Context, action Action) Result {
+	data := pipelineRenderingData{
+		Event: action.Event,
+		Steps: make(map[string]StepResult),
+	}
+
+	var (
+		sections []api.Section
+		stopped  bool
+		failed   bool
+	)
+	for _, step := range action.Steps {
+		log := p.log.WithFields(logrus.Fields{
+			"action": action.DisplayName,
+			"step":   step.Name,
+		})
+
+		if stopped {
+			data.Steps[step.Name] = StepResult{Skipped: true}
+			sections = append(sections, skippedStepSection(step.Name, stepSkippedFailureMsg))
+			continue
+		}
+
+		shouldRun, err := p.evaluateStepCondition(step, data)
+		if err != nil {
+			log.Errorf("while evaluating step condition: %s", err.Error())
+		}
+		if err == nil && !shouldRun {
+			log.Debug("Step condition is not met. Skipping...")
+			data.Steps[step.Name] = StepResult{Skipped: true}
+			sections = append(sections, skippedStepSection(step.Name, stepSkippedConditionMsg))
+			continue
+		}
+
+		var cmd, output string
+		if err == nil {
+			cmd, output, err = p.executeStep(ctx, action, step, data)
+		}
+		if err != nil {
+			log.Infof("Step failed: %s", err.Error())
+			if output == "" {
+				output = err.Error()
+			}
+		}
+
+		result := StepResult{
+			Output: output,
+			Failed: err != nil,
+		}
+		data.Steps[step.Name] = result
+		data.Previous = result
+		sections = append(sections, executedStepSection(step.Name, cmd, result))
+
+		if !result.Failed {
+			continue
+		}
+		failed = true
+		if step.OnFailure != config.ContinueOnFailure {
+			stopped = true
+		}
+	}
+
+	return Result{
+		Message: interactive.CoreMessage{
+			Header: fmt.Sprintf("Action %q", action.DisplayName),
+			Message: api.Message{
+				Sections: sections,
+			},
+		},
+		Failed: failed,
+	}
+}
+
+func (p *Provider) evaluateStepCondition(step config.ActionStep, data pipelineRenderingData) (bool, error) {
+	if strings.TrimSpace(step.When) == "" {
+		return true, nil
+	}
+
+	out, err := p.renderTemplate("action-step-condition", step.When, data)
+	if err != nil {
+		return false, fmt.Errorf("while rendering condition for step %q: %w", step.Name, err)
+	}
+	return strings.TrimSpace(out) == "true", nil
+}
+
+// executeStep renders and executes a given step. Returns the rendered command together with the command output.
+func (p *Provider) executeStep(ctx context.Context, action Action, step config.ActionStep, data pipelineRenderingData) (string, string, error) {
+	cmd, err := p.renderTemplate("action-step-command", step.Command, data)
+	if err != nil {
+		return "", "", fmt.Errorf("while rendering command for step %q: %w", step.Name, err)
+	}
+	cmd = strings.TrimSpace(cmd)
+
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, step.Timeout)
+		defer cancel()
+	}
+
+	msg, err := p.executeCommand(ctx, action, cmd)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return cmd, "", fmt.Errorf("step timed out after %s", step.Timeout)
+	}
+	return cmd, messageOutput(msg), err
+}
+
+// renderTemplate renders a given step template. In contrast to single command actions, it doesn't escape HTML characters,
+// as step outputs are passed as-is to the next steps.
+func (p *Provider) renderTemplate(name, text string, data any) (string, error) {
+	tpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("while parsing template %q: %w", text, err)
+	}
+
+	var result bytes.Buffer
+	if err := tpl.Execute(&result, data); err != nil {
+		return "", fmt.Errorf("while rendering template %q: %w", text, err)
+	}
+	return result.String(), nil
+}
+
+// messageOutput returns the textual output of a given message.
+func messageOutput(msg interactive.CoreMessage) string {
+	var out []string
+	appendBody := func(body api.Body) {
+		for _, text := range []string{body.CodeBlock, body.Plaintext} {
+			if text = strings.TrimSpace(text); text != "" {
+				out = append(out, text)
+			}
+		}
+	}
+
+	appendBody(msg.BaseBody)
+	for _, section := range msg.Sections {
+		appendBody(section.Body)
+	}
+	return strings.Join(out, "\n")
+}
+
+func executedStepSection(name, cmd string, result StepResult) api.Section {
+	status := "Succeeded"
+	if result.Failed {
+		status = "Failed"
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: name,
+			Body: api.Body{
+				CodeBlock: result.Output,
+			},
+		},
+		Context: api.ContextItems{
+			{Text: status},
+		},
+	}
+	if cmd != "" {
+		section.Description = fmt.Sprintf("`%s`", cmd)
+	}
+	return section
+}
+
+func skippedStepSection(name, reason string) api.Section {
+	return api.Section{
+		Base: api.Base{
+			Header: name,
+		},
+		Context: api.ContextItems{
+			{Text: reason},
+		},
+	}
+}
@@ -27,9 +27,28 @@ const (
 
 // Action describes an automated action for a given event.
 type Action struct {
+	// Name is the name of the action configuration.
+	Name             string
 	Command          string
 	ExecutorBindings []string
 	DisplayName      string
+
+	// Steps holds the pipeline steps. If specified, Command is empty and the steps are rendered during execution,
+	// as they can refer to outputs of the previous steps.
+	Steps []config.ActionStep
+	// Event holds the event which triggered the action. It is used to render pipeline steps.
+	Event any
+	// DedupKey is the rendered deduplication key. Empty if deduplication is not configured.
+	DedupKey string
+	// Output defines where the action output is sent.
+	Output config.ActionOutput
+}
+
+// Result holds the action execution result.
+type Result struct {
+	Message interactive.CoreMessage
+	// Failed is true if the command, or one of the pipeline steps, failed.
+	Failed bool
 }
 
 // ExecutorFactory facilitates creation of execute.Executor instances.
@@ -42,18 +61,23 @@ type Provider struct {
 	log             logrus.FieldLogger
 	cfg             config.Actions
 	executorFactory ExecutorFactory
+	limiters        map[string]*actionLimiter
 }
 
 // NewProvider returns new instance of Provider.
 func NewProvider(log logrus.FieldLogger, cfg config.Actions, executorFactory ExecutorFactory) *Provider {
-	return &Provider{log: log, cfg: cfg, executorFactory: executorFactory}
+	limiters := make(map[string]*actionLimiter)
+	for name, action := range cfg {
+		limiters[name] = newActionLimiter(action)
+	}
+	return &Provider{log: log, cfg: cfg, executorFactory: executorFactory, limiters: limiters}
 }
 
 // RenderedActions finds and processes actions for given data.
 func (p *Provider) RenderedActions(e any, sourceBindings []string) ([]Action, error) {
 	var actions []Action
 	errs := multierror.New()
-	for _, action := range p.cfg {
+	for name, action := range p.cfg {
 		if !action.Enabled {
 			continue
 		}
@@ -62,6 +86,25 @@ func (p *Provider) RenderedActions(e any, sourceBindings []string) ([]Action, er
 			continue
 		}
 
+		dedupKey, err := p.renderTemplate("action-dedup-key", action.DedupKey, renderingData{Event: e})
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while rendering deduplication key for Action %q: %w", action.DisplayName, err))
+			continue
+		}
+
+		if len(action.Steps) > 0 {
+			actions = append(actions, Action{
+				Name:             name,
+				DedupKey:         dedupKey,
+				Output:           action.Output,
+				DisplayName:      action.DisplayName,
+				ExecutorBindings: action.Bindings.Executors,
+				Steps:            action.Steps,
+				Event:            e,
+			})
+			continue
+		}
+
 		p.log.Debugf("Rendering Action %q (command: %q)...", action.DisplayName, action.Command)
 		renderingData := renderingData{
 			Event: e,
@@ -75,6 +118,9 @@ func (p *Provider) RenderedActions(e any, sourceBindings []string) ([]Action, er
 		p.log.Debugf("Rendered command: %q", renderedCmd)
 
 		actions = append(actions, Action{
+			Name:             name,
+			DedupKey:         dedupKey,
+			Output:           action.Output,
 			DisplayName:      action.DisplayName,
 			Command:          fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, renderedCmd),
 			ExecutorBindings: action.Bindings.Executors,
@@ -84,8 +130,51 @@ func (p *Provider) RenderedActions(e any, sourceBindings []string) ([]Action, er
 	return actions, errs.ErrorOrNil()
 }
 
-// ExecuteAction executes action for given event.
-func (p *Provider) ExecuteAction(ctx context.Context, action Action) interactive.CoreMessage {
+// ExecuteAction executes action for given event. If the execution is skipped due to the configured cooldown,
+// deduplication or concurrency limits, it returns SkippedActionError.
+func (p *Provider) ExecuteAction(ctx context.Context, action Action) (Result, error) {
+	limiter, found := p.limiters[action.Name]
+	if !found {
+		return p.execute(ctx, action), nil
+	}
+
+	release, reason, ok := limiter.Acquire(action.DedupKey)
+	if !ok {
+		skippedActionsCounter.WithLabelValues(action.Name, string(reason)).Inc()
+		return Result{}, NewSkippedActionError("execution of Action %q skipped (reason: %s)", action.DisplayName, reason)
+	}
+	defer release()
+
+	result := p.execute(ctx, action)
+	if !p.cfg[action.Name].NotifySkipped {
+		return result, nil
+	}
+
+	if summary := limiter.PopSkippedSummary(); summary != "" {
+		result.Message.Sections = append(result.Message.Sections, api.Section{
+			Context: api.ContextItems{
+				{Text: summary},
+			},
+		})
+	}
+	return result, nil
+}
+
+func (p *Provider) execute(ctx context.Context, action Action) Result {
+	if len(action.Steps) > 0 {
+		return p.executePipeline(ctx, action)
+	}
+
+	response, err := p.executeCommand(ctx, action, action.Command)
+	return Result{
+		Message: response,
+		Failed:  err != nil,
+	}
+}
+
+// executeCommand executes a given command in the action context. Apart from the response message,
+// it returns the command execution error, if the executor reports it.
+func (p *Provider) executeCommand(ctx context.Context, action Action, cmd string) (interactive.CoreMessage, error) {
 	userName := fmt.Sprintf("Automation %q", action.DisplayName)
 	e := p.executorFactory.NewDefault(execute.NewDefaultInput{
 		Conversation: execute.Conversation{
@@ -98,7 +187,7 @@ func (p *Provider) ExecuteAction(ctx context.Context, action Action) interactive
 		CommGroupName:   unknownValue,
 		Platform:        unknownValue,
 		NotifierHandler: &universalNotifierHandler{},
-		Message:         strings.TrimSpace(strings.TrimPrefix(action.Command, api.MessageBotNamePlaceholder)),
+		Message:         strings.TrimSpace(strings.TrimPrefix(cmd, api.MessageBotNamePlaceholder)),
 		User: execute.UserInput{
 			Mention:     userName,
 			DisplayName: userName,
@@ -106,7 +195,11 @@ func (p *Provider) ExecuteAction(ctx context.Context, action Action) interactive
 	})
 	response := e.Execute(ctx)
 
-	return response
+	failingExecutor, ok := e.(interface{ ExecutionError() error })
+	if !ok {
+		return response, nil
+	}
+	return response, failingExecutor.ExecutionError()
 }
 
 type renderingData struct {
@@ -2,8 +2,11 @@ package action_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +38,7 @@ func TestProvider_RenderedActionsForEvent(t *testing.T) {
 			Event:          fixEvent("name"),
 			ExpectedResult: []action.Action{
 				{
+					Name:             "success",
 					Command:          "{{BotName}} kubectl get po name",
 					ExecutorBindings: []string{"executor-binding1", "executor-binding2"},
 					DisplayName:      "Success",
@@ -55,6 +59,7 @@ func TestProvider_RenderedActionsForEvent(t *testing.T) {
 			Event:          fixEvent("name"),
 			ExpectedResult: []action.Action{
 				{
+					Name:             "success",
 					Command:          "{{BotName}} kubectl get po name",
 					ExecutorBindings: []string{"executor-binding1", "executor-binding2"},
 					DisplayName:      "Success",
@@ -117,11 +122,320 @@ func TestProvider_ExecuteEventAction(t *testing.T) {
 	provider := action.NewProvider(loggerx.NewNoop(), config.Actions{}, execFactory)
 
 	// when
-	msg := provider.ExecuteAction(context.Background(), eventAction)
-	msg.ReplaceBotNamePlaceholder(botName)
+	result, err := provider.ExecuteAction(context.Background(), eventAction)
+	require.NoError(t, err)
+	result.Message.ReplaceBotNamePlaceholder(botName)
 
 	// then
-	assert.Equal(t, fixInteractiveMessage(botName), msg)
+	assert.Equal(t, fixInteractiveMessage(botName), result.Message)
+	assert.False(t, result.Failed)
+}
+
+func TestProvider_RenderedActionsWithSteps(t *testing.T) {
+	// given
+	steps := fixPipelineSteps()
+	cfg := config.Actions{
+		"pipeline": {
+			Enabled:     true,
+			DisplayName: "Pipeline",
+			Steps:       steps,
+			Bindings: config.ActionBindings{
+				Sources:   []string{"success"},
+				Executors: []string{"executor-binding1"},
+			},
+		},
+	}
+	provider := action.NewProvider(loggerx.NewNoop(), cfg, nil)
+
+	// when
+	result, err := provider.RenderedActions(fixEvent("foo"), []string{"success"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []action.Action{
+		{
+			Name:             "pipeline",
+			DisplayName:      "Pipeline",
+			ExecutorBindings: []string{"executor-binding1"},
+			Steps:            steps,
+			Event:            fixEvent("foo"),
+		},
+	}, result)
+}
+
+func TestProvider_ExecuteEventActionPipeline(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name             string
+		Outputs          map[string]fakeCommandResult
+		OnFailure        config.ActionStepFailurePolicy
+		ExpectedCommands []string
+		ExpectedSections []api.Section
+		ExpectedFailed   bool
+	}{
+		{
+			Name: "All steps executed",
+			Outputs: map[string]fakeCommandResult{
+				"kubectl get po foo -ojsonpath='{.status.phase}'": {output: "Failed"},
+				"kubectl logs foo": {output: "panic: oops"},
+				`gh create issue --title "foo failed" --body "panic: oops"`: {output: "Issue created"},
+			},
+			ExpectedCommands: []string{
+				"kubectl get po foo -ojsonpath='{.status.phase}'",
+				"kubectl logs foo",
+				`gh create issue --title "foo failed" --body "panic: oops"`,
+			},
+			ExpectedSections: []api.Section{
+				fixExecutedStepSection("getPod", "kubectl get po foo -ojsonpath='{.status.phase}'", "Failed", "Succeeded"),
+				fixExecutedStepSection("getLogs", "kubectl logs foo", "panic: oops", "Succeeded"),
+				fixExecutedStepSection("createIssue", `gh create issue --title "foo failed" --body "panic: oops"`, "Issue created", "Succeeded"),
+			},
+		},
+		{
+			Name: "Condition not met",
+			Outputs: map[string]fakeCommandResult{
+				"kubectl get po foo -ojsonpath='{.status.phase}'": {output: "Running"},
+			},
+			ExpectedCommands: []string{
+				"kubectl get po foo -ojsonpath='{.status.phase}'",
+			},
+			ExpectedSections: []api.Section{
+				fixExecutedStepSection("getPod", "kubectl get po foo -ojsonpath='{.status.phase}'", "Running", "Succeeded"),
+				fixSkippedStepSection("getLogs", "Skipped, as the `when` condition is not met."),
+				fixSkippedStepSection("createIssue", "Skipped, as the `when` condition is not met."),
+			},
+		},
+		{
+			Name:           "Stop on failure",
+			ExpectedFailed: true,
+			Outputs: map[string]fakeCommandResult{
+				"kubectl get po foo -ojsonpath='{.status.phase}'": {output: "Failed"},
+				"kubectl logs foo": {output: "Error from server (NotFound)", err: errors.New("exit status 1")},
+			},
+			ExpectedCommands: []string{
+				"kubectl get po foo -ojsonpath='{.status.phase}'",
+				"kubectl logs foo",
+			},
+			ExpectedSections: []api.Section{
+				fixExecutedStepSection("getPod", "kubectl get po foo -ojsonpath='{.status.phase}'", "Failed", "Succeeded"),
+				fixExecutedStepSection("getLogs", "kubectl logs foo", "Error from server (NotFound)", "Failed"),
+				fixSkippedStepSection("createIssue", "Skipped, as one of the previous steps failed."),
+			},
+		},
+		{
+			Name:           "Continue on failure",
+			OnFailure:      config.ContinueOnFailure,
+			ExpectedFailed: true,
+			Outputs: map[string]fakeCommandResult{
+				"kubectl get po foo -ojsonpath='{.status.phase}'": {output: "Failed"},
+				"kubectl logs foo": {output: "Error from server (NotFound)", err: errors.New("exit status 1")},
+			},
+			ExpectedCommands: []string{
+				"kubectl get po foo -ojsonpath='{.status.phase}'",
+				"kubectl logs foo",
+			},
+			ExpectedSections: []api.Section{
+				fixExecutedStepSection("getPod", "kubectl get po foo -ojsonpath='{.status.phase}'", "Failed", "Succeeded"),
+				fixExecutedStepSection("getLogs", "kubectl logs foo", "Error from server (NotFound)", "Failed"),
+				fixSkippedStepSection("createIssue", "Skipped, as the `when` condition is not met."),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			steps := fixPipelineSteps()
+			steps[1].OnFailure = tc.OnFailure
+			eventAction := action.Action{
+				ExecutorBindings: []string{"executor-binding1"},
+				DisplayName:      "Pipeline",
+				Steps:            steps,
+				Event:            fixEvent("foo"),
+			}
+
+			execFactory := &fakePipelineFactory{outputs: tc.Outputs}
+			provider := action.NewProvider(loggerx.NewNoop(), config.Actions{}, execFactory)
+
+			// when
+			result, err := provider.ExecuteAction(context.Background(), eventAction)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedCommands, execFactory.commands)
+			assert.Equal(t, `Action "Pipeline"`, result.Message.Header)
+			assert.Equal(t, tc.ExpectedSections, result.Message.Sections)
+			assert.Equal(t, tc.ExpectedFailed, result.Failed)
+		})
+	}
+}
+
+func TestProvider_ExecuteEventActionPipelineTimeout(t *testing.T) {
+	// given
+	eventAction := action.Action{
+		DisplayName: "Pipeline",
+		Steps: []config.ActionStep{
+			{
+				Name:    "slow",
+				Command: "kubectl get po {{ .Event.Name }}",
+				Timeout: time.Millisecond,
+			},
+		},
+		Event: fixEvent("foo"),
+	}
+
+	execFactory := &fakePipelineFactory{delay: time.Second}
+	provider := action.NewProvider(loggerx.NewNoop(), config.Actions{}, execFactory)
+
+	// when
+	result, err := provider.ExecuteAction(context.Background(), eventAction)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, result.Failed)
+	assert.Equal(t, []api.Section{
+		fixExecutedStepSection("slow", "kubectl get po foo", "step timed out after 1ms", "Failed"),
+	}, result.Message.Sections)
+}
+
+func TestProvider_ExecuteActionCooldown(t *testing.T) {
+	// given
+	cfg := config.Actions{
+		"describe": {
+			Enabled:       true,
+			DisplayName:   "Describe",
+			Command:       "kubectl describe po {{ .Event.Name }}",
+			Cooldown:      200 * time.Millisecond,
+			DedupKey:      "{{ .Event.Name }}",
+			NotifySkipped: true,
+			Bindings: config.ActionBindings{
+				Sources: []string{"success"},
+			},
+		},
+	}
+	provider := action.NewProvider(loggerx.NewNoop(), cfg, &fakePipelineFactory{})
+
+	run := func(name string) (action.Result, error) {
+		actions, err := provider.RenderedActions(fixEvent(name), []string{"success"})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.Equal(t, name, actions[0].DedupKey)
+
+		return provider.ExecuteAction(context.Background(), actions[0])
+	}
+
+	// when
+	_, err := run("foo")
+	// then
+	require.NoError(t, err)
+
+	// when
+	_, err = run("foo")
+	// then
+	assert.True(t, action.IsSkippedActionError(err))
+	assert.EqualError(t, err, `execution of Action "Describe" skipped (reason: cooldown)`)
+
+	// when
+	result, err := run("bar")
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []api.Section{
+		{
+			Context: api.ContextItems{
+				{Text: "Skipped 1 execution since the last one (cooldown: 1, duplicate: 0, concurrency limit: 0)."},
+			},
+		},
+	}, result.Message.Sections)
+
+	// when
+	time.Sleep(250 * time.Millisecond)
+	result, err = run("foo")
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, result.Message.Sections)
+}
+
+func TestProvider_ExecuteActionMaxConcurrent(t *testing.T) {
+	// given
+	cfg := config.Actions{
+		"logs": {
+			Enabled:       true,
+			DisplayName:   "Logs",
+			Command:       "kubectl logs {{ .Event.Name }}",
+			MaxConcurrent: 1,
+		},
+	}
+	execFactory := &blockingFactory{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	provider := action.NewProvider(loggerx.NewNoop(), cfg, execFactory)
+	logsAction := action.Action{Name: "logs", DisplayName: "Logs", Command: "kubectl logs foo"}
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := provider.ExecuteAction(context.Background(), logsAction)
+		errCh <- err
+	}()
+	<-execFactory.started
+
+	// when
+	_, err := provider.ExecuteAction(context.Background(), logsAction)
+
+	// then
+	assert.EqualError(t, err, `execution of Action "Logs" skipped (reason: max_concurrent)`)
+
+	// when
+	close(execFactory.release)
+
+	// then
+	require.NoError(t, <-errCh)
+	_, err = provider.ExecuteAction(context.Background(), logsAction)
+	require.NoError(t, err)
+}
+
+func fixPipelineSteps() []config.ActionStep {
+	return []config.ActionStep{
+		{
+			Name:    "getPod",
+			Command: "kubectl get po {{ .Event.Name }} -ojsonpath='{.status.phase}'",
+		},
+		{
+			Name:    "getLogs",
+			When:    `{{ eq .Steps.getPod.Output "Failed" }}`,
+			Command: "kubectl logs {{ .Event.Name }}",
+		},
+		{
+			Name:    "createIssue",
+			When:    `{{ and (not .Steps.getLogs.Skipped) (not .Previous.Failed) }}`,
+			Command: `gh create issue --title "{{ .Event.Name }} failed" --body "{{ .Steps.getLogs.Output }}"`,
+		},
+	}
+}
+
+func fixExecutedStepSection(name, cmd, output, status string) api.Section {
+	return api.Section{
+		Base: api.Base{
+			Header:      name,
+			Description: fmt.Sprintf("`%s`", cmd),
+			Body: api.Body{
+				CodeBlock: output,
+			},
+		},
+		Context: api.ContextItems{
+			{Text: status},
+		},
+	}
+}
+
+func fixSkippedStepSection(name, reason string) api.Section {
+	return api.Section{
+		Base: api.Base{
+			Header: name,
+		},
+		Context: api.ContextItems{
+			{Text: reason},
+		},
+	}
 }
 
 func fixActionsConfig() config.Actions {
@@ -195,6 +509,65 @@ func (fakeExecutor) Execute(_ context.Context) interactive.CoreMessage {
 	return fixInteractiveMessage("{{BotName}}")
 }
 
+type fakeCommandResult struct {
+	output string
+	err    error
+}
+
+type fakePipelineFactory struct {
+	outputs  map[string]fakeCommandResult
+	delay    time.Duration
+	commands []string
+}
+
+func (f *fakePipelineFactory) NewDefault(input execute.NewDefaultInput) execute.Executor {
+	f.commands = append(f.commands, input.Message)
+	return &fakePipelineExecutor{result: f.outputs[input.Message], delay: f.delay}
+}
+
+type fakePipelineExecutor struct {
+	result fakeCommandResult
+	delay  time.Duration
+}
+
+func (f *fakePipelineExecutor) Execute(ctx context.Context) interactive.CoreMessage {
+	select {
+	case <-ctx.Done():
+		f.result.err = ctx.Err()
+	case <-time.After(f.delay):
+	}
+
+	return interactive.CoreMessage{
+		Message: api.Message{
+			BaseBody: api.Body{
+				CodeBlock: f.result.output,
+			},
+		},
+	}
+}
+
+func (f *fakePipelineExecutor) ExecutionError() error {
+	return f.result.err
+}
+
+type blockingFactory struct {
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func (f *blockingFactory) NewDefault(execute.NewDefaultInput) execute.Executor {
+	return f
+}
+
+func (f *blockingFactory) Execute(context.Context) interactive.CoreMessage {
+	f.once.Do(func() {
+		close(f.started)
+	})
+	<-f.release
+	return interactive.CoreMessage{}
+}
+
 func fixInteractiveMessage(botName string) interactive.CoreMessage {
 	return interactive.CoreMessage{
 		Header: "Sample",
@@ -53,6 +53,10 @@ type (
 		// Limitations:
 		//   - It's available only for SocketSlack. In the future, it may be adopted across other platforms.
 		Message Message
+
+		// IsApproved is set to true if the command was approved by an authorized approver.
+		// Executors shouldn't ask for an additional confirmation of such command.
+		IsApproved bool
 	}
 
 	// Message holds information about the message that triggered a given Executor.
@@ -132,6 +136,7 @@ func (p *grpcClient) Execute(ctx context.Context, in ExecuteInput) (ExecuteOutpu
 		Context: &ExecuteContext{
 			IsInteractivitySupported: in.Context.IsInteractivitySupported,
 			KubeConfig:               in.Context.KubeConfig,
+			IsApproved:               in.Context.IsApproved,
 			Message: &MessageContext{
 				Text: in.Context.Message.Text,
 				Url:  in.Context.Message.URL,
@@ -239,6 +244,7 @@ func (p *grpcServer) Execute(ctx context.Context, request *ExecuteRequest) (*Exe
 			IsInteractivitySupported: request.Context.IsInteractivitySupported,
 			KubeConfig:               request.Context.KubeConfig,
 			Message:                  p.toMessageIfPresent(request.Context.Message),
+			IsApproved:               request.Context.IsApproved,
 		},
 	})
 	if err != nil {
@@ -60,6 +60,18 @@ type Message struct {
 	OnlyVisibleForYou bool        `json:"onlyVisibleForYou,omitempty" yaml:"onlyVisibleForYou"`
 	ReplaceOriginal   bool        `json:"replaceOriginal,omitempty" yaml:"replaceOriginal"`
 	UserHandle        string      `json:"userHandle,omitempty" yaml:"userHandle"`
+	// InPlaceUpdate defines that the message should update the previously sent message with the same key, instead of being sent as a new one.
+	InPlaceUpdate *InPlaceUpdate `json:"inPlaceUpdate,omitempty" yaml:"inPlaceUpdate"`
+}
+
+// InPlaceUpdate holds details about a message which updates the previously sent message.
+type InPlaceUpdate struct {
+	// Key identifies the updated message, e.g. the UID of a Kubernetes object.
+	Key string `json:"key" yaml:"key"`
+	// Change describes the state transition. It's added to the changelog of the updated message.
+	Change string `json:"change,omitempty" yaml:"change"`
+	// Expiry is the time after which a new message is sent instead of updating the previous one.
+	Expiry time.Duration `json:"expiry,omitempty" yaml:"expiry"`
 }
 
 func (msg *Message) IsEmpty() bool {
@@ -2,6 +2,7 @@ package bot
 
 import (
 	"context"
+	"strings"
 
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/internal/health"
@@ -71,9 +72,24 @@ type channelConfigByName struct {
 	notify bool
 }
 
+// isTargetChannel returns true if a given channel is referenced by its alias or one of its identifiers.
+func isTargetChannel(targets []string, channelRefs ...string) bool {
+	for _, target := range targets {
+		target = strings.TrimPrefix(target, "#")
+		for _, ref := range channelRefs {
+			if ref != "" && target == ref {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type CommGroupMetadata struct {
 	Name  string
 	Index int
+	// DigestStore persists buffered notification digests across restarts. If nil, digests are sent on shutdown.
+	DigestStore DigestStore
 }
 
 func AsNotifiers(bots map[string]Bot) []notifier.Bot {
@@ -0,0 +1,86 @@
+package bot
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/sliceutil"
+)
+
+// routeConditionData holds data available in the channel route conditions.
+type routeConditionData struct {
+	Event      any
+	SourceName string
+	Namespace  string
+	Level      string
+}
+
+// routeConditions caches parsed route conditions, as they are evaluated for each dispatched event.
+var routeConditions sync.Map
+
+// matchesChannelRoutes returns true if a given message should be sent to a channel with given routes.
+// If there are no routes, all messages are matched. Routes apply only to event notifications,
+// so other messages, such as action results, throttling summaries or digests, are always matched.
+func matchesChannelRoutes(log logrus.FieldLogger, routes []config.ChannelRoute, msg interactive.CoreMessage, sourceBindings []string) bool {
+	if len(routes) == 0 {
+		return true
+	}
+
+	meta, ok := msg.Metadata.(interactive.EventMetadata)
+	if !ok {
+		return true
+	}
+	data := routeConditionData{
+		Event:      meta.Event,
+		SourceName: meta.SourceName,
+		Namespace:  meta.Namespace,
+		Level:      meta.Level,
+	}
+
+	for _, route := range routes {
+		if len(route.Sources) > 0 && !sliceutil.Intersect(route.Sources, sourceBindings) {
+			continue
+		}
+		if route.When == "" {
+			return true
+		}
+
+		matched, err := evaluateRouteCondition(route, data)
+		if err != nil {
+			log.Errorf("while evaluating route condition %q: %s", route.When, err.Error())
+			continue
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func evaluateRouteCondition(route config.ChannelRoute, data routeConditionData) (bool, error) {
+	var tpl *template.Template
+	if cached, found := routeConditions.Load(route.When); found {
+		tpl = cached.(*template.Template)
+	} else {
+		parsed, err := route.ParseCondition()
+		if err != nil {
+			return false, err
+		}
+		routeConditions.Store(route.When, parsed)
+		tpl = parsed
+	}
+
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, data); err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(buff.String()) == "true", nil
+}
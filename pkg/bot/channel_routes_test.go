@@ -0,0 +1,118 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestMatchesChannelRoutes(t *testing.T) {
+	// given
+	msg := interactive.CoreMessage{
+		Metadata: interactive.EventMetadata{
+			SourceName: "k8s-events",
+			Namespace:  "prod",
+			Level:      "error",
+			Event: map[string]any{
+				"Kind":   "Pod",
+				"Reason": "BackOff",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		routes   []config.ChannelRoute
+		expMatch bool
+	}{
+		{
+			name:     "No routes",
+			routes:   nil,
+			expMatch: true,
+		},
+		{
+			name: "Matching condition",
+			routes: []config.ChannelRoute{
+				{When: `{{ and (eq .Namespace "prod") (eq .Event.Kind "Pod") }}`},
+			},
+			expMatch: true,
+		},
+		{
+			name: "Not matching condition",
+			routes: []config.ChannelRoute{
+				{When: `{{ eq .Level "info" }}`},
+			},
+			expMatch: false,
+		},
+		{
+			name: "Route for a different source",
+			routes: []config.ChannelRoute{
+				{Sources: []string{"prometheus"}},
+			},
+			expMatch: false,
+		},
+		{
+			name: "Second route matches",
+			routes: []config.ChannelRoute{
+				{Sources: []string{"prometheus"}},
+				{Sources: []string{"k8s-events"}, When: `{{ hasPrefix "Back" .Event.Reason }}`},
+			},
+			expMatch: true,
+		},
+		{
+			name: "Condition with unknown field",
+			routes: []config.ChannelRoute{
+				{When: `{{ eq .Event.Unknown "foo" }}`},
+			},
+			expMatch: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			got := matchesChannelRoutes(loggerx.NewNoop(), tc.routes, msg, []string{"k8s-events"})
+
+			// then
+			assert.Equal(t, tc.expMatch, got)
+		})
+	}
+}
+
+func TestMatchesChannelRoutesForNonEventMessages(t *testing.T) {
+	// given
+	routes := []config.ChannelRoute{
+		{Sources: []string{"prometheus"}, When: `{{ eq .Level "error" }}`},
+	}
+
+	tests := []struct {
+		name string
+		msg  interactive.CoreMessage
+	}{
+		{
+			name: "Action result",
+			msg: interactive.CoreMessage{
+				Metadata: interactive.ActionMetadata{
+					ActionName:         "describe-created-resource",
+					EventCorrelationID: "event-id",
+				},
+			},
+		},
+		{
+			name: "Message without metadata",
+			msg:  interactive.CoreMessage{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			got := matchesChannelRoutes(loggerx.NewNoop(), routes, tc.msg, []string{"k8s-events"})
+
+			// then
+			assert.True(t, got)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/sirupsen/logrus"
@@ -21,6 +22,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -37,6 +39,11 @@ const (
 
 	// discordMaxMessageSize max size before a message should be uploaded as a file.
 	discordMaxMessageSize = 2000
+
+	// discordMaxThreadNameLength is the max length of the thread name.
+	discordMaxThreadNameLength = 100
+	// discordThreadArchiveDuration is the time in minutes after which inactive threads are archived.
+	discordThreadArchiveDuration = 1440
 )
 
 // Discord listens for user's message, execute commands and sends back the response.
@@ -52,6 +59,9 @@ type Discord struct {
 	botMentionRegex       *regexp.Regexp
 	commGroupMetadata     CommGroupMetadata
 	renderer              *DiscordRenderer
+	digest                *notificationDigest
+	threads               *messageThreads
+	updates               *inPlaceMessages
 	messages              chan discordMessage
 	discordMessageWorkers *pool.Pool
 	shutdownOnce          sync.Once
@@ -81,7 +91,7 @@ func NewDiscord(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata, cfg
 		return nil, fmt.Errorf("while creating Discord channels config: %w", err)
 	}
 
-	return &Discord{
+	b := &Discord{
 		log:                   log,
 		reporter:              reporter,
 		executorFactory:       executorFactory,
@@ -91,11 +101,18 @@ func NewDiscord(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata, cfg
 		channels:              channelsCfg,
 		botMentionRegex:       botMentionRegex,
 		renderer:              NewDiscordRenderer(),
+		threads:               newMessageThreads(),
+		updates:               newInPlaceMessages(),
 		messages:              make(chan discordMessage, platformMessageChannelSize),
 		discordMessageWorkers: pool.New().WithMaxGoroutines(platformMessageWorkersCount),
 		status:                health.StatusUnknown,
 		failureReason:         "",
-	}, nil
+	}
+	b.digest = newNotificationDigest(log, func(_ context.Context, channelID string, msg interactive.CoreMessage) error {
+		return b.send(channelID, msg)
+	}, digestStorageFor(commGroupMetadata, config.DiscordCommPlatformIntegration))
+
+	return b, nil
 }
 
 func (b *Discord) startMessageProcessor(ctx context.Context) {
@@ -114,6 +131,8 @@ func (b *Discord) startMessageProcessor(ctx context.Context) {
 func (b *Discord) Start(ctx context.Context) error {
 	b.log.Info("Starting bot")
 
+	go b.digest.Run(ctx)
+
 	// Register the messageCreate func as a callback for MessageCreate events.
 	b.api.AddHandler(func(s *discordgo.Session, m *discordgo.MessageCreate) {
 		b.messages <- discordMessage{
@@ -144,9 +163,44 @@ func (b *Discord) Start(ctx context.Context) error {
 
 // SendMessage sends interactive message to selected Discord channels.
 // Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
-func (b *Discord) SendMessage(_ context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Discord) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *Discord) SendGatedMessage(_ context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
+		channel := b.getChannels()[channelID]
+		if !matchesChannelRoutes(b.log, channel.Routes, msg, sourceBindings) {
+			continue
+		}
+		if !gate.Allows(channelID) {
+			continue
+		}
+		if b.digest.Buffer(channelID, channel.Notification.Digest, msg, sourceBindings) {
+			continue
+		}
+
+		err := b.send(channelID, msg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
+			continue
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// SendMessageToChannels sends interactive message to given Discord channels.
+// Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
+func (b *Discord) SendMessageToChannels(_ context.Context, msg interactive.CoreMessage, channels []string) error {
+	errs := multierror.New()
+	for channelID, channel := range b.getChannels() {
+		if !isTargetChannel(channels, channel.alias, channel.name, channelID) {
+			continue
+		}
+
 		err := b.send(channelID, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
@@ -261,11 +315,14 @@ func (b *Discord) handleMessage(ctx context.Context, dm discordMessage) error {
 			SourceBindings:   channel.Bindings.Sources,
 			IsKnown:          exists,
 			CommandOrigin:    command.TypedOrigin,
+			Approvers:        channel.Approvers,
 		},
 		Message: req,
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", dm.Event.Author.ID),
 			DisplayName: dm.Event.Author.String(),
+			ID:          dm.Event.Author.ID,
+			Email:       dm.Event.Author.Email,
 		},
 	})
 
@@ -282,19 +339,102 @@ func (b *Discord) send(channelID string, resp interactive.CoreMessage) error {
 	b.log.Debugf("Sending message to channel %q: %+v", channelID, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
+	resp, prevRef, found := b.updates.Prepare(resp, channelID)
 
 	discordMsg, err := b.formatMessage(resp)
 	if err != nil {
 		return fmt.Errorf("while formatting message: %w", err)
 	}
-	if _, err := b.api.ChannelMessageSendComplex(channelID, discordMsg); err != nil {
+
+	if found {
+		err := b.editMessage(prevRef, discordMsg)
+		if err == nil {
+			b.log.Debugf("Message successfully updated in channel %q", channelID)
+			return nil
+		}
+		b.log.Errorf("while updating message in place, sending a new one: %s", err.Error())
+	}
+
+	targetID := channelID
+	incidentParent, isFollowUp := b.threads.IncidentParent(resp, channelID)
+	if isFollowUp {
+		targetID = b.incidentThreadID(channelID, incidentParent)
+	}
+
+	sent, err := b.api.ChannelMessageSendComplex(targetID, discordMsg)
+	if err != nil {
 		return fmt.Errorf("while sending message: %w", discordError(err, channelID))
 	}
+	b.threads.Store(resp, channelID, sent.ID)
+	b.updates.Store(resp, channelID, sentMessageRef{ChannelID: channelID, MessageID: sent.ID})
+
+	if incident, resolved := resolvedIncident(resp); resolved && isFollowUp {
+		if err := b.updateIncidentStatus(channelID, incidentParent, incident); err != nil {
+			return err
+		}
+	}
 
 	b.log.Debugf("Message successfully sent to channel %q", channelID)
 	return nil
 }
 
+// incidentThreadID returns the ID of the thread started from the first incident notification.
+// The thread is started with the first follow-up message. Discord uses the message ID as the thread ID.
+func (b *Discord) incidentThreadID(channelID string, parent incidentThread) string {
+	if parent.Replies > 0 {
+		return parent.Ref
+	}
+
+	thread, err := b.api.MessageThreadStart(channelID, parent.Ref, incidentThreadName(parent.Message), discordThreadArchiveDuration)
+	if err != nil {
+		// the thread might have been already started for a concurrent follow-up message
+		b.log.Debugf("while starting incident thread: %s", discordError(err, channelID))
+		return parent.Ref
+	}
+	return thread.ID
+}
+
+// updateIncidentStatus updates the first incident notification with the resolved status header.
+func (b *Discord) updateIncidentStatus(channelID string, parent incidentThread, incident *interactive.Incident) error {
+	msg, err := b.formatMessage(withResolvedStatus(parent.Message, incident, time.Now()))
+	if err != nil {
+		return fmt.Errorf("while formatting incident status: %w", err)
+	}
+
+	if err := b.editMessage(sentMessageRef{ChannelID: channelID, MessageID: parent.Ref}, msg); err != nil {
+		return fmt.Errorf("while updating incident status: %w", err)
+	}
+	return nil
+}
+
+// editMessage replaces the content of a given message.
+func (b *Discord) editMessage(ref sentMessageRef, msg *discordgo.MessageSend) error {
+	edit := discordgo.NewMessageEdit(ref.ChannelID, ref.MessageID)
+	edit.Embeds = msg.Embeds
+	if msg.Content != "" {
+		edit.SetContent(msg.Content)
+	}
+	if _, err := b.api.ChannelMessageEditComplex(edit); err != nil {
+		return fmt.Errorf("while editing message: %w", discordError(err, ref.ChannelID))
+	}
+	return nil
+}
+
+// incidentThreadName returns the thread name based on the first incident notification header.
+func incidentThreadName(msg interactive.CoreMessage) string {
+	name := msg.Header
+	if len(msg.Sections) > 0 && msg.Sections[0].Base.Header != "" {
+		name = msg.Sections[0].Base.Header
+	}
+	if name == "" {
+		return "Incident"
+	}
+	if runes := []rune(name); len(runes) > discordMaxThreadNameLength {
+		return string(runes[:discordMaxThreadNameLength])
+	}
+	return name
+}
+
 // BotName returns the Bot name.
 func (b *Discord) BotName() string {
 	// Note: we can use the botID, but it's not rendered well.
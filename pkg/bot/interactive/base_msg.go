@@ -1,6 +1,8 @@
 package interactive
 
 import (
+	"time"
+
 	"github.com/kubeshop/botkube/pkg/api"
 )
 
@@ -12,3 +14,41 @@ type CoreMessage struct {
 	Messages    []api.Message
 	api.Message
 }
+
+// EventMetadata holds details about a source event a given message was produced for.
+type EventMetadata struct {
+	// SourceName is the name of the source configuration that produced the event.
+	SourceName string
+	// Namespace is the Kubernetes namespace of the event, if known.
+	Namespace string
+	// Level is the event level, e.g. `info` or `error`, if known.
+	Level string
+	// Event is the raw event object. It is used to evaluate channel routes and it's never sent to communication platforms.
+	Event any `json:"-"`
+	// CorrelationID identifies the event notification, so follow-up messages can be sent in its thread.
+	CorrelationID string
+	// Incident holds details about the incident the event was grouped into. It's nil if incident grouping is disabled.
+	Incident *Incident
+}
+
+// Incident holds details about a group of related events.
+type Incident struct {
+	// ID identifies the incident. The notification of its first event is used as the incident thread.
+	ID string
+	// FollowUp is true if the event is not the first one of the incident.
+	FollowUp bool
+	// Resolved is true if the event resolves the incident.
+	Resolved bool
+	// EventCount is the number of events grouped into the incident so far, including the current one.
+	EventCount int
+	// OpenedAt is the time when the incident was opened.
+	OpenedAt time.Time
+}
+
+// ActionMetadata holds details about an automated action a given message was produced for.
+type ActionMetadata struct {
+	// ActionName is the name of the action configuration.
+	ActionName string
+	// EventCorrelationID identifies the notification of the event which triggered the action.
+	EventCorrelationID string
+}
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/sirupsen/logrus"
@@ -22,6 +23,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -67,7 +69,12 @@ type Mattermost struct {
 	notifyMutex       sync.Mutex
 	botMentionRegex   *regexp.Regexp
 	renderer          *MattermostRenderer
+	digest            *notificationDigest
+	threads           *messageThreads
+	updates           *inPlaceMessages
+	usersMu           sync.RWMutex
 	userNamesForID    map[string]string
+	emailsForID       map[string]string
 	messages          chan mattermostMessage
 	messageWorkers    *pool.Pool
 	shutdownOnce      sync.Once
@@ -124,7 +131,7 @@ func NewMattermost(ctx context.Context, log logrus.FieldLogger, commGroupMetadat
 		return nil, fmt.Errorf("while getting bot user ID: %w", err)
 	}
 
-	return &Mattermost{
+	b := &Mattermost{
 		log:               log,
 		executorFactory:   executorFactory,
 		reporter:          reporter,
@@ -139,11 +146,17 @@ func NewMattermost(ctx context.Context, log logrus.FieldLogger, commGroupMetadat
 		botMentionRegex:   botMentionRegex,
 		renderer:          NewMattermostRenderer(),
 		userNamesForID:    map[string]string{},
+		emailsForID:       map[string]string{},
 		messages:          make(chan mattermostMessage, platformMessageChannelSize),
 		messageWorkers:    pool.New().WithMaxGoroutines(platformMessageWorkersCount),
+		threads:           newMessageThreads(),
+		updates:           newInPlaceMessages(),
 		status:            health.StatusUnknown,
 		failureReason:     "",
-	}, nil
+	}
+	b.digest = newNotificationDigest(log, b.send, digestStorageFor(commGroupMetadata, config.MattermostCommPlatformIntegration))
+
+	return b, nil
 }
 
 func (b *Mattermost) startMessageProcessor(ctx context.Context) {
@@ -162,6 +175,8 @@ func (b *Mattermost) startMessageProcessor(ctx context.Context) {
 func (b *Mattermost) Start(ctx context.Context) error {
 	b.log.Info("Starting bot")
 
+	go b.digest.Run(ctx)
+
 	// Check connection to Mattermost server
 	err := b.checkServerConnection(ctx)
 	if err != nil {
@@ -289,10 +304,13 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 			SourceBindings:   channel.Bindings.Sources,
 			IsKnown:          exists,
 			CommandOrigin:    command.TypedOrigin,
+			Approvers:        channel.Approvers,
 		},
 		User: execute.UserInput{
 			//Mention:     "", // not used currently
 			DisplayName: userName,
+			ID:          post.UserId,
+			Email:       b.getUserEmail(post.UserId),
 		},
 		Message: req,
 	})
@@ -310,19 +328,63 @@ func (b *Mattermost) send(ctx context.Context, channelID string, resp interactiv
 	b.log.Debugf("Sending message to channel %q: %+v", channelID, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
+	resp, prevRef, found := b.updates.Prepare(resp, channelID)
 	post, err := b.formatMessage(ctx, resp, channelID)
 	if err != nil {
 		return fmt.Errorf("while formatting message: %w", err)
 	}
 
-	if _, _, err := b.apiClient.CreatePost(ctx, post); err != nil {
+	if found {
+		post.Id = prevRef.MessageID
+		_, _, err := b.apiClient.UpdatePost(ctx, prevRef.MessageID, post)
+		if err == nil {
+			b.log.Debugf("Message successfully updated in channel %q", channelID)
+			return nil
+		}
+		b.log.Errorf("while updating message in place, sending a new one: %s", err.Error())
+		post.Id = ""
+	}
+
+	if rootID, found := b.threads.ParentRef(resp, channelID); found && resp.Type == api.ThreadMessage {
+		post.RootId = rootID
+	}
+	incidentParent, isFollowUp := b.threads.IncidentParent(resp, channelID)
+	if isFollowUp {
+		post.RootId = incidentParent.Ref
+	}
+
+	created, _, err := b.apiClient.CreatePost(ctx, post)
+	if err != nil {
 		b.log.Error("Failed to send message. Error: ", err)
+		return nil
+	}
+	b.threads.Store(resp, channelID, created.Id)
+	b.updates.Store(resp, channelID, sentMessageRef{ChannelID: channelID, MessageID: created.Id})
+
+	if incident, resolved := resolvedIncident(resp); resolved && isFollowUp {
+		if err := b.updateIncidentStatus(ctx, channelID, incidentParent, incident); err != nil {
+			return err
+		}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", channelID)
 	return nil
 }
 
+// updateIncidentStatus updates the first incident notification with the resolved status header.
+func (b *Mattermost) updateIncidentStatus(ctx context.Context, channelID string, parent incidentThread, incident *interactive.Incident) error {
+	post, err := b.formatMessage(ctx, withResolvedStatus(parent.Message, incident, time.Now()), channelID)
+	if err != nil {
+		return fmt.Errorf("while formatting incident status: %w", err)
+	}
+	post.Id = parent.Ref
+
+	if _, _, err := b.apiClient.UpdatePost(ctx, parent.Ref, post); err != nil {
+		return fmt.Errorf("while updating incident status: %w", err)
+	}
+	return nil
+}
+
 func (b *Mattermost) formatMessage(ctx context.Context, msg interactive.CoreMessage, channelID string) (*model.Post, error) {
 	// 1. Check the size and upload message as a file if it's too long
 	plaintext := interactive.MessageToPlaintext(msg, interactive.NewlineFormatter)
@@ -438,8 +500,42 @@ func (b *Mattermost) getChannelsToNotify(eventSources []string) []string {
 
 // SendMessage sends message to selected Mattermost channels.
 func (b *Mattermost) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *Mattermost) SendGatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
+		channel := b.getChannels()[channelID]
+		if !matchesChannelRoutes(b.log, channel.Routes, msg, sourceBindings) {
+			continue
+		}
+		if !gate.Allows(channelID) {
+			continue
+		}
+		if b.digest.Buffer(channelID, channel.Notification.Digest, msg, sourceBindings) {
+			continue
+		}
+
+		err := b.send(ctx, channelID, msg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Mattermost message to channel %q: %w", channelID, err))
+			continue
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// SendMessageToChannels sends message to given Mattermost channels.
+func (b *Mattermost) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, channels []string) error {
+	errs := multierror.New()
+	for channelID, channel := range b.getChannels() {
+		if !isTargetChannel(channels, channel.alias, channel.name, channelID) {
+			continue
+		}
+
 		err := b.send(ctx, channelID, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Mattermost message to channel %q: %w", channelID, err))
@@ -490,7 +586,9 @@ func (b *Mattermost) setChannels(channels map[string]channelConfigByID) {
 }
 
 func (b *Mattermost) getUserName(ctx context.Context, userID string) (string, error) {
+	b.usersMu.RLock()
 	userName, exists := b.userNamesForID[userID]
+	b.usersMu.RUnlock()
 	if exists {
 		return userName, nil
 	}
@@ -499,11 +597,21 @@ func (b *Mattermost) getUserName(ctx context.Context, userID string) (string, er
 	if err != nil {
 		return "", fmt.Errorf("while getting user with ID %q: %w", userID, err)
 	}
+	b.usersMu.Lock()
+	defer b.usersMu.Unlock()
 	b.userNamesForID[userID] = user.Username
+	b.emailsForID[userID] = user.Email
 
 	return user.Username, nil
 }
 
+// getUserEmail returns the user email cached while getting the user name.
+func (b *Mattermost) getUserEmail(userID string) string {
+	b.usersMu.RLock()
+	defer b.usersMu.RUnlock()
+	return b.emailsForID[userID]
+}
+
 func (b *Mattermost) shutdown() {
 	b.shutdownOnce.Do(func() {
 		b.log.Info("Shutting down mattermost message processor...")
@@ -0,0 +1,146 @@
+package bot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// maxMessageThreads is the maximum number of stored event notification references. The oldest ones are removed first.
+const maxMessageThreads = 1000
+
+// messageThreads stores references to event notifications sent to channels, so follow-up messages,
+// such as automated action results or next incident events, can be sent in their threads.
+type messageThreads struct {
+	mu        sync.Mutex
+	refs      map[string]string
+	incidents map[string]*incidentThread
+	order     []string
+}
+
+// incidentThread holds details about the first notification of an incident sent to a given channel.
+type incidentThread struct {
+	// Ref is the platform-specific reference of the notification.
+	Ref string
+	// Message is the notification message. It's used to update the status header once the incident is resolved.
+	Message interactive.CoreMessage
+	// Replies is the number of follow-up event notifications sent in the thread.
+	Replies int
+}
+
+func newMessageThreads() *messageThreads {
+	return &messageThreads{
+		refs:      map[string]string{},
+		incidents: map[string]*incidentThread{},
+	}
+}
+
+// Store saves a platform-specific reference, e.g. Slack message timestamp, of the event notification sent to a given channel.
+// Follow-up incident events are sent in the incident thread, so the thread reference is stored for them instead.
+// It's a no-op if a given message is not an event notification.
+func (t *messageThreads) Store(msg interactive.CoreMessage, channel, ref string) {
+	meta, ok := msg.Metadata.(interactive.EventMetadata)
+	if !ok || meta.CorrelationID == "" || ref == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := threadKey(meta.CorrelationID, channel)
+	if _, exists := t.refs[key]; exists {
+		return
+	}
+
+	if meta.Incident != nil {
+		incidentKey := threadKey(meta.Incident.ID, channel)
+		parent, found := t.incidents[incidentKey]
+		switch {
+		case found:
+			ref = parent.Ref
+			parent.Replies++
+		case !meta.Incident.FollowUp:
+			t.incidents[incidentKey] = &incidentThread{Ref: ref, Message: msg}
+			t.order = append(t.order, incidentKey)
+		}
+	}
+
+	t.refs[key] = ref
+	t.order = append(t.order, key)
+	for len(t.order) > maxMessageThreads {
+		delete(t.refs, t.order[0])
+		delete(t.incidents, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+// ParentRef returns the reference of the event notification in a given channel,
+// if a given message should be sent in its thread.
+func (t *messageThreads) ParentRef(msg interactive.CoreMessage, channel string) (string, bool) {
+	meta, ok := msg.Metadata.(interactive.ActionMetadata)
+	if !ok || meta.EventCorrelationID == "" {
+		return "", false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ref, found := t.refs[threadKey(meta.EventCorrelationID, channel)]
+	return ref, found
+}
+
+// IncidentParent returns the first notification of the incident in a given channel,
+// if a given message is a follow-up incident event notification.
+func (t *messageThreads) IncidentParent(msg interactive.CoreMessage, channel string) (incidentThread, bool) {
+	meta, ok := msg.Metadata.(interactive.EventMetadata)
+	if !ok || meta.Incident == nil || !meta.Incident.FollowUp {
+		return incidentThread{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	parent, found := t.incidents[threadKey(meta.Incident.ID, channel)]
+	if !found {
+		return incidentThread{}, false
+	}
+	return *parent, true
+}
+
+// resolvedIncident returns the incident details, if a given message resolves the incident.
+func resolvedIncident(msg interactive.CoreMessage) (*interactive.Incident, bool) {
+	meta, ok := msg.Metadata.(interactive.EventMetadata)
+	if !ok || meta.Incident == nil || !meta.Incident.Resolved {
+		return nil, false
+	}
+	return meta.Incident, true
+}
+
+// withResolvedStatus returns the first incident notification with the resolved status header.
+// Event notifications rendered in a simplified form use only the first section, so the header is added there.
+func withResolvedStatus(parent interactive.CoreMessage, incident *interactive.Incident, resolvedAt time.Time) interactive.CoreMessage {
+	status := fmt.Sprintf("✅ Resolved after %s (%d events)", resolvedAt.Sub(incident.OpenedAt).Round(time.Second), incident.EventCount)
+
+	if parent.Type == api.NonInteractiveSingleSection && len(parent.Sections) > 0 {
+		parent.Sections = slices.Clone(parent.Sections)
+		parent.Sections[0].Base.Header = joinHeader(status, parent.Sections[0].Base.Header)
+		return parent
+	}
+
+	parent.Header = joinHeader(status, parent.Header)
+	return parent
+}
+
+func joinHeader(status, header string) string {
+	if header == "" {
+		return status
+	}
+	return status + " | " + header
+}
+
+func threadKey(correlationID, channel string) string {
+	return correlationID + "/" + channel
+}
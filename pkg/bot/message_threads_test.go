@@ -0,0 +1,146 @@
+package bot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+func TestMessageThreads(t *testing.T) {
+	// given
+	threads := newMessageThreads()
+	eventMsg := interactive.CoreMessage{
+		Metadata: interactive.EventMetadata{CorrelationID: "event-1"},
+	}
+	actionMsg := interactive.CoreMessage{
+		Metadata: interactive.ActionMetadata{ActionName: "logs", EventCorrelationID: "event-1"},
+	}
+
+	// when
+	threads.Store(eventMsg, "alerts", "1680000000.000100")
+	threads.Store(eventMsg, "alerts", "1680000000.000200") // next message parts are ignored
+	threads.Store(actionMsg, "alerts", "1680000000.000300")
+
+	// then
+	ref, found := threads.ParentRef(actionMsg, "alerts")
+	assert.True(t, found)
+	assert.Equal(t, "1680000000.000100", ref)
+
+	_, found = threads.ParentRef(actionMsg, "ops")
+	assert.False(t, found)
+
+	_, found = threads.ParentRef(eventMsg, "alerts")
+	assert.False(t, found)
+
+	// when the limit is exceeded
+	for i := 0; i < maxMessageThreads; i++ {
+		threads.Store(interactive.CoreMessage{
+			Metadata: interactive.EventMetadata{CorrelationID: fmt.Sprintf("other-%d", i)},
+		}, "alerts", "ts")
+	}
+
+	// then the oldest reference is removed
+	_, found = threads.ParentRef(actionMsg, "alerts")
+	assert.False(t, found)
+}
+
+func TestMessageThreadsIncidents(t *testing.T) {
+	// given
+	threads := newMessageThreads()
+	incidentMsg := func(correlationID string, followUp bool) interactive.CoreMessage {
+		return interactive.CoreMessage{
+			Metadata: interactive.EventMetadata{
+				CorrelationID: correlationID,
+				Incident:      &interactive.Incident{ID: "incident-1", FollowUp: followUp},
+			},
+		}
+	}
+	first := incidentMsg("event-1", false)
+	followUp := incidentMsg("event-2", true)
+	actionMsg := interactive.CoreMessage{
+		Metadata: interactive.ActionMetadata{ActionName: "logs", EventCorrelationID: "event-2"},
+	}
+
+	// when
+	_, found := threads.IncidentParent(first, "alerts")
+
+	// then
+	assert.False(t, found)
+
+	// when
+	threads.Store(first, "alerts", "1680000000.000100")
+	parent, found := threads.IncidentParent(followUp, "alerts")
+
+	// then
+	require.True(t, found)
+	assert.Equal(t, incidentThread{Ref: "1680000000.000100", Message: first}, parent)
+
+	_, found = threads.IncidentParent(followUp, "ops")
+	assert.False(t, found)
+
+	// when
+	threads.Store(followUp, "alerts", "1680000000.000200")
+	parent, found = threads.IncidentParent(followUp, "alerts")
+
+	// then
+	require.True(t, found)
+	assert.Equal(t, 1, parent.Replies)
+
+	// action results of follow-up events are sent in the incident thread
+	ref, found := threads.ParentRef(actionMsg, "alerts")
+	assert.True(t, found)
+	assert.Equal(t, "1680000000.000100", ref)
+}
+
+func TestWithResolvedStatus(t *testing.T) {
+	// given
+	openedAt := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	incident := &interactive.Incident{ID: "incident-1", Resolved: true, EventCount: 3, OpenedAt: openedAt}
+
+	tests := []struct {
+		name     string
+		given    interactive.CoreMessage
+		expected interactive.CoreMessage
+	}{
+		{
+			name: "simplified event notification",
+			given: interactive.CoreMessage{
+				Message: api.Message{
+					Type:     api.NonInteractiveSingleSection,
+					Sections: []api.Section{{Base: api.Base{Header: "🔴 v1/pods error"}}},
+				},
+			},
+			expected: interactive.CoreMessage{
+				Message: api.Message{
+					Type:     api.NonInteractiveSingleSection,
+					Sections: []api.Section{{Base: api.Base{Header: "✅ Resolved after 5m30s (3 events) | 🔴 v1/pods error"}}},
+				},
+			},
+		},
+		{
+			name: "plaintext notification",
+			given: interactive.CoreMessage{
+				Message: api.NewPlaintextMessage("Pod is crashing", false),
+			},
+			expected: interactive.CoreMessage{
+				Header:  "✅ Resolved after 5m30s (3 events)",
+				Message: api.NewPlaintextMessage("Pod is crashing", false),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out := withResolvedStatus(tc.given, incident, openedAt.Add(5*time.Minute+30*time.Second))
+
+			// then
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package bot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+const (
+	// maxInPlaceMessages is the maximum number of stored references of messages updated in place. The oldest ones are removed first.
+	maxInPlaceMessages = 1000
+	// maxChangelogEntries is the maximum number of the most recent changes listed in the changelog.
+	// Older changes are summarized with a single line, so the message doesn't exceed the platform limits.
+	maxChangelogEntries = 10
+	changelogTitle      = "Changelog (UTC)"
+)
+
+// sentMessageRef identifies a message sent to a given channel.
+type sentMessageRef struct {
+	ChannelID string
+	MessageID string
+}
+
+// inPlaceMessages stores references to messages which are updated in place by next messages with the same key,
+// together with the changelog of all updates.
+type inPlaceMessages struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	messages map[string]*inPlaceMessage
+	order    []string
+}
+
+type inPlaceMessage struct {
+	ref       sentMessageRef
+	sentAt    time.Time
+	changelog []string
+	// earlierChanges is the number of changes removed from the changelog.
+	earlierChanges int
+}
+
+func newInPlaceMessages() *inPlaceMessages {
+	return &inPlaceMessages{
+		now:      time.Now,
+		messages: map[string]*inPlaceMessage{},
+	}
+}
+
+// Prepare records the change of a given message in the changelog, and returns the message with the changelog attached.
+// If the previous message with the same key was sent to a given channel and it's not expired, its reference is returned.
+// Otherwise, a new changelog is started, and the message should be sent as a new one.
+func (m *inPlaceMessages) Prepare(msg interactive.CoreMessage, channel string) (interactive.CoreMessage, sentMessageRef, bool) {
+	update := msg.InPlaceUpdate
+	if update == nil || update.Key == "" {
+		return msg, sentMessageRef{}, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	entry := changelogEntry(msg, now)
+
+	key := threadKey(update.Key, channel)
+	prev, found := m.messages[key]
+	if !found || prev.ref.MessageID == "" || now.Sub(prev.sentAt) >= update.Expiry {
+		m.messages[key] = &inPlaceMessage{
+			sentAt:    now,
+			changelog: []string{entry},
+		}
+		if !found {
+			m.order = append(m.order, key)
+		}
+		m.trim()
+		return msg, sentMessageRef{}, false
+	}
+
+	prev.addChange(entry)
+	return withChangelog(msg, prev.changelog, prev.earlierChanges), prev.ref, true
+}
+
+// Store saves the reference of a given message sent to a given channel, so it can be updated by next messages with the same key.
+// It's a no-op if a given message shouldn't be updated in place.
+func (m *inPlaceMessages) Store(msg interactive.CoreMessage, channel string, ref sentMessageRef) {
+	update := msg.InPlaceUpdate
+	if update == nil || update.Key == "" || ref.MessageID == "" {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := threadKey(update.Key, channel)
+	entry, found := m.messages[key]
+	if !found {
+		entry = &inPlaceMessage{
+			sentAt:    m.now(),
+			changelog: []string{changelogEntry(msg, m.now())},
+		}
+		m.messages[key] = entry
+		m.order = append(m.order, key)
+		m.trim()
+	}
+	entry.ref = ref
+}
+
+func (m *inPlaceMessages) trim() {
+	for len(m.order) > maxInPlaceMessages {
+		delete(m.messages, m.order[0])
+		m.order = m.order[1:]
+	}
+}
+
+// addChange appends a given entry to the changelog and removes the oldest entries above the maxChangelogEntries limit.
+func (m *inPlaceMessage) addChange(entry string) {
+	m.changelog = append(m.changelog, entry)
+	if overflow := len(m.changelog) - maxChangelogEntries; overflow > 0 {
+		m.changelog = slices.Clone(m.changelog[overflow:])
+		m.earlierChanges += overflow
+	}
+}
+
+func changelogEntry(msg interactive.CoreMessage, now time.Time) string {
+	ts := msg.Timestamp
+	if ts.IsZero() {
+		ts = now
+	}
+	return fmt.Sprintf("%s %s", ts.UTC().Format(time.TimeOnly), msg.InPlaceUpdate.Change)
+}
+
+// withChangelog returns a given message with the changelog attached to its first section.
+// Event notifications rendered in a simplified form use only the first section, so the changelog is added there.
+// If earlier changes were removed from the changelog, their number is listed first.
+func withChangelog(msg interactive.CoreMessage, changelog []string, earlierChanges int) interactive.CoreMessage {
+	var items []string
+	if earlierChanges > 0 {
+		items = append(items, fmt.Sprintf("+%d earlier", earlierChanges))
+	}
+	list := api.BulletList{
+		Title: changelogTitle,
+		Items: append(items, changelog...),
+	}
+
+	if len(msg.Sections) == 0 {
+		msg.Sections = []api.Section{{BulletLists: api.BulletLists{list}}}
+		return msg
+	}
+
+	msg.Sections = slices.Clone(msg.Sections)
+	msg.Sections[0].BulletLists = append(slices.Clone(msg.Sections[0].BulletLists), list)
+	return msg
+}
@@ -0,0 +1,171 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+func TestInPlaceMessages(t *testing.T) {
+	// given
+	now := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	updates := newInPlaceMessages()
+	updates.now = func() time.Time { return now }
+
+	podMsg := func(change string) interactive.CoreMessage {
+		return interactive.CoreMessage{
+			Message: api.Message{
+				Type: api.NonInteractiveSingleSection,
+				Sections: []api.Section{
+					{Base: api.Base{Header: "v1/pods updated"}},
+				},
+				InPlaceUpdate: &api.InPlaceUpdate{
+					Key:    "pod-uid",
+					Change: change,
+					Expiry: time.Hour,
+				},
+			},
+		}
+	}
+	sentRef := sentMessageRef{ChannelID: "C123", MessageID: "1680000000.000100"}
+
+	// when
+	first, _, found := updates.Prepare(podMsg("created"), "alerts")
+
+	// then
+	assert.False(t, found)
+	assert.Equal(t, podMsg("created"), first)
+
+	// when
+	updates.Store(first, "alerts", sentRef)
+	now = now.Add(time.Minute)
+	second, ref, found := updates.Prepare(podMsg("updated"), "alerts")
+
+	// then
+	require.True(t, found)
+	assert.Equal(t, sentRef, ref)
+	require.Len(t, second.Sections, 1)
+	assert.Equal(t, api.BulletLists{
+		{
+			Title: changelogTitle,
+			Items: []string{"10:00:00 created", "10:01:00 updated"},
+		},
+	}, second.Sections[0].BulletLists)
+
+	// when the same object is reported to a different channel
+	_, _, found = updates.Prepare(podMsg("updated"), "ops")
+
+	// then
+	assert.False(t, found)
+
+	// when the previous message is expired
+	now = now.Add(time.Hour)
+	expired, _, found := updates.Prepare(podMsg("deleted"), "alerts")
+
+	// then a new message with a new changelog is sent
+	assert.False(t, found)
+	assert.Empty(t, expired.Sections[0].BulletLists)
+
+	updates.Store(expired, "alerts", sentRef)
+	now = now.Add(time.Minute)
+	next, _, found := updates.Prepare(podMsg("recreated"), "alerts")
+	require.True(t, found)
+	assert.Equal(t, []string{"11:01:00 deleted", "11:02:00 recreated"}, next.Sections[0].BulletLists[0].Items)
+}
+
+func TestInPlaceMessagesChangelogLimit(t *testing.T) {
+	// given
+	now := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	updates := newInPlaceMessages()
+	updates.now = func() time.Time { return now }
+
+	podMsg := interactive.CoreMessage{
+		Message: api.Message{
+			InPlaceUpdate: &api.InPlaceUpdate{
+				Key:    "pod-uid",
+				Change: "updated",
+				Expiry: time.Hour,
+			},
+		},
+	}
+	first, _, _ := updates.Prepare(podMsg, "alerts")
+	updates.Store(first, "alerts", sentMessageRef{ChannelID: "C123", MessageID: "1"})
+
+	// when
+	var out interactive.CoreMessage
+	for i := 1; i <= maxChangelogEntries+4; i++ {
+		now = now.Add(time.Minute)
+		out, _, _ = updates.Prepare(podMsg, "alerts")
+	}
+
+	// then
+	items := out.Sections[0].BulletLists[0].Items
+	require.Len(t, items, maxChangelogEntries+1)
+	assert.Equal(t, "+5 earlier", items[0])
+	assert.Equal(t, "10:05:00 updated", items[1])
+	assert.Equal(t, "10:14:00 updated", items[len(items)-1])
+	assert.Len(t, updates.messages[threadKey("pod-uid", "alerts")].changelog, maxChangelogEntries)
+}
+
+func TestInPlaceMessagesWithoutKey(t *testing.T) {
+	// given
+	updates := newInPlaceMessages()
+	msg := interactive.CoreMessage{
+		Message: api.Message{
+			Sections: []api.Section{{Base: api.Base{Header: "Command output"}}},
+		},
+	}
+
+	// when
+	updates.Store(msg, "alerts", sentMessageRef{ChannelID: "C123", MessageID: "1"})
+	out, _, found := updates.Prepare(msg, "alerts")
+
+	// then
+	assert.False(t, found)
+	assert.Equal(t, msg, out)
+	assert.Empty(t, updates.messages)
+}
+
+func TestWithChangelog(t *testing.T) {
+	// given
+	list := api.BulletList{Title: "Messages", Items: []string{"Back-off restarting failed container"}}
+	msg := interactive.CoreMessage{
+		Message: api.Message{
+			Sections: []api.Section{
+				{BulletLists: api.BulletLists{list}},
+				{Base: api.Base{Body: api.Body{Plaintext: "second section"}}},
+			},
+		},
+	}
+
+	// when
+	out := withChangelog(msg, []string{"10:00:00 created"}, 0)
+
+	// then
+	assert.Equal(t, api.BulletLists{
+		list,
+		{Title: changelogTitle, Items: []string{"10:00:00 created"}},
+	}, out.Sections[0].BulletLists)
+	assert.Equal(t, msg.Sections[1], out.Sections[1])
+
+	// the original message is not modified
+	assert.Equal(t, api.BulletLists{list}, msg.Sections[0].BulletLists)
+
+	// when the message has no sections
+	out = withChangelog(interactive.CoreMessage{}, []string{"10:00:00 created"}, 0)
+
+	// then
+	require.Len(t, out.Sections, 1)
+	assert.Equal(t, changelogTitle, out.Sections[0].BulletLists[0].Title)
+
+	// when earlier changes were removed
+	out = withChangelog(interactive.CoreMessage{}, []string{"10:05:00 updated"}, 3)
+
+	// then
+	assert.Equal(t, []string{"+3 earlier", "10:05:00 updated"}, out.Sections[0].BulletLists[0].Items)
+}
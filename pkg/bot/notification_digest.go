@@ -0,0 +1,440 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	digestFlushCheckInterval = time.Second
+	digestShutdownTimeout    = 10 * time.Second
+	digestItemMaxLength      = 200
+	defaultDigestMaxItems    = 50
+	unknownDigestGroupValue  = "n/a"
+)
+
+var defaultDigestGroupBy = []config.DigestGroupKey{
+	config.SourceDigestGroupKey,
+	config.NamespaceDigestGroupKey,
+	config.LevelDigestGroupKey,
+}
+
+// digestSendFn sends a given message to a given channel.
+type digestSendFn func(ctx context.Context, channelID string, msg interactive.CoreMessage) error
+
+// DigestStore persists notification digests buffered by bots, so they are kept across Botkube restarts, e.g. during config reload.
+type DigestStore interface {
+	// Load returns data stored under a given key. Returns empty data if the key doesn't exist.
+	Load(ctx context.Context, key string) ([]byte, error)
+	// Save stores data under a given key. Empty data removes the key.
+	Save(ctx context.Context, key string, data []byte) error
+}
+
+// digestStorage holds the store and the key under which digests of a single bot are persisted.
+type digestStorage struct {
+	store DigestStore
+	key   string
+}
+
+// notificationDigest buffers notifications for channels with the digest mode enabled
+// and sends them as a single aggregated message at the end of each interval.
+type notificationDigest struct {
+	log     logrus.FieldLogger
+	send    digestSendFn
+	storage digestStorage
+	now     func() time.Time
+
+	mu      sync.Mutex
+	buffers map[string]*digestBuffer
+}
+
+type digestBuffer struct {
+	cfg       config.NotificationDigest
+	startedAt time.Time
+	groups    map[string]*digestGroup
+	listed    int
+	total     int
+}
+
+type digestGroup struct {
+	title string
+	items []string
+	count int
+}
+
+// persistedDigestBuffer is the persistence model of digestBuffer.
+type persistedDigestBuffer struct {
+	Cfg       config.NotificationDigest `json:"cfg"`
+	StartedAt time.Time                 `json:"startedAt"`
+	Groups    []persistedDigestGroup    `json:"groups"`
+	Listed    int                       `json:"listed"`
+	Total     int                       `json:"total"`
+}
+
+// persistedDigestGroup is the persistence model of digestGroup.
+type persistedDigestGroup struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+	Count int      `json:"count"`
+}
+
+func newNotificationDigest(log logrus.FieldLogger, send digestSendFn, storage digestStorage) *notificationDigest {
+	return &notificationDigest{
+		log:     log,
+		send:    send,
+		storage: storage,
+		now:     time.Now,
+		buffers: map[string]*digestBuffer{},
+	}
+}
+
+// digestStorageFor returns the digest storage for a given bot.
+func digestStorageFor(meta CommGroupMetadata, platform config.CommPlatformIntegration) digestStorage {
+	return digestStorage{
+		store: meta.DigestStore,
+		key:   fmt.Sprintf("%s.%s", meta.Name, platform),
+	}
+}
+
+// Buffer adds a given message to the digest of a given channel.
+// Returns false if the digest mode is not enabled for the channel and the message should be sent directly.
+func (d *notificationDigest) Buffer(channelID string, cfg config.NotificationDigest, msg interactive.CoreMessage, sourceBindings []string) bool {
+	if d == nil || !cfg.Enabled {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	buff, found := d.buffers[channelID]
+	if !found {
+		buff = &digestBuffer{
+			cfg:       cfg,
+			startedAt: d.now(),
+			groups:    map[string]*digestGroup{},
+		}
+		d.buffers[channelID] = buff
+	}
+	// always use the latest configuration, e.g. after notifications were reconfigured
+	buff.cfg = cfg
+
+	title := digestGroupTitle(cfg.GroupBy, msg, sourceBindings)
+	group, found := buff.groups[title]
+	if !found {
+		group = &digestGroup{title: title}
+		buff.groups[title] = group
+	}
+
+	group.count++
+	buff.total++
+	if buff.listed < maxDigestItems(cfg) {
+		group.items = append(group.items, digestItemFor(msg))
+		buff.listed++
+	}
+
+	return true
+}
+
+// Run periodically sends digests for which the interval has elapsed.
+// Digests persisted during the previous shutdown are restored on start. Once the context is cancelled, buffered notifications
+// are persisted, so they are sent at the end of their interval after restart, e.g. during config reload.
+// If the store is not configured, or persisting fails, buffered notifications are sent immediately, so they are not lost.
+func (d *notificationDigest) Run(ctx context.Context) {
+	d.restore(ctx)
+
+	ticker := time.NewTicker(digestFlushCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.shutdown()
+			return
+		case <-ticker.C:
+			d.flush(ctx, false)
+		}
+	}
+}
+
+func (d *notificationDigest) shutdown() {
+	// the parent context is already cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), digestShutdownTimeout)
+	defer cancel()
+
+	if d.storage.store == nil {
+		d.flush(ctx, true)
+		return
+	}
+
+	if err := d.persist(ctx); err != nil {
+		d.log.Errorf("while persisting notification digests, sending them immediately: %s", err.Error())
+		d.flush(ctx, true)
+	}
+}
+
+// persist saves all buffered digests in the store.
+func (d *notificationDigest) persist(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.buffers) == 0 {
+		return nil
+	}
+
+	out := map[string]persistedDigestBuffer{}
+	for channelID, buff := range d.buffers {
+		out[channelID] = buff.toPersisted()
+	}
+
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("while marshaling digests: %w", err)
+	}
+	if err := d.storage.store.Save(ctx, d.storage.key, raw); err != nil {
+		return err
+	}
+
+	d.buffers = map[string]*digestBuffer{}
+	return nil
+}
+
+// restore loads digests persisted during the previous shutdown and removes them from the store.
+func (d *notificationDigest) restore(ctx context.Context) {
+	if d.storage.store == nil {
+		return
+	}
+
+	raw, err := d.storage.store.Load(ctx, d.storage.key)
+	if err != nil {
+		d.log.Errorf("while loading persisted notification digests: %s", err.Error())
+		return
+	}
+	if len(raw) == 0 {
+		return
+	}
+
+	var persisted map[string]persistedDigestBuffer
+	if err := json.Unmarshal(raw, &persisted); err != nil {
+		d.log.Errorf("while unmarshaling persisted notification digests: %s", err.Error())
+		return
+	}
+
+	d.mu.Lock()
+	for channelID, item := range persisted {
+		restored := item.toBuffer()
+		if buff, found := d.buffers[channelID]; found {
+			restored.merge(buff)
+		}
+		d.buffers[channelID] = restored
+	}
+	d.mu.Unlock()
+
+	// digests are kept in memory from now on, so they are not sent twice after a crash
+	if err := d.storage.store.Save(ctx, d.storage.key, nil); err != nil {
+		d.log.Errorf("while removing persisted notification digests: %s", err.Error())
+	}
+	d.log.Infof("Restored notification digests for %d %s.", len(persisted), pluralize(len(persisted), "channel"))
+}
+
+func (d *notificationDigest) flush(ctx context.Context, all bool) {
+	for channelID, buff := range d.popReady(all) {
+		msg := buff.toMessage()
+		if err := d.send(ctx, channelID, msg); err != nil {
+			d.log.Errorf("while sending notification digest to channel %q: %s", channelID, err.Error())
+		}
+	}
+}
+
+func (d *notificationDigest) popReady(all bool) map[string]*digestBuffer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	out := map[string]*digestBuffer{}
+	for channelID, buff := range d.buffers {
+		if !all && now.Sub(buff.startedAt) < buff.cfg.Interval {
+			continue
+		}
+		out[channelID] = buff
+		delete(d.buffers, channelID)
+	}
+	return out
+}
+
+// merge adds notifications from a given buffer, which was started later.
+func (b *digestBuffer) merge(other *digestBuffer) {
+	b.cfg = other.cfg
+	for title, group := range other.groups {
+		existing, found := b.groups[title]
+		if !found {
+			existing = &digestGroup{title: title}
+			b.groups[title] = existing
+		}
+		existing.count += group.count
+		for _, item := range group.items {
+			if b.listed >= maxDigestItems(b.cfg) {
+				break
+			}
+			existing.items = append(existing.items, item)
+			b.listed++
+		}
+	}
+	b.total += other.total
+}
+
+func (b *digestBuffer) toPersisted() persistedDigestBuffer {
+	out := persistedDigestBuffer{
+		Cfg:       b.cfg,
+		StartedAt: b.startedAt,
+		Listed:    b.listed,
+		Total:     b.total,
+	}
+	for _, group := range b.groups {
+		out.Groups = append(out.Groups, persistedDigestGroup{
+			Title: group.title,
+			Items: group.items,
+			Count: group.count,
+		})
+	}
+	return out
+}
+
+func (p persistedDigestBuffer) toBuffer() *digestBuffer {
+	out := &digestBuffer{
+		cfg:       p.Cfg,
+		startedAt: p.StartedAt,
+		groups:    map[string]*digestGroup{},
+		listed:    p.Listed,
+		total:     p.Total,
+	}
+	for _, group := range p.Groups {
+		out.groups[group.Title] = &digestGroup{
+			title: group.Title,
+			items: group.Items,
+			count: group.Count,
+		}
+	}
+	return out
+}
+
+func (b *digestBuffer) toMessage() interactive.CoreMessage {
+	titles := make([]string, 0, len(b.groups))
+	for title := range b.groups {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+
+	var sections []api.Section
+	for _, title := range titles {
+		group := b.groups[title]
+		items := group.items
+		if skipped := group.count - len(items); skipped > 0 {
+			items = append(items, fmt.Sprintf("...and %d more", skipped))
+		}
+		sections = append(sections, api.Section{
+			Base: api.Base{
+				Header: fmt.Sprintf("%s (%d)", group.title, group.count),
+			},
+			BulletLists: api.BulletLists{
+				{Items: items},
+			},
+		})
+	}
+
+	return interactive.CoreMessage{
+		Header:      "Notification digest",
+		Description: fmt.Sprintf("%d %s received since %s.", b.total, pluralize(b.total, "notification"), b.startedAt.UTC().Format(time.RFC1123)),
+		Message: api.Message{
+			Sections: sections,
+		},
+	}
+}
+
+func digestGroupTitle(groupBy []config.DigestGroupKey, msg interactive.CoreMessage, sourceBindings []string) string {
+	if len(groupBy) == 0 {
+		groupBy = defaultDigestGroupBy
+	}
+
+	meta, _ := msg.Metadata.(interactive.EventMetadata)
+	var parts []string
+	for _, key := range groupBy {
+		var val string
+		switch key {
+		case config.SourceDigestGroupKey:
+			val = meta.SourceName
+			if val == "" {
+				val = strings.Join(sourceBindings, ", ")
+			}
+		case config.NamespaceDigestGroupKey:
+			val = meta.Namespace
+		case config.LevelDigestGroupKey:
+			val = meta.Level
+		}
+		if val == "" {
+			val = unknownDigestGroupValue
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", key, val))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// digestItemFor returns a short, single line description of a given message.
+func digestItemFor(msg interactive.CoreMessage) string {
+	var out string
+	switch {
+	case len(msg.Sections) > 0:
+		section := msg.Sections[0]
+		out = section.Header
+		var fields []string
+		for _, field := range section.TextFields {
+			if field.IsEmpty() || field.Key == "Cluster" {
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s", field.Key, field.Value))
+		}
+		if len(fields) > 0 {
+			out = fmt.Sprintf("%s (%s)", out, strings.Join(fields, ", "))
+		}
+	case msg.BaseBody.Plaintext != "":
+		out = msg.BaseBody.Plaintext
+	case msg.BaseBody.CodeBlock != "":
+		out = msg.BaseBody.CodeBlock
+	case msg.Header != "":
+		out = msg.Header
+	}
+
+	out, _, _ = strings.Cut(strings.TrimSpace(out), "\n")
+	if len(out) > digestItemMaxLength {
+		out = out[:digestItemMaxLength] + "..."
+	}
+	if out == "" {
+		out = unknownDigestGroupValue
+	}
+	return out
+}
+
+func maxDigestItems(cfg config.NotificationDigest) int {
+	if cfg.MaxItems > 0 {
+		return cfg.MaxItems
+	}
+	return defaultDigestMaxItems
+}
+
+func pluralize(count int, word string) string {
+	if count == 1 {
+		return word
+	}
+	return word + "s"
+}
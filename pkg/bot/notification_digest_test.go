@@ -0,0 +1,202 @@
+package bot
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestNotificationDigest(t *testing.T) {
+	// given
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	sender := &fakeDigestSender{}
+	digest := newNotificationDigest(loggerx.NewNoop(), sender.Send, digestStorage{})
+	digest.now = func() time.Time { return now }
+
+	cfg := config.NotificationDigest{
+		Enabled:  true,
+		Interval: time.Minute,
+		MaxItems: 2,
+	}
+
+	// when
+	buffered := digest.Buffer("disabled", config.NotificationDigest{}, fixDigestMessage("foo", "default", "error"), []string{"k8s-events"})
+	// then
+	assert.False(t, buffered)
+
+	// when
+	for _, msg := range []interactive.CoreMessage{
+		fixDigestMessage("pod-1 failed", "default", "error"),
+		fixDigestMessage("pod-2 failed", "default", "error"),
+		fixDigestMessage("pod-3 failed", "default", "error"),
+		fixDigestMessage("pod-4 created", "prod", "info"),
+	} {
+		assert.True(t, digest.Buffer("channel", cfg, msg, []string{"k8s-events"}))
+	}
+	digest.flush(context.Background(), false)
+
+	// then interval has not elapsed yet
+	assert.Empty(t, sender.Messages())
+
+	// when
+	now = now.Add(time.Minute)
+	digest.flush(context.Background(), false)
+
+	// then
+	msgs := sender.Messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "channel", msgs[0].channelID)
+	assert.Equal(t, "4 notifications received since Sun, 01 Oct 2023 12:00:00 UTC.", msgs[0].msg.Description)
+	assert.Equal(t, []api.Section{
+		{
+			Base: api.Base{Header: "source: k8s-events, namespace: default, level: error (3)"},
+			BulletLists: api.BulletLists{
+				{Items: []string{"pod-1 failed", "pod-2 failed", "...and 1 more"}},
+			},
+		},
+		{
+			Base: api.Base{Header: "source: k8s-events, namespace: prod, level: info (1)"},
+			BulletLists: api.BulletLists{
+				{Items: []string{"...and 1 more"}},
+			},
+		},
+	}, msgs[0].msg.Sections)
+
+	// when the digest is already sent, nothing is sent again
+	digest.flush(context.Background(), false)
+	assert.Len(t, sender.Messages(), 1)
+}
+
+func TestNotificationDigestFlushesOnShutdownWithoutStore(t *testing.T) {
+	// given
+	sender := &fakeDigestSender{}
+	digest := newNotificationDigest(loggerx.NewNoop(), sender.Send, digestStorage{})
+
+	cfg := config.NotificationDigest{
+		Enabled:  true,
+		Interval: time.Hour,
+		GroupBy:  []config.DigestGroupKey{config.LevelDigestGroupKey},
+	}
+	digest.Buffer("channel", cfg, fixDigestMessage("pod-1 failed", "default", "error"), []string{"k8s-events"})
+
+	// when
+	runUntilCancelled(digest)
+
+	// then
+	msgs := sender.Messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "level: error (1)", msgs[0].msg.Sections[0].Header)
+}
+
+func TestNotificationDigestPersistsOnShutdown(t *testing.T) {
+	// given
+	store := &fakeDigestStore{data: map[string][]byte{}}
+	storage := digestStorage{store: store, key: "default.socketSlack"}
+	sender := &fakeDigestSender{}
+	digest := newNotificationDigest(loggerx.NewNoop(), sender.Send, storage)
+
+	cfg := config.NotificationDigest{
+		Enabled:  true,
+		Interval: time.Hour,
+		GroupBy:  []config.DigestGroupKey{config.LevelDigestGroupKey},
+	}
+	startedAt := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	digest.now = func() time.Time { return startedAt }
+	digest.Buffer("channel", cfg, fixDigestMessage("pod-1 failed", "default", "error"), []string{"k8s-events"})
+
+	// when
+	runUntilCancelled(digest)
+
+	// then nothing is sent before the interval elapses
+	assert.Empty(t, sender.Messages())
+	assert.NotEmpty(t, store.data[storage.key])
+
+	// when
+	restarted := newNotificationDigest(loggerx.NewNoop(), sender.Send, storage)
+	restarted.now = func() time.Time { return startedAt.Add(time.Hour) }
+	restarted.restore(context.Background())
+
+	// then the persisted digests are removed, so they are not sent twice
+	assert.Empty(t, store.data[storage.key])
+
+	// when
+	restarted.flush(context.Background(), false)
+
+	// then
+	msgs := sender.Messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "channel", msgs[0].channelID)
+	assert.Equal(t, "1 notification received since Sun, 01 Oct 2023 12:00:00 UTC.", msgs[0].msg.Description)
+	assert.Equal(t, "level: error (1)", msgs[0].msg.Sections[0].Header)
+}
+
+func runUntilCancelled(digest *notificationDigest) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		digest.Run(ctx)
+		close(done)
+	}()
+	cancel()
+	<-done
+}
+
+func fixDigestMessage(text, namespace, level string) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Message: api.NewPlaintextMessage(text, false),
+		Metadata: interactive.EventMetadata{
+			SourceName: "k8s-events",
+			Namespace:  namespace,
+			Level:      level,
+		},
+	}
+}
+
+type sentDigest struct {
+	channelID string
+	msg       interactive.CoreMessage
+}
+
+type fakeDigestSender struct {
+	mu   sync.Mutex
+	sent []sentDigest
+}
+
+func (f *fakeDigestSender) Send(_ context.Context, channelID string, msg interactive.CoreMessage) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, sentDigest{channelID: channelID, msg: msg})
+	return nil
+}
+
+func (f *fakeDigestSender) Messages() []sentDigest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sent
+}
+
+type fakeDigestStore struct {
+	data map[string][]byte
+}
+
+func (f *fakeDigestStore) Load(_ context.Context, key string) ([]byte, error) {
+	return f.data[key], nil
+}
+
+func (f *fakeDigestStore) Save(_ context.Context, key string, data []byte) error {
+	if len(data) == 0 {
+		delete(f.data, key)
+		return nil
+	}
+	f.data[key] = data
+	return nil
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -54,7 +55,9 @@ type CloudSlack struct {
 	executorFactory   ExecutorFactory
 	reporter          AnalyticsCommandReporter
 	commGroupMetadata CommGroupMetadata
+	usersMu           sync.RWMutex
 	realNamesForID    map[string]string
+	emailsForID       map[string]string
 	botMentionRegex   *regexp.Regexp
 	botID             string
 	channelsMutex     sync.RWMutex
@@ -63,6 +66,9 @@ type CloudSlack struct {
 	notifyMutex       sync.Mutex
 	clusterName       string
 	msgStatusTracker  *SlackMessageStatusTracker
+	digest            *notificationDigest
+	threads           *messageThreads
+	updates           *inPlaceMessages
 	status            health.PlatformStatusMsg
 	failuresNo        int
 	failureReason     health.FailureReasonMsg
@@ -92,7 +98,7 @@ func NewCloudSlack(log logrus.FieldLogger,
 		return nil, fmt.Errorf("while producing channels configuration map by ID: %w", err)
 	}
 
-	return &CloudSlack{
+	b := &CloudSlack{
 		log:               log,
 		cfg:               cfg,
 		executorFactory:   executorFactory,
@@ -105,14 +111,22 @@ func NewCloudSlack(log logrus.FieldLogger,
 		botID:             cfg.BotID,
 		clusterName:       clusterName,
 		realNamesForID:    map[string]string{},
+		emailsForID:       map[string]string{},
 		msgStatusTracker:  NewSlackMessageStatusTracker(log, client),
+		threads:           newMessageThreads(),
+		updates:           newInPlaceMessages(),
 		status:            health.StatusUnknown,
 		failuresNo:        0,
 		failureReason:     "",
-	}, nil
+	}
+	b.digest = newNotificationDigest(log, b.sendToChannel, digestStorageFor(commGroupMetadata, config.CloudSlackCommPlatformIntegration))
+
+	return b, nil
 }
 
 func (b *CloudSlack) Start(ctx context.Context) error {
+	go b.digest.Run(ctx)
+
 	if b.cfg.ExecutionEventStreamingDisabled {
 		b.setFailureReason(health.FailureReasonQuotaExceeded)
 		b.log.Warn(quotaExceededMsg)
@@ -399,14 +413,25 @@ func (b *CloudSlack) handleStreamMessage(ctx context.Context, data *pb.ConnectRe
 }
 
 func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *CloudSlack) SendGatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
-		msgMetadata := slackMessage{
-			Channel:         channelName,
-			ThreadTimeStamp: "",
-			BlockID:         uuid.New().String(),
+		channel := b.getChannels()[channelName]
+		if !matchesChannelRoutes(b.log, channel.Routes, msg, sourceBindings) {
+			continue
 		}
-		err := b.send(ctx, msgMetadata, msg)
+		if !gate.Allows(channelName) {
+			continue
+		}
+		if b.digest.Buffer(channelName, channel.Notification.Digest, msg, sourceBindings) {
+			continue
+		}
+
+		err := b.sendToChannel(ctx, channelName, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
@@ -416,6 +441,33 @@ func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessag
 	return errs.ErrorOrNil()
 }
 
+// SendMessageToChannels sends message to given Slack channels.
+func (b *CloudSlack) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, channels []string) error {
+	errs := multierror.New()
+	for channelName, channel := range b.getChannels() {
+		if !isTargetChannel(channels, channel.alias, channelName) {
+			continue
+		}
+
+		err := b.sendToChannel(ctx, channelName, msg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
+			continue
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (b *CloudSlack) sendToChannel(ctx context.Context, channelName string, msg interactive.CoreMessage) error {
+	msgMetadata := slackMessage{
+		Channel:         channelName,
+		ThreadTimeStamp: "",
+		BlockID:         uuid.New().String(),
+	}
+	return b.send(ctx, msgMetadata, msg)
+}
+
 func (b *CloudSlack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
 	errs := multierror.New()
 	for _, channel := range b.getChannels() {
@@ -443,7 +495,9 @@ func (b *CloudSlack) IntegrationName() config.CommPlatformIntegration {
 }
 
 func (b *CloudSlack) getRealNameWithFallbackToUserID(ctx context.Context, userID string) string {
+	b.usersMu.RLock()
 	realName, exists := b.realNamesForID[userID]
+	b.usersMu.RUnlock()
 	if exists {
 		return realName
 	}
@@ -454,7 +508,16 @@ func (b *CloudSlack) getRealNameWithFallbackToUserID(ctx context.Context, userID
 		return userID
 	}
 
-	if user == nil || user.RealName == "" {
+	if user == nil {
+		return userID
+	}
+	b.usersMu.Lock()
+	defer b.usersMu.Unlock()
+
+	// email is available only if the app has the `users:read.email` scope
+	b.emailsForID[userID] = user.Profile.Email
+
+	if user.RealName == "" {
 		return userID
 	}
 
@@ -462,6 +525,13 @@ func (b *CloudSlack) getRealNameWithFallbackToUserID(ctx context.Context, userID
 	return user.RealName
 }
 
+// getUserEmail returns the user email cached while getting the user real name.
+func (b *CloudSlack) getUserEmail(userID string) string {
+	b.usersMu.RLock()
+	defer b.usersMu.RUnlock()
+	return b.emailsForID[userID]
+}
+
 func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) error {
 	// Handle message only if starts with mention
 	request, found := b.findAndTrimBotMention(event.Text)
@@ -499,11 +569,14 @@ func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) erro
 			IsKnown:          exists,
 			CommandOrigin:    event.CommandOrigin,
 			SlackState:       event.State,
+			Approvers:        channel.Approvers,
 		},
 		Message: request,
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", event.UserID),
 			DisplayName: event.UserName,
+			ID:          event.UserID,
+			Email:       b.getUserEmail(event.UserID),
 		},
 	})
 
@@ -525,6 +598,17 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName(), api.BotNameWithClusterName(b.clusterName))
+
+	resp, prevRef, found := b.updates.Prepare(resp, event.Channel)
+	if found {
+		err := updateSlackMessage(ctx, b.client, b.renderer, prevRef, resp)
+		if err == nil {
+			b.log.Debugf("Message successfully updated in channel %q", event.Channel)
+			return nil
+		}
+		b.log.Errorf("while updating message in place, sending a new one: %s", err.Error())
+	}
+
 	markdown := b.renderer.MessageToMarkdown(resp)
 
 	if len(markdown) == 0 {
@@ -563,6 +647,13 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 		b.renderer.RenderInteractiveMessage(resp),
 	}
 
+	if ts, found := b.threads.ParentRef(resp, event.Channel); found && resp.Type == api.ThreadMessage && event.ThreadTimeStamp == "" {
+		event.ThreadTimeStamp = ts
+	}
+	incidentParent, isFollowUp := b.threads.IncidentParent(resp, event.Channel)
+	if isFollowUp && event.ThreadTimeStamp == "" {
+		event.ThreadTimeStamp = incidentParent.Ref
+	}
 	if ts := b.getThreadOptionIfNeeded(event, file); ts != nil {
 		options = append(options, ts)
 	}
@@ -576,9 +667,18 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 			return fmt.Errorf("while posting Slack message visible only to user: %w", err)
 		}
 	} else {
-		if _, _, err := b.client.PostMessageContext(ctx, event.Channel, options...); err != nil {
+		channelID, ts, err := b.client.PostMessageContext(ctx, event.Channel, options...)
+		if err != nil {
 			return fmt.Errorf("while posting Slack message: %w", err)
 		}
+		b.threads.Store(resp, event.Channel, ts)
+		b.updates.Store(resp, event.Channel, sentMessageRef{ChannelID: channelID, MessageID: ts})
+
+		if incident, resolved := resolvedIncident(resp); resolved && isFollowUp {
+			if err := updateSlackIncidentStatus(ctx, b.client, b.renderer, channelID, incidentParent, incident); err != nil {
+				return err
+			}
+		}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", event.Channel)
@@ -18,6 +18,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -52,6 +53,7 @@ type Slack struct {
 	botMentionRegex     *regexp.Regexp
 	commGroupMetadata   CommGroupMetadata
 	renderer            *SlackRenderer
+	digest              *notificationDigest
 	messages            chan slackLegacyMessage
 	slackMessageWorkers *pool.Pool
 	shutdownOnce        sync.Once
@@ -87,7 +89,7 @@ func NewSlack(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata, cfg c
 		return nil, fmt.Errorf("while producing channels configuration map by ID: %w", err)
 	}
 
-	return &Slack{
+	b := &Slack{
 		log:                 log,
 		executorFactory:     executorFactory,
 		reporter:            reporter,
@@ -101,7 +103,10 @@ func NewSlack(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata, cfg c
 		slackMessageWorkers: pool.New().WithMaxGoroutines(platformMessageWorkersCount),
 		status:              health.StatusUnknown,
 		failureReason:       "",
-	}, nil
+	}
+	b.digest = newNotificationDigest(log, b.sendToChannel, digestStorageFor(commGroupMetadata, config.SlackCommPlatformIntegration))
+
+	return b, nil
 }
 
 func (b *Slack) startMessageProcessor(ctx context.Context) {
@@ -122,6 +127,8 @@ func (b *Slack) startMessageProcessor(ctx context.Context) {
 func (b *Slack) Start(ctx context.Context) error {
 	b.log.Info("Starting bot")
 
+	go b.digest.Run(ctx)
+
 	rtm := b.client.NewRTM()
 	go func() {
 		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
@@ -269,11 +276,13 @@ func (b *Slack) handleMessage(ctx context.Context, msg slackLegacyMessage) error
 			ExecutorBindings: channel.Bindings.Executors,
 			IsKnown:          exists,
 			CommandOrigin:    command.TypedOrigin,
+			Approvers:        channel.Approvers,
 		},
 		Message: request,
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", msg.User),
 			DisplayName: msg.User, // this integration is officially not supported, so no need to ensure it has a nice display name
+			ID:          msg.User,
 		},
 	})
 	response := e.Execute(ctx)
@@ -344,13 +353,25 @@ func (b *Slack) getChannelsToNotify(sourceBindings []string) []string {
 
 // SendMessage sends message to selected Slack channels.
 func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *Slack) SendGatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
-		msgMetadata := slackLegacyMessage{
-			Channel:         channelName,
-			ThreadTimeStamp: "",
+		channel := b.getChannels()[channelName]
+		if !matchesChannelRoutes(b.log, channel.Routes, msg, sourceBindings) {
+			continue
 		}
-		err := b.send(ctx, msgMetadata, msg, false)
+		if !gate.Allows(channelName) {
+			continue
+		}
+		if b.digest.Buffer(channelName, channel.Notification.Digest, msg, sourceBindings) {
+			continue
+		}
+
+		err := b.sendToChannel(ctx, channelName, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
@@ -360,6 +381,32 @@ func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, so
 	return errs.ErrorOrNil()
 }
 
+// SendMessageToChannels sends message to given Slack channels.
+func (b *Slack) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, channels []string) error {
+	errs := multierror.New()
+	for channelName, channel := range b.getChannels() {
+		if !isTargetChannel(channels, channel.alias, channelName) {
+			continue
+		}
+
+		err := b.sendToChannel(ctx, channelName, msg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
+			continue
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (b *Slack) sendToChannel(ctx context.Context, channelName string, msg interactive.CoreMessage) error {
+	msgMetadata := slackLegacyMessage{
+		Channel:         channelName,
+		ThreadTimeStamp: "",
+	}
+	return b.send(ctx, msgMetadata, msg, false)
+}
+
 // SendMessageToAll sends message to all Slack channels.
 func (b *Slack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
 	errs := multierror.New()
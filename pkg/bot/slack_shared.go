@@ -1,12 +1,15 @@
 package bot
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
 
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	conversationx "github.com/kubeshop/botkube/pkg/conversation"
 	"github.com/kubeshop/botkube/pkg/execute/command"
@@ -54,6 +57,25 @@ func slackError(err error, channel string) error {
 	return err
 }
 
+// updateSlackIncidentStatus updates the first incident notification with the resolved status header.
+// Slack requires the channel ID to update a message, so it must be resolved by the caller.
+func updateSlackIncidentStatus(ctx context.Context, client *slack.Client, renderer *SlackRenderer, channelID string, parent incidentThread, incident *interactive.Incident) error {
+	msg := withResolvedStatus(parent.Message, incident, time.Now())
+	if err := updateSlackMessage(ctx, client, renderer, sentMessageRef{ChannelID: channelID, MessageID: parent.Ref}, msg); err != nil {
+		return fmt.Errorf("while updating incident status: %w", err)
+	}
+	return nil
+}
+
+// updateSlackMessage replaces the content of a given message.
+func updateSlackMessage(ctx context.Context, client *slack.Client, renderer *SlackRenderer, ref sentMessageRef, msg interactive.CoreMessage) error {
+	_, _, _, err := client.UpdateMessageContext(ctx, ref.ChannelID, ref.MessageID, renderer.RenderInteractiveMessage(msg))
+	if err != nil {
+		return fmt.Errorf("while updating Slack message: %w", slackError(err, ref.ChannelID))
+	}
+	return nil
+}
+
 // slackMessage contains message details to execute command and send back the result
 type slackMessage struct {
 	Text                 string
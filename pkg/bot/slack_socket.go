@@ -24,6 +24,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -46,8 +47,13 @@ type SocketSlack struct {
 	botMentionRegex   *regexp.Regexp
 	commGroupMetadata CommGroupMetadata
 	renderer          *SlackRenderer
+	usersMu           sync.RWMutex
 	realNamesForID    map[string]string
+	emailsForID       map[string]string
 	msgStatusTracker  *SlackMessageStatusTracker
+	digest            *notificationDigest
+	threads           *messageThreads
+	updates           *inPlaceMessages
 	messages          chan slackMessage
 	messageWorkers    *pool.Pool
 	shutdownOnce      sync.Once
@@ -81,7 +87,7 @@ func NewSocketSlack(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata,
 		return nil, fmt.Errorf("while producing channels configuration map by ID: %w", err)
 	}
 
-	return &SocketSlack{
+	b := &SocketSlack{
 		log:               log,
 		executorFactory:   executorFactory,
 		reporter:          reporter,
@@ -92,18 +98,26 @@ func NewSocketSlack(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata,
 		renderer:          NewSlackRenderer(),
 		botMentionRegex:   botMentionRegex,
 		realNamesForID:    map[string]string{},
+		emailsForID:       map[string]string{},
 		msgStatusTracker:  NewSlackMessageStatusTracker(log, client),
 		messages:          make(chan slackMessage, platformMessageChannelSize),
 		messageWorkers:    pool.New().WithMaxGoroutines(platformMessageWorkersCount),
+		threads:           newMessageThreads(),
+		updates:           newInPlaceMessages(),
 		status:            health.StatusUnknown,
 		failureReason:     "",
-	}, nil
+	}
+	b.digest = newNotificationDigest(log, b.sendToChannel, digestStorageFor(commGroupMetadata, config.SocketSlackCommPlatformIntegration))
+
+	return b, nil
 }
 
 // Start starts the Slack WebSocket connection and listens for messages
 func (b *SocketSlack) Start(ctx context.Context) error {
 	b.log.Info("Starting bot")
 
+	go b.digest.Run(ctx)
+
 	websocketClient := socketmode.New(b.client)
 
 	go func() {
@@ -442,11 +456,14 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event slackMessage) err
 			SlackState:       event.State,
 			URL:              permalink,
 			Text:             event.Text,
+			Approvers:        channel.Approvers,
 		},
 		Message: request,
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", event.UserID),
 			DisplayName: event.UserName,
+			ID:          event.UserID,
+			Email:       b.getUserEmail(event.UserID),
 		},
 	})
 
@@ -491,6 +508,17 @@ func (b *SocketSlack) hasMatchingTextMessageTrigger(channel channelConfigByName,
 func (b *SocketSlack) send(ctx context.Context, event slackMessage, in interactive.CoreMessage) error {
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, in)
 
+	in, prevRef, found := b.updates.Prepare(in, event.Channel)
+	if found {
+		in.ReplaceBotNamePlaceholder(b.BotName())
+		err := updateSlackMessage(ctx, b.client, b.renderer, prevRef, in)
+		if err == nil {
+			b.log.Debugf("Message successfully updated in channel %q", event.Channel)
+			return nil
+		}
+		b.log.Errorf("while updating message in place, sending a new one: %s", err.Error())
+	}
+
 	var msgs []api.Message
 	if !in.Message.IsEmpty() {
 		msgs = append(msgs, in.Message)
@@ -498,6 +526,16 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, in interacti
 
 	msgs = append(msgs, in.Messages...)
 
+	if ts, found := b.threads.ParentRef(in, event.Channel); found {
+		event.RootMessageTimeStamp = ts
+	}
+	incidentParent, isFollowUp := b.threads.IncidentParent(in, event.Channel)
+	if isFollowUp && event.ThreadTimeStamp == "" {
+		event.ThreadTimeStamp = incidentParent.Ref
+	}
+
+	var postedChannelID string
+
 	for idx := range msgs {
 		if msgs[idx].IsEmpty() {
 			continue
@@ -568,15 +606,22 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, in interacti
 			if resp.Message.UserHandle != "" {
 				id = resp.Message.UserHandle
 			}
-			_, _, err = b.client.PostMessageContext(ctx, id, options...)
+			channelID, ts, err := b.client.PostMessageContext(ctx, id, options...)
 			if err != nil {
 				return fmt.Errorf("while posting Slack message: %w", slackError(err, event.Channel))
 			}
+			b.threads.Store(in, event.Channel, ts)
+			b.updates.Store(in, event.Channel, sentMessageRef{ChannelID: channelID, MessageID: ts})
+			postedChannelID = channelID
 		}
 
 		b.log.Debugf("Message successfully sent to channel %q", event.Channel)
 	}
 
+	if incident, resolved := resolvedIncident(in); resolved && isFollowUp && postedChannelID != "" {
+		return updateSlackIncidentStatus(ctx, b.client, b.renderer, postedChannelID, incidentParent, incident)
+	}
+
 	return nil
 }
 
@@ -599,14 +644,25 @@ func (b *SocketSlack) getChannelsToNotify(sourceBindings []string) []string {
 
 // SendMessage sends message with interactive sections to selected Slack channels.
 func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *SocketSlack) SendGatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
-		msgMetadata := slackMessage{
-			Channel:         channelName,
-			ThreadTimeStamp: "",
-			BlockID:         uuid.New().String(),
+		channel := b.getChannels()[channelName]
+		if !matchesChannelRoutes(b.log, channel.Routes, msg, sourceBindings) {
+			continue
 		}
-		err := b.send(ctx, msgMetadata, msg)
+		if !gate.Allows(channelName) {
+			continue
+		}
+		if b.digest.Buffer(channelName, channel.Notification.Digest, msg, sourceBindings) {
+			continue
+		}
+
+		err := b.sendToChannel(ctx, channelName, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
@@ -616,6 +672,33 @@ func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessa
 	return errs.ErrorOrNil()
 }
 
+// SendMessageToChannels sends message to given Slack channels.
+func (b *SocketSlack) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, channels []string) error {
+	errs := multierror.New()
+	for channelName, channel := range b.getChannels() {
+		if !isTargetChannel(channels, channel.alias, channelName) {
+			continue
+		}
+
+		err := b.sendToChannel(ctx, channelName, msg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
+			continue
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (b *SocketSlack) sendToChannel(ctx context.Context, channelName string, msg interactive.CoreMessage) error {
+	msgMetadata := slackMessage{
+		Channel:         channelName,
+		ThreadTimeStamp: "",
+		BlockID:         uuid.New().String(),
+	}
+	return b.send(ctx, msgMetadata, msg)
+}
+
 // SendMessageToAll sends message with interactive sections to all Slack channels.
 func (b *SocketSlack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
 	errs := multierror.New()
@@ -710,7 +793,9 @@ func (b *SocketSlack) getThreadOptionIfNeeded(event slackMessage, file *slack.Fi
 }
 
 func (b *SocketSlack) getRealNameWithFallbackToUserID(ctx context.Context, userID string) string {
+	b.usersMu.RLock()
 	realName, exists := b.realNamesForID[userID]
+	b.usersMu.RUnlock()
 	if exists {
 		return realName
 	}
@@ -721,7 +806,16 @@ func (b *SocketSlack) getRealNameWithFallbackToUserID(ctx context.Context, userI
 		return userID
 	}
 
-	if user == nil || user.RealName == "" {
+	if user == nil {
+		return userID
+	}
+	b.usersMu.Lock()
+	defer b.usersMu.Unlock()
+
+	// email is available only if the app has the `users:read.email` scope
+	b.emailsForID[userID] = user.Profile.Email
+
+	if user.RealName == "" {
 		return userID
 	}
 
@@ -729,6 +823,13 @@ func (b *SocketSlack) getRealNameWithFallbackToUserID(ctx context.Context, userI
 	return user.RealName
 }
 
+// getUserEmail returns the user email cached while getting the user real name.
+func (b *SocketSlack) getUserEmail(userID string) string {
+	b.usersMu.RLock()
+	defer b.usersMu.RUnlock()
+	return b.emailsForID[userID]
+}
+
 func (b *SocketSlack) setFailureReason(reason health.FailureReasonMsg) {
 	if reason == "" {
 		b.status = health.StatusHealthy
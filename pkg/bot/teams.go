@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"regexp"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/infracloudio/msbotbuilder-go/core"
@@ -24,6 +25,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -84,6 +86,8 @@ type Teams struct {
 	renderer      *TeamsRenderer
 	status        health.PlatformStatusMsg
 	failureReason health.FailureReasonMsg
+	connector     teamsActivitySender
+	threads       *messageThreads
 }
 
 type consentContext struct {
@@ -120,6 +124,8 @@ func NewTeams(log logrus.FieldLogger, commGroupMetadata CommGroupMetadata, cfg c
 		Port:              port,
 		renderer:          NewTeamsRenderer(),
 		conversations:     make(map[string]conversation),
+		connector:         newTeamsConnector(cfg.AppID, cfg.AppPassword),
+		threads:           newMessageThreads(),
 		botMentionRegex:   botMentionRegex,
 		status:            health.StatusUnknown,
 		failureReason:     "",
@@ -302,6 +308,7 @@ func (b *Teams) processMessage(ctx context.Context, activity schema.Activity) (i
 		User: execute.UserInput{
 			//Mention:     "", // not used currently
 			DisplayName: activity.From.Name,
+			ID:          activity.From.AadObjectID,
 		},
 		Message: trimmedMsg,
 	})
@@ -353,6 +360,11 @@ func (b *Teams) putRequest(u string, data []byte) (err error) {
 
 // SendMessage sends message to MS Teams to selected conversations.
 func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *Teams) SendGatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
 	msg.ReplaceBotNamePlaceholder(b.BotName())
 	errs := multierror.New()
 
@@ -363,8 +375,77 @@ func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, so
 
 	for _, ref := range b.getConversationRefsToNotify(sourceBindings) {
 		channelID := ref.ChannelID
+		if !gate.Allows(channelID) {
+			continue
+		}
+		b.log.Debugf("Sending message to channel %q", channelID)
+		err := b.sendToConversation(ctx, ref, msg, activityMsg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Teams message to channel %q: %w", channelID, err))
+			continue
+		}
+		b.log.Debugf("Message successfully sent to channel %q", channelID)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sendToConversation sends a given message to a given conversation. Action results and follow-up incident events
+// are sent to the reply chain of the event notification.
+func (b *Teams) sendToConversation(ctx context.Context, ref schema.ConversationReference, msg interactive.CoreMessage, activityMsg coreActivity.MsgOption) error {
+	channelID := ref.ChannelID
+
+	var replyToID string
+	if parentID, found := b.threads.ParentRef(msg, channelID); found && msg.Type == api.ThreadMessage {
+		replyToID = parentID
+	}
+	incidentParent, isFollowUp := b.threads.IncidentParent(msg, channelID)
+	if isFollowUp {
+		replyToID = incidentParent.Ref
+	}
+
+	activityID, err := b.connector.SendActivity(ctx, ref, replyToID, activityMsg)
+	if err != nil {
+		return err
+	}
+	b.threads.Store(msg, channelID, activityID)
+
+	if incident, resolved := resolvedIncident(msg); resolved && isFollowUp {
+		return b.updateIncidentStatus(ctx, ref, incidentParent, incident)
+	}
+	return nil
+}
+
+// updateIncidentStatus updates the first incident notification with the resolved status header.
+func (b *Teams) updateIncidentStatus(ctx context.Context, ref schema.ConversationReference, parent incidentThread, incident *interactive.Incident) error {
+	activityMsg, err := b.renderMessage(withResolvedStatus(parent.Message, incident, time.Now()))
+	if err != nil {
+		return fmt.Errorf("while rendering incident status: %w", err)
+	}
+
+	if err := b.connector.UpdateActivity(ctx, ref, parent.Ref, activityMsg); err != nil {
+		return fmt.Errorf("while updating incident status: %w", err)
+	}
+	return nil
+}
+
+// SendMessageToChannels sends message to MS Teams to given conversations. Conversations are referenced by their channel IDs.
+func (b *Teams) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, channels []string) error {
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	errs := multierror.New()
+
+	activityMsg, err := b.renderMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	for channelID, convCfg := range b.getConversations() {
+		if !isTargetChannel(channels, channelID) {
+			continue
+		}
+
 		b.log.Debugf("Sending message to channel %q", channelID)
-		err := b.Adapter.ProactiveMessage(ctx, ref, coreActivity.HandlerFuncs{
+		err := b.Adapter.ProactiveMessage(ctx, convCfg.ref, coreActivity.HandlerFuncs{
 			OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
 				return turn.SendActivity(activityMsg)
 			},
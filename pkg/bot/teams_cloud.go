@@ -25,6 +25,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -78,6 +79,7 @@ type CloudTeams struct {
 	agentActivityMessage chan *pb.AgentActivity
 	channelsMutex        sync.RWMutex
 	channels             map[string]teamsCloudChannelConfigByID
+	digest               *notificationDigest
 }
 
 // NewCloudTeams returns a new CloudTeams instance.
@@ -92,7 +94,7 @@ func NewCloudTeams(
 	if err != nil {
 		return nil, err
 	}
-	return &CloudTeams{
+	b := &CloudTeams{
 		log:                  log,
 		executorFactory:      executorFactory,
 		reporter:             reporter,
@@ -104,11 +106,16 @@ func NewCloudTeams(
 		botMentionRegex:      botMentionRegex,
 		status:               health.StatusUnknown,
 		agentActivityMessage: make(chan *pb.AgentActivity, platformMessageChannelSize),
-	}, nil
+	}
+	b.digest = newNotificationDigest(log, b.sendToChannel, digestStorageFor(commGroupMetadata, config.CloudTeamsCommPlatformIntegration))
+
+	return b, nil
 }
 
 // Start MS Teams server to serve messages from Teams client
 func (b *CloudTeams) Start(ctx context.Context) error {
+	go b.digest.Run(ctx)
+
 	return b.withRetries(ctx, b.log, maxRetries, func() error {
 		return b.start(ctx)
 	})
@@ -121,7 +128,48 @@ func (b *CloudTeams) SendMessageToAll(ctx context.Context, msg interactive.CoreM
 
 // SendMessage sends the message to MS CloudTeams to selected conversations.
 func (b *CloudTeams) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
-	return b.sendAgentActivity(ctx, msg, b.getChannelsToNotify(sourceBindings))
+	return b.SendGatedMessage(ctx, msg, sourceBindings, nil)
+}
+
+// SendGatedMessage sends message to channels bound to given source bindings and allowed by a given gate.
+func (b *CloudTeams) SendGatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, gate notifier.ChannelGate) error {
+	var channels []teamsCloudChannelConfigByID
+	for _, channel := range b.getChannelsToNotify(sourceBindings) {
+		if !matchesChannelRoutes(b.log, channel.Routes, msg, sourceBindings) {
+			continue
+		}
+		if !gate.Allows(channel.Identifier()) {
+			continue
+		}
+		if b.digest.Buffer(channel.Identifier(), channel.Notification.Digest, msg, sourceBindings) {
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return b.sendAgentActivity(ctx, msg, channels)
+}
+
+// SendMessageToChannels sends the message to given MS CloudTeams conversations.
+func (b *CloudTeams) SendMessageToChannels(ctx context.Context, msg interactive.CoreMessage, channels []string) error {
+	var out []teamsCloudChannelConfigByID
+	for channelID, channel := range b.getChannels() {
+		if !isTargetChannel(channels, channel.alias, channelID) {
+			continue
+		}
+		out = append(out, channel)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return b.sendAgentActivity(ctx, msg, out)
+}
+
+func (b *CloudTeams) sendToChannel(ctx context.Context, channelID string, msg interactive.CoreMessage) error {
+	channel, exists := b.getChannels()[channelID]
+	if !exists {
+		return fmt.Errorf("channel %q not found", channelID)
+	}
+	return b.sendAgentActivity(ctx, msg, []teamsCloudChannelConfigByID{channel})
 }
 
 // IntegrationName describes the integration name.
@@ -301,12 +349,14 @@ func (b *CloudTeams) processMessage(ctx context.Context, act schema.Activity, ch
 			ExecutorBindings: channel.Bindings.Executors,
 			SourceBindings:   channel.Bindings.Sources,
 			CommandOrigin:    command.TypedOrigin,
+			Approvers:        channel.Approvers,
 		},
 		Message: trimmedMsg,
 		User: execute.UserInput{
 			// TODO: we need to add support for mentions on cloud side.
 			//Mention:     "",
 			DisplayName: act.From.Name,
+			ID:          act.From.AadObjectID,
 		},
 	})
 	return e.Execute(ctx)
@@ -0,0 +1,130 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/infracloudio/msbotbuilder-go/connector/auth"
+	coreActivity "github.com/infracloudio/msbotbuilder-go/core/activity"
+	"github.com/infracloudio/msbotbuilder-go/schema"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+// teamsActivitySender sends activities to MS Teams conversations.
+type teamsActivitySender interface {
+	SendActivity(ctx context.Context, ref schema.ConversationReference, replyToID string, msg coreActivity.MsgOption) (string, error)
+	UpdateActivity(ctx context.Context, ref schema.ConversationReference, activityID string, msg coreActivity.MsgOption) error
+}
+
+// teamsConnector sends activities to the Bot Framework connector service.
+// Contrary to the msbotbuilder-go adapter, it returns IDs of sent activities, so next messages can be sent as their replies.
+type teamsConnector struct {
+	httpCli *http.Client
+}
+
+func newTeamsConnector(appID, appPassword string) *teamsConnector {
+	cfg := clientcredentials.Config{
+		ClientID:     appID,
+		ClientSecret: appPassword,
+		TokenURL:     auth.ToChannelFromBotLoginURL[0],
+		Scopes:       []string{auth.ToChannelFromBotOauthScope},
+	}
+	return &teamsConnector{
+		httpCli: cfg.Client(context.Background()),
+	}
+}
+
+// SendActivity sends a message to a given conversation and returns the ID of the created activity.
+// If replyToID is specified, the message is sent to the reply chain of a given activity.
+func (c *teamsConnector) SendActivity(ctx context.Context, ref schema.ConversationReference, replyToID string, msg coreActivity.MsgOption) (string, error) {
+	act, err := teamsOutgoingActivity(ref, msg)
+	if err != nil {
+		return "", err
+	}
+
+	conversationID := ref.Conversation.ID
+	if replyToID != "" {
+		// messages sent to the conversation with the root message ID are added to its reply chain
+		conversationID = fmt.Sprintf("%s;messageid=%s", conversationID, replyToID)
+		act.Conversation.ID = conversationID
+		act.ReplyToID = replyToID
+	}
+
+	var out schema.ResourceResponse
+	err = c.do(ctx, http.MethodPost, ref.ServiceURL, []string{"v3", "conversations", conversationID, "activities"}, act, &out)
+	if err != nil {
+		return "", err
+	}
+	return out.ID, nil
+}
+
+// UpdateActivity replaces the content of a given activity.
+func (c *teamsConnector) UpdateActivity(ctx context.Context, ref schema.ConversationReference, activityID string, msg coreActivity.MsgOption) error {
+	act, err := teamsOutgoingActivity(ref, msg)
+	if err != nil {
+		return err
+	}
+	act.ID = activityID
+
+	return c.do(ctx, http.MethodPut, ref.ServiceURL, []string{"v3", "conversations", ref.Conversation.ID, "activities", activityID}, act, nil)
+}
+
+func (c *teamsConnector) do(ctx context.Context, method, serviceURL string, path []string, act schema.Activity, out any) (err error) {
+	body, err := json.Marshal(act)
+	if err != nil {
+		return fmt.Errorf("while marshaling activity: %w", err)
+	}
+
+	u, err := url.Parse(serviceURL)
+	if err != nil {
+		return fmt.Errorf("while parsing service URL: %w", err)
+	}
+	u = u.JoinPath(path...)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpCli.Do(req)
+	if err != nil {
+		return fmt.Errorf("while sending request: %w", err)
+	}
+	defer func() {
+		deferredErr := resp.Body.Close()
+		if deferredErr != nil {
+			err = multierror.Append(err, deferredErr)
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		raw, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("got unexpected status code %d: %s", resp.StatusCode, raw)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("while decoding response: %w", err)
+	}
+	return nil
+}
+
+// teamsOutgoingActivity returns the bot message activity for a given conversation reference.
+func teamsOutgoingActivity(ref schema.ConversationReference, msg coreActivity.MsgOption) (schema.Activity, error) {
+	// the reference is stored from the user message, so its activity ID is cleared to not send the message as a reply
+	ref.ActivityID = ""
+	act := coreActivity.ApplyConversationReference(schema.Activity{Type: schema.Message}, ref, false)
+	if err := msg(&act); err != nil {
+		return schema.Activity{}, fmt.Errorf("while rendering activity: %w", err)
+	}
+	return act, nil
+}
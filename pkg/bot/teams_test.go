@@ -1,10 +1,22 @@
 package bot
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	coreActivity "github.com/infracloudio/msbotbuilder-go/core/activity"
+	"github.com/infracloudio/msbotbuilder-go/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
 )
 
 func TestTeams_TrimBotMention(t *testing.T) {
@@ -47,3 +59,110 @@ func TestTeams_TrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamsIncidentReplyChain(t *testing.T) {
+	// given
+	connector := &fakeTeamsActivitySender{}
+	ref := schema.ConversationReference{
+		ChannelID:    "19:alerts@thread.skype",
+		Conversation: schema.ConversationAccount{ID: "19:alerts@thread.skype"},
+		ServiceURL:   "https://smba.trafficmanager.net/emea/",
+	}
+	b := &Teams{
+		log:           loggerx.NewNoop(),
+		botName:       "Botkube",
+		bindings:      config.BotBindings{Sources: []string{"k8s-events"}},
+		renderer:      NewTeamsRenderer(),
+		conversations: map[string]conversation{ref.ChannelID: {ref: ref, notify: true}},
+		connector:     connector,
+		threads:       newMessageThreads(),
+	}
+
+	incidentMsg := func(correlationID string, incident interactive.Incident) interactive.CoreMessage {
+		return interactive.CoreMessage{
+			Message: api.NewPlaintextMessage("pod crashed", false),
+			Metadata: interactive.EventMetadata{
+				CorrelationID: correlationID,
+				Incident:      &incident,
+			},
+		}
+	}
+	actionMsg := interactive.CoreMessage{
+		Message: api.Message{
+			Type:     api.ThreadMessage,
+			BaseBody: api.Body{Plaintext: "logs"},
+		},
+		Metadata: interactive.ActionMetadata{ActionName: "logs", EventCorrelationID: "event-1"},
+	}
+
+	// when
+	for _, msg := range []interactive.CoreMessage{
+		incidentMsg("event-1", interactive.Incident{ID: "incident-1"}),
+		actionMsg,
+		incidentMsg("event-2", interactive.Incident{ID: "incident-1", FollowUp: true}),
+		incidentMsg("event-3", interactive.Incident{ID: "incident-1", FollowUp: true, Resolved: true, EventCount: 3}),
+	} {
+		require.NoError(t, b.SendMessage(context.Background(), msg, []string{"k8s-events"}))
+	}
+
+	// then
+	assert.Equal(t, []string{"", "activity-1", "activity-1", "activity-1"}, connector.replyToIDs)
+	assert.Equal(t, []string{"activity-1"}, connector.updatedIDs)
+}
+
+func TestTeamsConnectorSendActivity(t *testing.T) {
+	// given
+	var gotPaths []string
+	var gotActivities []schema.Activity
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.EscapedPath())
+		var act schema.Activity
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&act))
+		gotActivities = append(gotActivities, act)
+		_, _ = w.Write([]byte(`{"id": "1680000000000"}`))
+	}))
+	defer srv.Close()
+
+	connector := &teamsConnector{httpCli: srv.Client()}
+	ref := schema.ConversationReference{
+		ActivityID:   "user-message",
+		Bot:          schema.ChannelAccount{ID: "bot"},
+		User:         schema.ChannelAccount{ID: "user"},
+		Conversation: schema.ConversationAccount{ID: "19:alerts@thread.skype"},
+		ServiceURL:   srv.URL,
+	}
+
+	// when
+	rootID, err := connector.SendActivity(context.Background(), ref, "", coreActivity.MsgOptionText("event"))
+	require.NoError(t, err)
+	_, err = connector.SendActivity(context.Background(), ref, rootID, coreActivity.MsgOptionText("follow-up"))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "1680000000000", rootID)
+	assert.Equal(t, []string{
+		"/v3/conversations/19:alerts@thread.skype/activities",
+		"/v3/conversations/19:alerts@thread.skype;messageid=1680000000000/activities",
+	}, gotPaths)
+
+	require.Len(t, gotActivities, 2)
+	assert.Equal(t, "bot", gotActivities[0].From.ID)
+	assert.Empty(t, gotActivities[0].ReplyToID)
+	assert.Equal(t, "event", gotActivities[0].Text)
+	assert.Equal(t, rootID, gotActivities[1].ReplyToID)
+}
+
+type fakeTeamsActivitySender struct {
+	replyToIDs []string
+	updatedIDs []string
+}
+
+func (f *fakeTeamsActivitySender) SendActivity(_ context.Context, _ schema.ConversationReference, replyToID string, _ coreActivity.MsgOption) (string, error) {
+	f.replyToIDs = append(f.replyToIDs, replyToID)
+	return fmt.Sprintf("activity-%d", len(f.replyToIDs)), nil
+}
+
+func (f *fakeTeamsActivitySender) UpdateActivity(_ context.Context, _ schema.ConversationReference, activityID string, _ coreActivity.MsgOption) error {
+	f.updatedIDs = append(f.updatedIDs, activityID)
+	return nil
+}
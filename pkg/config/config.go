@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
+	sprig "github.com/go-task/slim-sprig"
 	"github.com/knadh/koanf"
 	koanfyaml "github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/env"
 	"github.com/knadh/koanf/providers/rawbytes"
+	"github.com/robfig/cron/v3"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -98,6 +101,15 @@ const (
 
 	// WebhookCommPlatformIntegration defines an outgoing webhook integration.
 	WebhookCommPlatformIntegration CommPlatformIntegration = "webhook"
+
+	// LokiCommPlatformIntegration defines Grafana Loki integration.
+	LokiCommPlatformIntegration CommPlatformIntegration = "loki"
+
+	// OTLPLogsCommPlatformIntegration defines OpenTelemetry logs integration.
+	OTLPLogsCommPlatformIntegration CommPlatformIntegration = "otlpLogs"
+
+	// KafkaCommPlatformIntegration defines Apache Kafka integration.
+	KafkaCommPlatformIntegration CommPlatformIntegration = "kafka"
 )
 
 func (c CommPlatformIntegration) IsInteractive() bool {
@@ -123,11 +135,14 @@ const (
 // Config structure of configuration yaml file
 type Config struct {
 	Actions        Actions                   `yaml:"actions" validate:"dive"`
+	Schedules      Schedules                 `yaml:"schedules" validate:"dive"`
 	Sources        map[string]Sources        `yaml:"sources" validate:"dive"`
 	Executors      map[string]Executors      `yaml:"executors" validate:"dive"`
 	Aliases        Aliases                   `yaml:"aliases" validate:"dive"`
 	Communications map[string]Communications `yaml:"communications"  validate:"required,min=1,dive"`
 
+	CommandApprovals CommandApprovals `yaml:"commandApprovals"`
+
 	Analytics     Analytics        `yaml:"analytics"`
 	Settings      Settings         `yaml:"settings"`
 	ConfigWatcher CfgWatcher       `yaml:"configWatcher"`
@@ -178,6 +193,8 @@ type ChannelBindingsByName struct {
 	Name            string                `yaml:"name"`
 	Notification    ChannelNotification   `yaml:"notification"` // TODO: rename to `notifications` later
 	Bindings        BotBindings           `yaml:"bindings"`
+	Routes          []ChannelRoute        `yaml:"routes,omitempty"`
+	Approvers       CommandApprovers      `yaml:"approvers,omitempty"`
 	MessageTriggers []TextMessageTriggers `yaml:"messageTriggers"`
 }
 
@@ -191,6 +208,11 @@ func (c ChannelBindingsByName) GetBotBindings() BotBindings {
 	return c.Bindings
 }
 
+// GetRoutes returns associated routes.
+func (c ChannelBindingsByName) GetRoutes() []ChannelRoute {
+	return c.Routes
+}
+
 type TextMessageTriggerEvent string
 
 const (
@@ -226,6 +248,8 @@ type ChannelBindingsByID struct {
 	ID           string              `yaml:"id"`
 	Notification ChannelNotification `yaml:"notification"` // TODO: rename to `notifications` later
 	Bindings     BotBindings         `yaml:"bindings"`
+	Routes       []ChannelRoute      `yaml:"routes,omitempty"`
+	Approvers    CommandApprovers    `yaml:"approvers,omitempty"`
 }
 
 // Identifier returns ChannelBindingsByID identifier.
@@ -238,12 +262,33 @@ func (c ChannelBindingsByID) GetBotBindings() BotBindings {
 	return c.Bindings
 }
 
+// GetRoutes returns associated routes.
+func (c ChannelBindingsByID) GetRoutes() []ChannelRoute {
+	return c.Routes
+}
+
 // BotBindings contains configuration for possible Bot bindings.
 type BotBindings struct {
 	Sources   []string `yaml:"sources"`
 	Executors []string `yaml:"executors"`
 }
 
+// ChannelRoute defines a condition for routing source events to a given channel.
+// If a channel has routes defined, only events matching at least one route are sent to it.
+type ChannelRoute struct {
+	// Sources limits the route to events from given source bindings. If empty, all channel source bindings are matched.
+	Sources []string `yaml:"sources,omitempty"`
+	// When is a Go template condition evaluated against the event. The event matches if the condition renders to `true`.
+	// Available variables: `.Event`, `.SourceName`, `.Namespace`, `.Level`.
+	// If empty, all events from the route sources are matched.
+	When string `yaml:"when,omitempty"`
+}
+
+// ParseCondition parses the route condition template.
+func (r ChannelRoute) ParseCondition() (*template.Template, error) {
+	return template.New("route-condition").Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(r.When)
+}
+
 // SinkBindings contains configuration for possible Sink bindings.
 type SinkBindings struct {
 	Sources []string `yaml:"sources"`
@@ -254,10 +299,74 @@ type Actions map[string]Action
 
 // Action contains configuration for Botkube app event automations.
 type Action struct {
-	Enabled     bool           `yaml:"enabled"`
-	DisplayName string         `yaml:"displayName"`
-	Command     string         `yaml:"command" validate:"required_if=Enabled true"`
-	Bindings    ActionBindings `yaml:"bindings"`
+	Enabled     bool   `yaml:"enabled"`
+	DisplayName string `yaml:"displayName"`
+	// Command is executed when the action is triggered. It is mutually exclusive with Steps.
+	Command string `yaml:"command,omitempty"`
+	// Steps defines a pipeline of commands executed one by one when the action is triggered. It is mutually exclusive with Command.
+	Steps    []ActionStep   `yaml:"steps,omitempty" validate:"dive"`
+	Bindings ActionBindings `yaml:"bindings"`
+
+	// Cooldown is the minimal time between two executions of the action. If DedupKey is specified, it applies to each key separately.
+	Cooldown time.Duration `yaml:"cooldown,omitempty" validate:"gte=0"`
+	// MaxConcurrent limits the number of action executions running at the same time. If zero, the number is not limited.
+	MaxConcurrent int `yaml:"maxConcurrent,omitempty" validate:"gte=0"`
+	// DedupKey is a Go template rendered with the event, e.g. `{{ .Event.Namespace }}/{{ .Event.Name }}`.
+	// An execution is skipped if another one with the same key is still running or the key is within the cooldown period.
+	DedupKey string `yaml:"dedupKey,omitempty"`
+	// NotifySkipped adds the number of skipped executions to the next action output message.
+	NotifySkipped bool `yaml:"notifySkipped,omitempty"`
+	// Output defines where the action output is sent.
+	Output ActionOutput `yaml:"output,omitempty"`
+}
+
+// ActionOutputMode defines how the action output is posted to communication platforms.
+type ActionOutputMode string
+
+const (
+	// MessageActionOutputMode posts the output as a new message to channels bound to the same sources.
+	MessageActionOutputMode ActionOutputMode = "message"
+	// ThreadActionOutputMode replies in the thread of the notification which triggered the action.
+	// If the platform doesn't support threads, or the notification wasn't sent, a new message is posted.
+	ThreadActionOutputMode ActionOutputMode = "thread"
+	// NoneActionOutputMode doesn't post the output to communication platforms.
+	NoneActionOutputMode ActionOutputMode = "none"
+)
+
+// ActionOutput contains configuration for action output destinations.
+type ActionOutput struct {
+	// Mode defines how the output is posted to communication platforms. Defaults to "message".
+	Mode ActionOutputMode `yaml:"mode,omitempty" validate:"omitempty,oneof=message thread none"`
+	// Sinks is a list of sink integrations the output is sent to, in addition to communication platforms.
+	// Only sinks bound to the same sources receive the output.
+	Sinks []CommPlatformIntegration `yaml:"sinks,omitempty" validate:"dive,oneof=webhook elasticsearch loki otlpLogs kafka"`
+	// OnlyOnFailure suppresses the output unless the command, or one of the pipeline steps, fails.
+	OnlyOnFailure bool `yaml:"onlyOnFailure,omitempty"`
+}
+
+// ActionStepFailurePolicy defines what happens with the remaining steps when a given step fails.
+type ActionStepFailurePolicy string
+
+const (
+	// StopOnFailure skips all remaining steps.
+	StopOnFailure ActionStepFailurePolicy = "stop"
+	// ContinueOnFailure executes the remaining steps.
+	ContinueOnFailure ActionStepFailurePolicy = "continue"
+)
+
+// ActionStep contains configuration for a single step of the action pipeline.
+type ActionStep struct {
+	// Name identifies the step output in templates of the next steps, e.g. `{{ .Steps.getPod.Output }}`.
+	Name string `yaml:"name" validate:"required"`
+	// When is a Go template evaluated against the event and previous step outputs. The step is executed only if it renders "true".
+	// If empty, the step is always executed.
+	When string `yaml:"when,omitempty"`
+	// Command is a Go template rendered with the event and previous step outputs.
+	Command string `yaml:"command" validate:"required"`
+	// Timeout limits the step execution time. If zero, the step execution time is not limited.
+	Timeout time.Duration `yaml:"timeout,omitempty" validate:"gte=0"`
+	// OnFailure defines what happens when the step fails. Defaults to "stop".
+	OnFailure ActionStepFailurePolicy `yaml:"onFailure,omitempty" validate:"omitempty,oneof=stop continue"`
 }
 
 // ActionBindings contains configuration for action bindings.
@@ -266,10 +375,104 @@ type ActionBindings struct {
 	Executors []string `yaml:"executors"`
 }
 
+// Schedules contains configuration for Botkube commands executed periodically.
+type Schedules map[string]Schedule
+
+// Schedule contains configuration for a Botkube command executed periodically.
+type Schedule struct {
+	Enabled     bool   `yaml:"enabled"`
+	DisplayName string `yaml:"displayName"`
+	// Cron defines when the command is executed. It uses the standard 5-field cron format, e.g. "0 8 * * 1-5",
+	// or one of the descriptors, such as "@daily" or "@every 1h".
+	Cron string `yaml:"cron" validate:"required_if=Enabled true"`
+	// Timezone defines the IANA time zone used for the cron expression, e.g. "Europe/Warsaw". Defaults to UTC.
+	Timezone string           `yaml:"timezone"`
+	Command  string           `yaml:"command" validate:"required_if=Enabled true"`
+	Bindings ScheduleBindings `yaml:"bindings"`
+}
+
+// ScheduleBindings contains configuration for schedule bindings.
+type ScheduleBindings struct {
+	Executors []string `yaml:"executors"`
+	// Channels contains channels where the command output is sent. Both channel aliases and names (or IDs) are supported.
+	Channels []string `yaml:"channels"`
+}
+
+// CronSchedule parses the cron expression in the configured time zone.
+func (s Schedule) CronSchedule() (cron.Schedule, error) {
+	timezone := s.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	return cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", timezone, s.Cron))
+}
+
 // Sources contains configuration for Botkube app sources.
 type Sources struct {
-	DisplayName string  `yaml:"displayName"`
-	Plugins     Plugins `yaml:",inline" koanf:",remain"`
+	DisplayName string           `yaml:"displayName"`
+	Throttling  SourceThrottling `yaml:"throttling,omitempty"`
+	Incidents   IncidentGrouping `yaml:"incidents,omitempty"`
+	Plugins     Plugins          `yaml:",inline" koanf:",remain"`
+}
+
+// IncidentGrouping contains configuration for grouping related events into incidents.
+// The first event opens an incident, and the follow-up events are sent in the thread of its notification.
+type IncidentGrouping struct {
+	Enabled bool `yaml:"enabled"`
+	// Key is a Go template rendering the incident key. Events with the same key are grouped into a single incident.
+	// Available variables: `.Event`, `.SourceName`, `.Namespace`, `.Level`.
+	Key string `yaml:"key" validate:"required_if=Enabled true"`
+	// ResolveWhen is a Go template condition which must render `true` for an event resolving the incident.
+	// Available variables are the same as for Key. If empty, incidents are closed only after the inactivity timeout.
+	ResolveWhen string `yaml:"resolveWhen,omitempty"`
+	// InactivityTimeout is the time after which an incident without new events is closed.
+	// The next event with the same key opens a new incident.
+	InactivityTimeout time.Duration `yaml:"inactivityTimeout" validate:"required_if=Enabled true"`
+}
+
+// ParseKey parses the incident key template.
+func (g IncidentGrouping) ParseKey() (*template.Template, error) {
+	return template.New("incident-key").Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(g.Key)
+}
+
+// ParseResolveCondition parses the incident resolve condition template.
+func (g IncidentGrouping) ParseResolveCondition() (*template.Template, error) {
+	return template.New("incident-resolve-condition").Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(g.ResolveWhen)
+}
+
+// SourceThrottling contains configuration for deduplication and rate limiting of source notifications.
+// It applies only to bot notifications. Sinks and actions receive every event.
+type SourceThrottling struct {
+	// Deduplication suppresses similar events within a given time window.
+	Deduplication EventDeduplication `yaml:"deduplication"`
+	// RateLimit limits the number of notifications sent to a single channel.
+	RateLimit NotificationRateLimit `yaml:"rateLimit"`
+}
+
+// IsEnabled returns true if deduplication or rate limiting is enabled.
+func (t SourceThrottling) IsEnabled() bool {
+	return t.Deduplication.Enabled || t.RateLimit.Enabled
+}
+
+// EventDeduplication contains configuration for event deduplication.
+type EventDeduplication struct {
+	Enabled bool `yaml:"enabled"`
+	// Window is the time window during which events with the same fingerprint are suppressed.
+	// Once the window is closed, a summary with the number of suppressed events is sent.
+	Window time.Duration `yaml:"window" validate:"required_if=Enabled true"`
+	// Fields is a list of event fields used to compute the event fingerprint.
+	// Nested fields are separated by dots, e.g. `labels.alertname`. Field names are case-insensitive.
+	// If not specified, kind, namespace, name and reason are used.
+	Fields []string `yaml:"fields,omitempty"`
+}
+
+// NotificationRateLimit contains configuration for a token bucket rate limiter.
+type NotificationRateLimit struct {
+	Enabled bool `yaml:"enabled"`
+	// Burst is the maximum number of notifications that can be sent at once.
+	Burst int `yaml:"burst" validate:"required_if=Enabled true"`
+	// RefillInterval is the time after which a single notification token is restored.
+	RefillInterval time.Duration `yaml:"refillInterval" validate:"required_if=Enabled true"`
 }
 
 // GetPlugins returns Sources.Plugins.
@@ -343,8 +546,37 @@ const (
 	StaticPolicySubjectType PolicySubjectType = "Static"
 	// ChannelNamePolicySubjectType is the channel name policy type.
 	ChannelNamePolicySubjectType PolicySubjectType = "ChannelName"
+	// UserIDPolicySubjectType is the policy type based on the ID of the user who executes a command.
+	UserIDPolicySubjectType PolicySubjectType = "UserID"
+	// UserEmailPolicySubjectType is the policy type based on the email of the user who executes a command.
+	UserEmailPolicySubjectType PolicySubjectType = "UserEmail"
 )
 
+// IsUserBased returns true if the subject is resolved based on the user who executes a command.
+func (p PolicySubjectType) IsUserBased() bool {
+	return p == UserIDPolicySubjectType || p == UserEmailPolicySubjectType
+}
+
+// UserMapping maps communication platform users to Kubernetes subjects.
+// It is used by the UserID and UserEmail RBAC policy subject types.
+type UserMapping struct {
+	// Static contains mappings keyed by user ID or email.
+	Static map[string]K8sSubjectMapping `yaml:"static"`
+	// ConfigMap references a ConfigMap with additional mappings stored under the `mapping.yaml` key.
+	// The ConfigMap entries take precedence over the static ones.
+	ConfigMap K8sResourceRef `yaml:"configMap"`
+	// Default is used for users without a mapping. If not set, unmapped users cannot run commands with user based policy subjects.
+	Default *K8sSubjectMapping `yaml:"default,omitempty"`
+}
+
+// K8sSubjectMapping defines Kubernetes subjects for a given communication platform user.
+type K8sSubjectMapping struct {
+	// User is the Kubernetes user name. If empty, the user ID or email is used.
+	User string `yaml:"user"`
+	// Groups are the Kubernetes group names.
+	Groups []string `yaml:"groups"`
+}
+
 // Executors contains executors configuration parameters.
 type Executors struct {
 	Plugins Plugins `yaml:",inline" koanf:",remain"`
@@ -450,7 +682,32 @@ func (r *RegexConstraints) IsAllowed(value string) (bool, error) {
 
 // ChannelNotification contains notification configuration for a given platform.
 type ChannelNotification struct {
-	Disabled bool `yaml:"disabled"`
+	Disabled bool               `yaml:"disabled"`
+	Digest   NotificationDigest `yaml:"digest,omitempty"`
+}
+
+// DigestGroupKey defines a key used to group notifications in a digest.
+type DigestGroupKey string
+
+const (
+	// SourceDigestGroupKey groups notifications by source bindings.
+	SourceDigestGroupKey DigestGroupKey = "source"
+	// NamespaceDigestGroupKey groups notifications by Kubernetes namespace.
+	NamespaceDigestGroupKey DigestGroupKey = "namespace"
+	// LevelDigestGroupKey groups notifications by event level.
+	LevelDigestGroupKey DigestGroupKey = "level"
+)
+
+// NotificationDigest contains configuration for sending notifications as a periodic summary instead of a message per event.
+// Buffered notifications are persisted on shutdown and restored on start, so the digest is not sent early on restart.
+type NotificationDigest struct {
+	Enabled bool `yaml:"enabled"`
+	// Interval defines how often the digest is sent.
+	Interval time.Duration `yaml:"interval" validate:"required_if=Enabled true"`
+	// MaxItems is the maximum number of notifications listed in a single digest. Remaining ones are only counted.
+	MaxItems int `yaml:"maxItems" validate:"gte=0"`
+	// GroupBy defines keys used to group notifications in the digest. If not specified, notifications are grouped by source, namespace and level.
+	GroupBy []DigestGroupKey `yaml:"groupBy,omitempty" validate:"dive,oneof=source namespace level"`
 }
 
 // Communications contains communication platforms that are supported.
@@ -464,6 +721,9 @@ type Communications struct {
 	CloudTeams    CloudTeams    `yaml:"cloudTeams,omitempty"`
 	Webhook       Webhook       `yaml:"webhook,omitempty"`
 	Elasticsearch Elasticsearch `yaml:"elasticsearch,omitempty"`
+	Loki          Loki          `yaml:"loki,omitempty"`
+	OTLPLogs      OTLPLogs      `yaml:"otlpLogs,omitempty"`
+	Kafka         Kafka         `yaml:"kafka,omitempty"`
 }
 
 // Slack holds Slack integration config.
@@ -508,6 +768,20 @@ type Elasticsearch struct {
 	AWSSigning    AWSSigning          `yaml:"awsSigning"`
 	Indices       map[string]ELSIndex `yaml:"indices"  validate:"required_if=Enabled true,dive,omitempty,min=1"`
 	LogLevel      string              `yaml:"logLevel"`
+	// Bulk configures bulk indexing. If disabled, events are indexed one by one.
+	Bulk ELSBulk `yaml:"bulk,omitempty"`
+}
+
+// ELSBulk contains Elasticsearch bulk indexing configuration.
+type ELSBulk struct {
+	Enabled bool `yaml:"enabled"`
+	// FlushSize is the number of buffered events which triggers the flush.
+	FlushSize int `yaml:"flushSize" validate:"required_if=Enabled true,gte=0"`
+	// FlushInterval is the maximum time events are buffered before the flush.
+	FlushInterval time.Duration `yaml:"flushInterval" validate:"required_if=Enabled true,gte=0"`
+	// BufferSize limits the number of buffered events, including the ones waiting for a retry after a failed flush.
+	// Once the buffer is full, the oldest events are dropped.
+	BufferSize int `yaml:"bufferSize" validate:"required_if=Enabled true,gte=0"`
 }
 
 // AWSSigning contains AWS configurations
@@ -523,10 +797,44 @@ type ELSIndex struct {
 	Type     string `yaml:"type"`
 	Shards   int    `yaml:"shards"`
 	Replicas int    `yaml:"replicas"`
+	// NamePattern is a date-based index name pattern, e.g. `botkube-%Y.%m.%d`. Supported placeholders: %Y, %m, %d, %H.
+	// If not specified, the `{name}-YYYY-MM-DD` pattern is used.
+	NamePattern string `yaml:"namePattern,omitempty"`
+	// DataStream sends events to the data stream with a given name instead of date-based indices.
+	// The data stream requires a matching index template.
+	DataStream bool `yaml:"dataStream,omitempty"`
+	// Template configures the index template managed by Botkube.
+	Template ELSIndexTemplate `yaml:"template,omitempty"`
+	// ILM configures the index lifecycle policy assigned by the index template.
+	ILM ELSILMPolicy `yaml:"ilm,omitempty"`
 
 	Bindings SinkBindings `yaml:"bindings"`
 }
 
+// ELSIndexTemplate contains configuration of the composable index template. Requires Elasticsearch 7.8 or newer.
+type ELSIndexTemplate struct {
+	// Enabled creates or updates the index template on startup.
+	Enabled bool `yaml:"enabled"`
+	// Name is the index template name. Defaults to the index name.
+	Name string `yaml:"name,omitempty"`
+	// Priority of the index template. Templates with higher priority take precedence.
+	Priority int `yaml:"priority,omitempty" validate:"gte=0"`
+}
+
+// ELSILMPolicy contains configuration of the index lifecycle management policy.
+type ELSILMPolicy struct {
+	// Enabled creates or updates the ILM policy on startup.
+	Enabled bool `yaml:"enabled"`
+	// Name is the ILM policy name. Defaults to the index name.
+	Name string `yaml:"name,omitempty"`
+	// RolloverMaxAge and RolloverMaxPrimaryShardSize trigger the rollover of data stream backing indices, e.g. `1d` and `50gb`.
+	// Ignored for date-based indices.
+	RolloverMaxAge              string `yaml:"rolloverMaxAge,omitempty"`
+	RolloverMaxPrimaryShardSize string `yaml:"rolloverMaxPrimaryShardSize,omitempty"`
+	// DeleteAfter deletes indices after a given time since the rollover or index creation, e.g. `30d`.
+	DeleteAfter string `yaml:"deleteAfter,omitempty"`
+}
+
 // Mattermost configuration to authentication and send notifications
 type Mattermost struct {
 	Enabled  bool                                   `yaml:"enabled"`
@@ -571,11 +879,169 @@ type Discord struct {
 
 // Webhook configuration to send notifications
 type Webhook struct {
-	Enabled  bool         `yaml:"enabled"`
-	URL      string       `yaml:"url"`
+	Enabled bool   `yaml:"enabled"`
+	URL     string `yaml:"url"`
+	// Method is the HTTP method used to send events. Defaults to POST.
+	Method string `yaml:"method,omitempty" validate:"omitempty,oneof=POST PUT PATCH"`
+	// Headers are additional HTTP headers sent with each request.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Auth configures the request authentication.
+	Auth WebhookAuth `yaml:"auth,omitempty"`
+	// Templates configures custom request bodies. If not specified, the default JSON payload is sent.
+	Templates WebhookTemplates `yaml:"templates,omitempty"`
+	// Signing configures the HMAC signature of the request body.
+	Signing WebhookSigning `yaml:"signing,omitempty"`
+	// Retry configures retries of failed requests.
+	Retry WebhookRetry `yaml:"retry,omitempty"`
+	// TLS configures the TLS client options.
+	TLS      SinkTLS      `yaml:"tls,omitempty"`
 	Bindings SinkBindings `yaml:"bindings" validate:"required_if=Enabled true"`
 }
 
+// WebhookAuthType defines the webhook authentication type.
+type WebhookAuthType string
+
+const (
+	// BearerWebhookAuth sends the token in the `Authorization: Bearer` header.
+	BearerWebhookAuth WebhookAuthType = "bearer"
+	// BasicWebhookAuth uses the HTTP basic authentication.
+	BasicWebhookAuth WebhookAuthType = "basic"
+)
+
+// WebhookAuth contains configuration for the webhook authentication.
+// Secret values can be read from files, e.g. a mounted Kubernetes Secret. Files are read before each request, so the values can be rotated.
+type WebhookAuth struct {
+	Type         WebhookAuthType `yaml:"type,omitempty" validate:"omitempty,oneof=bearer basic"`
+	Token        string          `yaml:"token,omitempty"`
+	TokenFile    string          `yaml:"tokenFile,omitempty"`
+	Username     string          `yaml:"username,omitempty"`
+	Password     string          `yaml:"password,omitempty"`
+	PasswordFile string          `yaml:"passwordFile,omitempty"`
+}
+
+// WebhookTemplates contains Go templates for webhook request bodies. Templates are rendered with the `.Source`, `.Data` and `.TimeStamp` fields.
+type WebhookTemplates struct {
+	// Default is used for sources without a dedicated template.
+	Default string `yaml:"default,omitempty"`
+	// Sources holds templates indexed by the source configuration name.
+	Sources map[string]string `yaml:"sources,omitempty"`
+}
+
+// WebhookSigning contains configuration for the HMAC-SHA256 signature of the request body.
+type WebhookSigning struct {
+	Enabled    bool   `yaml:"enabled"`
+	Secret     string `yaml:"secret,omitempty"`
+	SecretFile string `yaml:"secretFile,omitempty"`
+	// Header is the name of the header with the hex-encoded signature. Defaults to `X-Botkube-Signature`.
+	Header string `yaml:"header,omitempty"`
+}
+
+// WebhookRetry contains configuration for retries with exponential backoff.
+type WebhookRetry struct {
+	Enabled bool `yaml:"enabled"`
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts uint `yaml:"maxAttempts" validate:"required_if=Enabled true"`
+	// InitialDelay is the delay before the first retry. It's doubled with each retry.
+	InitialDelay time.Duration `yaml:"initialDelay" validate:"required_if=Enabled true"`
+	// MaxDelay limits the delay between retries.
+	MaxDelay time.Duration `yaml:"maxDelay,omitempty" validate:"gte=0"`
+	// QueueSize limits the number of events waiting for a retry. The first attempt doesn't use the queue.
+	// Events which fail when the queue is full are dropped.
+	QueueSize int `yaml:"queueSize" validate:"required_if=Enabled true,gte=0"`
+}
+
+// SinkTLS contains TLS client configuration for sinks.
+type SinkTLS struct {
+	// Enabled enables TLS for integrations which don't use URL schemes, such as Kafka.
+	// HTTP-based sinks use TLS for https:// URLs regardless of this setting.
+	Enabled    bool `yaml:"enabled,omitempty"`
+	SkipVerify bool `yaml:"skipVerify,omitempty"`
+	// CACertFile is a path to the PEM-encoded CA bundle used to verify the server certificate.
+	CACertFile string `yaml:"caCertFile,omitempty"`
+	// CertFile and KeyFile are paths to the PEM-encoded client certificate and key used for mutual TLS.
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
+}
+
+// Loki configuration to push events to Grafana Loki.
+type Loki struct {
+	Enabled bool `yaml:"enabled"`
+	// URL is the Loki server address, e.g. http://loki:3100. Events are sent to the push API endpoint.
+	URL string `yaml:"url"`
+	// TenantID is sent in the X-Scope-OrgID header. Required for multi-tenant Loki installations.
+	TenantID string `yaml:"tenantID,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	// Labels are static stream labels added to all events.
+	Labels map[string]string `yaml:"labels,omitempty"`
+	// LabelFields maps stream label names to dot-separated event field paths, e.g. `namespace: labels.namespace`.
+	// Labels for fields which are not found in a given event are skipped.
+	LabelFields map[string]string `yaml:"labelFields,omitempty"`
+	TLS         SinkTLS           `yaml:"tls,omitempty"`
+	Bindings    SinkBindings      `yaml:"bindings" validate:"required_if=Enabled true"`
+}
+
+// OTLPProtocol defines the OTLP transport protocol.
+type OTLPProtocol string
+
+const (
+	// GRPCOTLPProtocol sends logs using OTLP/gRPC.
+	GRPCOTLPProtocol OTLPProtocol = "grpc"
+	// HTTPOTLPProtocol sends logs using OTLP/HTTP with protobuf encoding.
+	HTTPOTLPProtocol OTLPProtocol = "http"
+)
+
+// OTLPLogs configuration to export events as OpenTelemetry log records.
+type OTLPLogs struct {
+	Enabled bool `yaml:"enabled"`
+	// Endpoint is the collector address. For gRPC, it's the host:port pair, e.g. otel-collector:4317.
+	// For HTTP, it's the base URL, e.g. http://otel-collector:4318.
+	Endpoint string       `yaml:"endpoint"`
+	Protocol OTLPProtocol `yaml:"protocol" validate:"omitempty,oneof=grpc http"`
+	// Headers are sent with each export request, e.g. for authentication.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// ResourceAttributes are added to the exported resource, next to the service.name attribute.
+	ResourceAttributes map[string]string `yaml:"resourceAttributes,omitempty"`
+	// Timeout limits the duration of a single export request.
+	Timeout  time.Duration `yaml:"timeout,omitempty" validate:"gte=0"`
+	TLS      SinkTLS       `yaml:"tls,omitempty"`
+	Bindings SinkBindings  `yaml:"bindings" validate:"required_if=Enabled true"`
+}
+
+// KafkaSASLMechanism defines the Kafka SASL mechanism.
+type KafkaSASLMechanism string
+
+const (
+	// PlainKafkaSASLMechanism defines SASL/PLAIN mechanism.
+	PlainKafkaSASLMechanism KafkaSASLMechanism = "PLAIN"
+	// SCRAMSHA256KafkaSASLMechanism defines SASL/SCRAM-SHA-256 mechanism.
+	SCRAMSHA256KafkaSASLMechanism KafkaSASLMechanism = "SCRAM-SHA-256"
+	// SCRAMSHA512KafkaSASLMechanism defines SASL/SCRAM-SHA-512 mechanism.
+	SCRAMSHA512KafkaSASLMechanism KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// Kafka configuration to publish events to a Kafka topic.
+type Kafka struct {
+	Enabled bool     `yaml:"enabled"`
+	Brokers []string `yaml:"brokers"`
+	Topic   string   `yaml:"topic"`
+	// KeyTemplate is a Go template used to render the message key, e.g. `{{ .Data.namespace }}`. If not specified, messages are sent without a key.
+	KeyTemplate string    `yaml:"keyTemplate,omitempty"`
+	SASL        KafkaSASL `yaml:"sasl,omitempty"`
+	TLS         SinkTLS   `yaml:"tls,omitempty"`
+	// Timeout limits the duration of a single write.
+	Timeout  time.Duration `yaml:"timeout,omitempty" validate:"gte=0"`
+	Bindings SinkBindings  `yaml:"bindings" validate:"required_if=Enabled true"`
+}
+
+// KafkaSASL contains Kafka SASL authentication configuration.
+type KafkaSASL struct {
+	Enabled   bool               `yaml:"enabled"`
+	Mechanism KafkaSASLMechanism `yaml:"mechanism" validate:"omitempty,oneof=PLAIN SCRAM-SHA-256 SCRAM-SHA-512"`
+	Username  string             `yaml:"username"`
+	Password  string             `yaml:"password"`
+}
+
 // CfgWatcher describes configuration for watching the configuration.
 type CfgWatcher struct {
 	Enabled   bool                `yaml:"enabled"`
@@ -608,6 +1074,8 @@ type Settings struct {
 	InformersResyncPeriod   time.Duration    `yaml:"informersResyncPeriod"`
 	Kubeconfig              string           `yaml:"kubeconfig"`
 	SACredentialsPathPrefix string           `yaml:"saCredentialsPathPrefix"`
+	EventHistory            EventHistory     `yaml:"eventHistory"`
+	UserMapping             UserMapping      `yaml:"userMapping"`
 }
 
 // Formatter log formatter
@@ -634,6 +1102,79 @@ type LifecycleServer struct {
 	Port    int  `yaml:"port"` // String for consistency
 }
 
+// CommandApprovals contains configuration of the approval workflow for executor commands.
+// Commands matching one of the rules are executed only after an authorized approver approves them.
+type CommandApprovals struct {
+	Enabled bool `yaml:"enabled"`
+	// Timeout defines how long a request waits for a decision before it expires.
+	Timeout time.Duration `yaml:"timeout" validate:"gte=0"`
+	// Rules defines commands that require approval.
+	Rules []CommandApprovalRule `yaml:"rules" validate:"dive"`
+	// Groups defines named lists of users which can be referenced by approvers.
+	Groups map[string][]string `yaml:"groups"`
+	// Approvers are used for channels without their own approvers configuration.
+	Approvers CommandApprovers `yaml:"approvers"`
+}
+
+// CommandApprovalRule defines executor commands that require approval.
+type CommandApprovalRule struct {
+	Name string `yaml:"name"`
+	// Plugins contains command names, such as kubectl, helm or flux. Regular expressions are supported.
+	Plugins []string `yaml:"plugins" validate:"required,min=1"`
+	// Verbs contains command verbs, such as delete or rollback. Regular expressions are supported.
+	// If empty, all commands for a given plugin require approval.
+	Verbs []string `yaml:"verbs"`
+}
+
+// CommandApprovers defines users which are allowed to approve commands.
+type CommandApprovers struct {
+	// Users contains user IDs or mentions. Display names are not matched.
+	Users []string `yaml:"users,omitempty"`
+	// Groups contains names of groups defined under the `commandApprovals.groups` property.
+	Groups []string `yaml:"groups,omitempty"`
+}
+
+// IsEmpty returns true if no approvers are defined.
+func (a CommandApprovers) IsEmpty() bool {
+	return len(a.Users) == 0 && len(a.Groups) == 0
+}
+
+// EventHistory contains configuration for the store that keeps history of dispatched events.
+type EventHistory struct {
+	Enabled   bool                  `yaml:"enabled"`
+	Storage   EventHistoryStorage   `yaml:"storage"`
+	Retention EventHistoryRetention `yaml:"retention"`
+}
+
+// EventHistoryStorageType defines the storage backend for event history.
+type EventHistoryStorageType string
+
+const (
+	// EventHistoryBoltStorage stores events in an embedded BoltDB file.
+	EventHistoryBoltStorage EventHistoryStorageType = "bolt"
+
+	// EventHistoryConfigMapStorage stores events in a ConfigMap used as a ring buffer.
+	EventHistoryConfigMapStorage EventHistoryStorageType = "configMap"
+)
+
+// EventHistoryStorage contains configuration for the event history storage backend.
+type EventHistoryStorage struct {
+	Type      EventHistoryStorageType `yaml:"type" validate:"omitempty,oneof=bolt configMap"`
+	Bolt      BoltStorage             `yaml:"bolt"`
+	ConfigMap K8sResourceRef          `yaml:"configMap"`
+}
+
+// BoltStorage contains configuration for the BoltDB storage.
+type BoltStorage struct {
+	Path string `yaml:"path"`
+}
+
+// EventHistoryRetention defines how long events are kept in the event history.
+type EventHistoryRetention struct {
+	MaxEvents int           `yaml:"maxEvents" validate:"gte=0"`
+	MaxAge    time.Duration `yaml:"maxAge" validate:"gte=0"`
+}
+
 // PersistentConfig contains configuration for persistent storage.
 type PersistentConfig struct {
 	Startup PartialPersistentConfig `yaml:"startup"`
@@ -173,6 +173,16 @@ func TestLoadedConfigValidationErrors(t *testing.T) {
 				readTestdataFile(t, "missing-source.yaml"),
 			},
 		},
+		{
+			name: "invalid routes",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Communications[default-workspace].SocketSlack.Channels[alias].prometheus' 'prometheus' route source is not bound to the channel
+					* Key: 'Config.Communications[default-workspace].SocketSlack.Channels[alias].Routes[1].When' Routes[1].When is not a valid template: template: route-condition:1: unexpected EOF`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-routes.yaml"),
+			},
+		},
 		{
 			name: "missing action bindings",
 			expErrMsg: heredoc.Doc(`
@@ -183,6 +193,79 @@ func TestLoadedConfigValidationErrors(t *testing.T) {
 				readTestdataFile(t, "missing-action-bindings.yaml"),
 			},
 		},
+		{
+			name: "invalid schedules",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 3 errors occurred:
+					* Key: 'Config.Schedules[not-running-pods].Bindings.kubectl-read-only' 'kubectl-read-only' binding not defined in Config.Executors
+					* Key: 'Config.Schedules[not-running-pods].Bindings.not-existing' 'not-existing' binding not defined in Config.Communications
+					* Key: 'Config.Schedules[not-running-pods].Cron' Cron is not a valid cron expression: expected exactly 5 fields, found 2: [every morning]`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-schedules.yaml"),
+			},
+		},
+		{
+			name: "invalid actions",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 7 errors occurred:
+					* Key: 'Config.Actions[failed-pod-issue].Steps[1].OnFailure' OnFailure must be one of [stop continue]
+					* Key: 'Config.Actions[failed-pod-issue].MaxConcurrent' MaxConcurrent must be 0 or greater
+					* Key: 'Config.Actions[failed-pod-issue].Output.Mode' Mode must be one of [message thread none]
+					* Key: 'Config.Actions[failed-pod-issue].Output.Sinks[0]' Sinks[0] must be one of [webhook elasticsearch loki otlpLogs kafka]
+					* Key: 'Config.Actions[failed-pod-issue].DedupKey' DedupKey is not a valid template: template: action-dedup-key:1: unclosed action
+					* Key: 'Config.Actions[failed-pod-issue].Steps[1].Name' Steps[1].Name "getPod" is not unique
+					* Key: 'Config.Actions[failed-pod-issue].Steps[1].When' Steps[1].When is not a valid template: template: action-step:1: unexpected "}" in operand`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-actions.yaml"),
+			},
+		},
+		{
+			name: "invalid webhook",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 6 errors occurred:
+					* Key: 'Config.Communications[default-group].Webhook.Method' Method must be one of [POST PUT PATCH]
+					* Key: 'Config.Communications[default-group].Webhook.Auth.Username' Username is a required field
+					* Key: 'Config.Communications[default-group].Webhook.Auth.Password' Password or PasswordFile is required for basic authentication
+					* Key: 'Config.Communications[default-group].Webhook.Signing.Secret' Secret or SecretFile is required for request signing
+					* Key: 'Config.Communications[default-group].Webhook.TLS.CertFile' CertFile and KeyFile must be specified together
+					* Key: 'Config.Communications[default-group].Webhook.Templates.Default' Templates.Default is not a valid template: template: webhook:1: unexpected "}" in operand`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-webhook.yaml"),
+			},
+		},
+		{
+			name: "invalid sinks",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 14 errors occurred:
+					* Key: 'Config.Communications[default-group].Elasticsearch.Indices[default].NamePattern' NamePattern cannot be used together with DataStream
+					* Key: 'Config.Communications[default-group].Elasticsearch.Indices[default].ILM.Enabled' ILM requires Template to be enabled, as the policy is assigned by the index template
+					* Key: 'Config.Communications[default-group].Elasticsearch.Bulk.FlushSize' FlushSize is a required field
+					* Key: 'Config.Communications[default-group].Loki.URL' URL is a required field
+					* Key: 'Config.Communications[default-group].Loki.LabelFields[namespace]' LabelFields[namespace] is a required field
+					* Key: 'Config.Communications[default-group].OTLPLogs.Protocol' Protocol must be one of [grpc http]
+					* Key: 'Config.Communications[default-group].OTLPLogs.Endpoint' Endpoint is a required field
+					* Key: 'Config.Communications[default-group].OTLPLogs.TLS.CertFile' CertFile and KeyFile must be specified together
+					* Key: 'Config.Communications[default-group].Kafka.SASL.Mechanism' Mechanism must be one of [PLAIN SCRAM-SHA-256 SCRAM-SHA-512]
+					* Key: 'Config.Communications[default-group].Kafka.Brokers' Brokers is a required field
+					* Key: 'Config.Communications[default-group].Kafka.Topic' Topic is a required field
+					* Key: 'Config.Communications[default-group].Kafka.KeyTemplate' KeyTemplate is not a valid template: template: kafka-key:1: unexpected "}" in operand
+					* Key: 'Config.Communications[default-group].Kafka.SASL.Username' Username is a required field
+					* Key: 'Config.Communications[default-group].Kafka.SASL.Password' Password is a required field`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-sinks.yaml"),
+			},
+		},
+		{
+			name: "invalid incidents",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 3 errors occurred:
+					* Key: 'Config.Sources[k8s-events].Incidents.InactivityTimeout' InactivityTimeout is a required field
+					* Key: 'Config.Sources[k8s-events].Incidents.Key' Key is not a valid template: template: incident-key:1: unclosed action
+					* Key: 'Config.Sources[k8s-events].Incidents.ResolveWhen' ResolveWhen is not a valid template: template: incident-resolve-condition:1: missing value for if`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-incidents.yaml"),
+			},
+		},
 		{
 			name: "missing alias command",
 			expErrMsg: heredoc.Doc(`
@@ -219,6 +302,16 @@ func TestLoadedConfigValidationErrors(t *testing.T) {
 				readTestdataFile(t, "sources-rbac.yaml"),
 			},
 		},
+		{
+			name: "User based RBAC for sources and actions",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Actions[describe-created-resource].Bindings.kubectl' Plugin botkube/kubectl has 'UserEmail' RBAC policy. This is not supported for actions. See https://docs.botkube.io/configuration/action#rbac
+					* Key: 'Config.Sources[cm].botkube/cm-watcher' uses the "UserID" RBAC policy subject type, which is not supported for sources.`),
+			configs: [][]byte{
+				readTestdataFile(t, "user-based-rbac.yaml"),
+			},
+		},
 		{
 			name: "Invalid channel names",
 			expErrMsg: heredoc.Doc(`
@@ -5,15 +5,19 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"text/template"
 
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
+	sprig "github.com/go-task/slim-sprig"
 	"github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/slices"
 
 	"github.com/kubeshop/botkube/pkg/conversation"
 	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/maputil"
 	multierrx "github.com/kubeshop/botkube/pkg/multierror"
 )
 
@@ -28,6 +32,13 @@ const (
 	invalidAliasCommandTag      = "invalid_alias_command"
 	invalidPluginRBACTag        = "invalid_plugin_rbac"
 	invalidActionRBACTag        = "invalid_action_tag"
+	invalidRouteSourceTag       = "invalid_route_source"
+	invalidRouteConditionTag    = "invalid_route_condition"
+	invalidScheduleRBACTag      = "invalid_schedule_rbac"
+	invalidScheduleCronTag      = "invalid_schedule_cron"
+	invalidActionTag            = "invalid_action"
+	invalidSinkTag              = "invalid_sink"
+	invalidIncidentGroupingTag  = "invalid_incident_grouping"
 	appTokenPrefix              = "xapp-"
 	botTokenPrefix              = "xoxb-"
 )
@@ -83,13 +94,27 @@ func ValidateStruct(in any) (ValidateResult, error) {
 		return ValidateResult{}, err
 	}
 
+	if err := registerChannelRoutesValidator(validate, trans); err != nil {
+		return ValidateResult{}, err
+	}
+
+	if err := registerScheduleValidator(validate, trans); err != nil {
+		return ValidateResult{}, err
+	}
+
 	validate.RegisterStructValidation(slackStructTokenValidator, Slack{})
 	validate.RegisterStructValidation(socketSlackValidator, SocketSlack{})
 	validate.RegisterStructValidation(discordValidator, Discord{})
 	validate.RegisterStructValidation(cloudSlackValidator, CloudSlack{})
 	validate.RegisterStructValidation(mattermostValidator, Mattermost{})
+	validate.RegisterStructValidation(webhookValidator, Webhook{})
+	validate.RegisterStructValidation(elsIndexValidator, ELSIndex{})
+	validate.RegisterStructValidation(lokiValidator, Loki{})
+	validate.RegisterStructValidation(otlpLogsValidator, OTLPLogs{})
+	validate.RegisterStructValidation(kafkaValidator, Kafka{})
 
 	validate.RegisterStructValidation(sourceStructValidator, Sources{})
+	validate.RegisterStructValidation(incidentGroupingValidator, IncidentGrouping{})
 	validate.RegisterStructValidation(executorStructValidator, Executors{})
 
 	err := validate.Struct(in)
@@ -121,8 +146,10 @@ func ValidateStruct(in any) (ValidateResult, error) {
 
 func registerCustomTranslations(validate *validator.Validate, trans ut.Translator) error {
 	return registerTranslation(validate, trans, map[string]string{
-		"invalid_slack_token": "{0} {1}",
-		invalidChannelNameTag: "The channel name '{0}' seems to be invalid. See the documentation to learn more: {1}.",
+		"invalid_slack_token":      "{0} {1}",
+		invalidChannelNameTag:      "The channel name '{0}' seems to be invalid. See the documentation to learn more: {1}.",
+		invalidSinkTag:             "{0}{1}",
+		invalidIncidentGroupingTag: "{0}{1}",
 	})
 }
 
@@ -139,6 +166,7 @@ func registerRegexConstraintsValidator(validate *validator.Validate, trans ut.Tr
 func registerBindingsValidator(validate *validator.Validate, trans ut.Translator) error {
 	validate.RegisterStructValidation(botBindingsStructValidator, BotBindings{})
 	validate.RegisterStructValidation(actionBindingsStructValidator, ActionBindings{})
+	validate.RegisterStructValidation(actionStructValidator, Action{})
 	validate.RegisterStructValidation(sinkBindingsStructValidator, SinkBindings{})
 
 	return registerTranslation(validate, trans, map[string]string{
@@ -147,7 +175,8 @@ func registerBindingsValidator(validate *validator.Validate, trans ut.Translator
 		conflictingPluginVersionTag: "{0}{1}",
 		invalidPluginDefinitionTag:  "{0}{1}",
 		invalidPluginRBACTag:        "Binding is referencing plugins of same kind with different RBAC. '{0}' and '{1}' bindings must be identical when used together.",
-		invalidActionRBACTag:        "Plugin {0} has 'ChannelName' RBAC policy. This is not supported for actions. See https://docs.botkube.io/configuration/action#rbac",
+		invalidActionRBACTag:        "Plugin {0} has '{1}' RBAC policy. This is not supported for actions. See https://docs.botkube.io/configuration/action#rbac",
+		invalidActionTag:            "{0}{1}",
 	})
 }
 
@@ -159,6 +188,25 @@ func registerAliasValidator(validate *validator.Validate, trans ut.Translator) e
 	})
 }
 
+func registerChannelRoutesValidator(validate *validator.Validate, trans ut.Translator) error {
+	validate.RegisterStructValidation(channelRoutesStructValidator, ChannelBindingsByName{}, ChannelBindingsByID{})
+
+	return registerTranslation(validate, trans, map[string]string{
+		invalidRouteSourceTag:    "'{0}' route source is not bound to the channel",
+		invalidRouteConditionTag: "{0}{1}",
+	})
+}
+
+func registerScheduleValidator(validate *validator.Validate, trans ut.Translator) error {
+	validate.RegisterStructValidation(scheduleStructValidator, Schedule{})
+	validate.RegisterStructValidation(scheduleBindingsStructValidator, ScheduleBindings{})
+
+	return registerTranslation(validate, trans, map[string]string{
+		invalidScheduleCronTag: "{0}{1}",
+		invalidScheduleRBACTag: "Plugin {0} has '{1}' RBAC policy. This is not supported for schedules.",
+	})
+}
+
 func slackStructTokenValidator(sl validator.StructLevel) {
 	slack, ok := sl.Current().Interface().(Slack)
 
@@ -205,6 +253,125 @@ func socketSlackValidator(sl validator.StructLevel) {
 	validateChannels(sl, slackChannelNameRegex, true, slack.Channels, "Name", slackDocsURL)
 }
 
+func webhookValidator(sl validator.StructLevel) {
+	webhook, ok := sl.Current().Interface().(Webhook)
+	if !ok || !webhook.Enabled {
+		return
+	}
+
+	if webhook.URL == "" {
+		sl.ReportError(webhook.URL, "URL", "URL", "required", "")
+	}
+
+	auth := webhook.Auth
+	switch auth.Type {
+	case BearerWebhookAuth:
+		if auth.Token == "" && auth.TokenFile == "" {
+			sl.ReportError(auth.Token, "Token", "Auth.Token", invalidSinkTag, " or TokenFile is required for bearer authentication")
+		}
+	case BasicWebhookAuth:
+		if auth.Username == "" {
+			sl.ReportError(auth.Username, "Username", "Auth.Username", "required", "")
+		}
+		if auth.Password == "" && auth.PasswordFile == "" {
+			sl.ReportError(auth.Password, "Password", "Auth.Password", invalidSinkTag, " or PasswordFile is required for basic authentication")
+		}
+	}
+
+	if webhook.Signing.Enabled && webhook.Signing.Secret == "" && webhook.Signing.SecretFile == "" {
+		sl.ReportError(webhook.Signing.Secret, "Secret", "Signing.Secret", invalidSinkTag, " or SecretFile is required for request signing")
+	}
+
+	validateSinkTLS(sl, webhook.TLS)
+
+	templates := map[string]string{"Default": webhook.Templates.Default}
+	for name, tpl := range webhook.Templates.Sources {
+		templates[fmt.Sprintf("Sources[%s]", name)] = tpl
+	}
+	for _, name := range maputil.SortKeys(templates) {
+		if _, err := template.New("webhook").Funcs(sprig.TxtFuncMap()).Parse(templates[name]); err != nil {
+			fieldName := fmt.Sprintf("Templates.%s", name)
+			sl.ReportError(templates[name], fieldName, fieldName, invalidSinkTag, fmt.Sprintf(" is not a valid template: %s", err.Error()))
+		}
+	}
+}
+
+func elsIndexValidator(sl validator.StructLevel) {
+	index, ok := sl.Current().Interface().(ELSIndex)
+	if !ok {
+		return
+	}
+
+	if index.DataStream && index.NamePattern != "" {
+		sl.ReportError(index.NamePattern, "NamePattern", "NamePattern", invalidSinkTag, " cannot be used together with DataStream")
+	}
+	if index.ILM.Enabled && !index.Template.Enabled {
+		sl.ReportError(index.ILM.Enabled, "ILM", "ILM.Enabled", invalidSinkTag, " requires Template to be enabled, as the policy is assigned by the index template")
+	}
+}
+
+func lokiValidator(sl validator.StructLevel) {
+	loki, ok := sl.Current().Interface().(Loki)
+	if !ok || !loki.Enabled {
+		return
+	}
+
+	if loki.URL == "" {
+		sl.ReportError(loki.URL, "URL", "URL", "required", "")
+	}
+	for _, name := range maputil.SortKeys(loki.LabelFields) {
+		if loki.LabelFields[name] == "" {
+			fieldName := fmt.Sprintf("LabelFields[%s]", name)
+			sl.ReportError(loki.LabelFields[name], fieldName, fieldName, "required", "")
+		}
+	}
+	validateSinkTLS(sl, loki.TLS)
+}
+
+func otlpLogsValidator(sl validator.StructLevel) {
+	otlp, ok := sl.Current().Interface().(OTLPLogs)
+	if !ok || !otlp.Enabled {
+		return
+	}
+
+	if otlp.Endpoint == "" {
+		sl.ReportError(otlp.Endpoint, "Endpoint", "Endpoint", "required", "")
+	}
+	validateSinkTLS(sl, otlp.TLS)
+}
+
+func kafkaValidator(sl validator.StructLevel) {
+	kafka, ok := sl.Current().Interface().(Kafka)
+	if !ok || !kafka.Enabled {
+		return
+	}
+
+	if len(kafka.Brokers) == 0 {
+		sl.ReportError(kafka.Brokers, "Brokers", "Brokers", "required", "")
+	}
+	if kafka.Topic == "" {
+		sl.ReportError(kafka.Topic, "Topic", "Topic", "required", "")
+	}
+	if _, err := template.New("kafka-key").Funcs(sprig.TxtFuncMap()).Parse(kafka.KeyTemplate); err != nil {
+		sl.ReportError(kafka.KeyTemplate, "KeyTemplate", "KeyTemplate", invalidSinkTag, fmt.Sprintf(" is not a valid template: %s", err.Error()))
+	}
+	if kafka.SASL.Enabled {
+		if kafka.SASL.Username == "" {
+			sl.ReportError(kafka.SASL.Username, "Username", "SASL.Username", "required", "")
+		}
+		if kafka.SASL.Password == "" {
+			sl.ReportError(kafka.SASL.Password, "Password", "SASL.Password", "required", "")
+		}
+	}
+	validateSinkTLS(sl, kafka.TLS)
+}
+
+func validateSinkTLS(sl validator.StructLevel, tls SinkTLS) {
+	if (tls.CertFile == "") != (tls.KeyFile == "") {
+		sl.ReportError(tls.CertFile, "CertFile", "TLS.CertFile", invalidSinkTag, " and KeyFile must be specified together")
+	}
+}
+
 func cloudSlackValidator(sl validator.StructLevel) {
 	slack, ok := sl.Current().Interface().(CloudSlack)
 
@@ -289,6 +456,42 @@ func sourceStructValidator(sl validator.StructLevel) {
 	}
 
 	validatePlugins(sl, sources.Plugins)
+	validateSourcePluginsRBAC(sl, sources.Plugins)
+}
+
+func incidentGroupingValidator(sl validator.StructLevel) {
+	incidents, ok := sl.Current().Interface().(IncidentGrouping)
+	if !ok || !incidents.Enabled {
+		return
+	}
+
+	if _, err := incidents.ParseKey(); err != nil {
+		msg := fmt.Sprintf(" is not a valid template: %s", err.Error())
+		sl.ReportError(incidents.Key, "Key", "Key", invalidIncidentGroupingTag, msg)
+	}
+	if _, err := incidents.ParseResolveCondition(); err != nil {
+		msg := fmt.Sprintf(" is not a valid template: %s", err.Error())
+		sl.ReportError(incidents.ResolveWhen, "ResolveWhen", "ResolveWhen", invalidIncidentGroupingTag, msg)
+	}
+}
+
+// validateSourcePluginsRBAC ensures that source plugins don't use user based RBAC, as events are not produced by any user.
+func validateSourcePluginsRBAC(sl validator.StructLevel, plugins Plugins) {
+	for _, pluginKey := range maputil.SortKeys(plugins) {
+		rbac := plugins[pluginKey].Context.RBAC
+		if !plugins[pluginKey].Enabled || rbac == nil {
+			continue
+		}
+
+		for _, subjectType := range []PolicySubjectType{rbac.User.Type, rbac.Group.Type} {
+			if !subjectType.IsUserBased() {
+				continue
+			}
+			msg := fmt.Sprintf("uses the %q RBAC policy subject type, which is not supported for sources.", subjectType)
+			sl.ReportError(pluginKey, "", pluginKey, invalidPluginDefinitionTag, msg)
+			break
+		}
+	}
 }
 
 func executorStructValidator(sl validator.StructLevel) {
@@ -313,6 +516,47 @@ func botBindingsStructValidator(sl validator.StructLevel) {
 	validateExecutorBindings(sl, conf.Executors, bindings.Executors)
 }
 
+func actionStructValidator(sl validator.StructLevel) {
+	action, ok := sl.Current().Interface().(Action)
+	if !ok {
+		return
+	}
+
+	switch {
+	case action.Command != "" && len(action.Steps) > 0:
+		sl.ReportError(action.Steps, "Steps", "Steps", invalidActionTag, " cannot be used together with Command")
+	case action.Enabled && action.Command == "" && len(action.Steps) == 0:
+		sl.ReportError(action.Command, "Command", "Command", "required", "")
+	}
+
+	if _, err := template.New("action-dedup-key").Funcs(sprig.TxtFuncMap()).Parse(action.DedupKey); err != nil {
+		sl.ReportError(action.DedupKey, "DedupKey", "DedupKey", invalidActionTag, fmt.Sprintf(" is not a valid template: %s", err.Error()))
+	}
+
+	names := make(map[string]struct{})
+	for idx, step := range action.Steps {
+		field := fmt.Sprintf("Steps[%d]", idx)
+		if _, exists := names[step.Name]; exists && step.Name != "" {
+			sl.ReportError(step.Name, field+".Name", field+".Name", invalidActionTag, fmt.Sprintf(" %q is not unique", step.Name))
+		}
+		names[step.Name] = struct{}{}
+
+		templates := []struct {
+			name, value string
+		}{
+			{name: "When", value: step.When},
+			{name: "Command", value: step.Command},
+		}
+		for _, tpl := range templates {
+			if _, err := template.New("action-step").Funcs(sprig.TxtFuncMap()).Parse(tpl.value); err != nil {
+				msg := fmt.Sprintf(" is not a valid template: %s", err.Error())
+				fieldName := fmt.Sprintf("%s.%s", field, tpl.name)
+				sl.ReportError(tpl.value, fieldName, fieldName, invalidActionTag, msg)
+			}
+		}
+	}
+}
+
 func actionBindingsStructValidator(sl validator.StructLevel) {
 	bindings, ok := sl.Current().Interface().(ActionBindings)
 	if !ok {
@@ -324,7 +568,94 @@ func actionBindingsStructValidator(sl validator.StructLevel) {
 	}
 	validateSourceBindings(sl, conf.Sources, bindings.Sources)
 	validateExecutorBindings(sl, conf.Executors, bindings.Executors)
-	validateActionExecutors(sl, conf.Executors, bindings.Executors)
+	validateAutomationExecutors(sl, conf.Executors, bindings.Executors, invalidActionRBACTag)
+}
+
+func scheduleStructValidator(sl validator.StructLevel) {
+	schedule, ok := sl.Current().Interface().(Schedule)
+	if !ok {
+		return
+	}
+
+	if schedule.Enabled && len(schedule.Bindings.Channels) == 0 {
+		sl.ReportError(schedule.Bindings.Channels, "Channels", "Bindings.Channels", "required", "")
+	}
+
+	if schedule.Cron == "" {
+		// validated on struct level, no need to report two errors
+		return
+	}
+	if _, err := schedule.CronSchedule(); err != nil {
+		msg := fmt.Sprintf(" is not a valid cron expression: %s", err.Error())
+		sl.ReportError(schedule.Cron, "Cron", "Cron", invalidScheduleCronTag, msg)
+	}
+}
+
+func scheduleBindingsStructValidator(sl validator.StructLevel) {
+	bindings, ok := sl.Current().Interface().(ScheduleBindings)
+	if !ok {
+		return
+	}
+	conf, ok := sl.Top().Interface().(Config)
+	if !ok {
+		return
+	}
+	validateExecutorBindings(sl, conf.Executors, bindings.Executors)
+	validateAutomationExecutors(sl, conf.Executors, bindings.Executors, invalidScheduleRBACTag)
+
+	refs, known := channelRefs(conf.Communications)
+	if !known {
+		return
+	}
+	for _, channel := range bindings.Channels {
+		if _, found := refs[strings.TrimPrefix(channel, "#")]; !found {
+			sl.ReportError(bindings.Channels, channel, channel, invalidBindingTag, "Config.Communications")
+		}
+	}
+}
+
+// channelRefs returns aliases, names and IDs of channels from enabled communication platforms.
+// Returns false if channels are not known upfront, e.g. for the legacy MS Teams, which discovers conversations at runtime.
+func channelRefs(comms map[string]Communications) (map[string]struct{}, bool) {
+	out := map[string]struct{}{}
+	addChannels := func(alias, identifier string) {
+		out[alias] = struct{}{}
+		out[strings.TrimPrefix(identifier, "#")] = struct{}{}
+	}
+
+	for _, comm := range comms {
+		if comm.Teams.Enabled {
+			return nil, false
+		}
+		for _, channels := range []IdentifiableMap[ChannelBindingsByName]{
+			enabledChannels(comm.Slack.Enabled, comm.Slack.Channels),
+			enabledChannels(comm.SocketSlack.Enabled, comm.SocketSlack.Channels),
+			enabledChannels(comm.CloudSlack.Enabled, comm.CloudSlack.Channels),
+			enabledChannels(comm.Mattermost.Enabled, comm.Mattermost.Channels),
+		} {
+			for alias, channel := range channels {
+				addChannels(alias, channel.Identifier())
+			}
+		}
+		for alias, channel := range enabledChannels(comm.Discord.Enabled, comm.Discord.Channels) {
+			addChannels(alias, channel.Identifier())
+		}
+		if comm.CloudTeams.Enabled {
+			for _, team := range comm.CloudTeams.Teams {
+				for alias, channel := range team.Channels {
+					addChannels(alias, channel.Identifier())
+				}
+			}
+		}
+	}
+	return out, true
+}
+
+func enabledChannels[T Identifiable](enabled bool, channels IdentifiableMap[T]) IdentifiableMap[T] {
+	if !enabled {
+		return nil
+	}
+	return channels
 }
 
 func aliasesStructValidator(sl validator.StructLevel) {
@@ -366,6 +697,31 @@ func aliasesStructValidator(sl validator.StructLevel) {
 	sl.ReportError(alias.Command, cmdPrefix, "Command", invalidAliasCommandTag, "")
 }
 
+func channelRoutesStructValidator(sl validator.StructLevel) {
+	channel, ok := sl.Current().Interface().(interface {
+		GetBotBindings() BotBindings
+		GetRoutes() []ChannelRoute
+	})
+	if !ok {
+		return
+	}
+
+	sources := channel.GetBotBindings().Sources
+	for idx, route := range channel.GetRoutes() {
+		for _, source := range route.Sources {
+			if !slices.Contains(sources, source) {
+				sl.ReportError(route.Sources, source, source, invalidRouteSourceTag, "")
+			}
+		}
+
+		if _, err := route.ParseCondition(); err != nil {
+			msg := fmt.Sprintf(" is not a valid template: %s", err.Error())
+			field := fmt.Sprintf("Routes[%d].When", idx)
+			sl.ReportError(route.When, field, field, invalidRouteConditionTag, msg)
+		}
+	}
+}
+
 func sinkBindingsStructValidator(sl validator.StructLevel) {
 	bindings, ok := sl.Current().Interface().(SinkBindings)
 	if !ok {
@@ -440,7 +796,9 @@ func validatePluginRBAC[P pluginProvider](sl validator.StructLevel, pluginConfig
 	}
 }
 
-func validateActionExecutors(sl validator.StructLevel, executors map[string]Executors, bindings []string) {
+// validateAutomationExecutors ensures that executors used by automations, such as actions or schedules, don't use user-based RBAC,
+// as there is no user who runs the command.
+func validateAutomationExecutors(sl validator.StructLevel, executors map[string]Executors, bindings []string, tag string) {
 	for _, executor := range bindings {
 		execConf := executors[executor]
 		for pluginKey, plugin := range execConf.Plugins {
@@ -450,8 +808,15 @@ func validateActionExecutors(sl validator.StructLevel, executors map[string]Exec
 			if plugin.Context.RBAC == nil {
 				continue
 			}
-			if plugin.Context.RBAC.Group.Type == ChannelNamePolicySubjectType {
-				sl.ReportError(bindings, pluginKey, executor, invalidActionRBACTag, "")
+			rbac := plugin.Context.RBAC
+			switch {
+			case rbac.Group.Type == ChannelNamePolicySubjectType:
+				sl.ReportError(bindings, pluginKey, executor, tag, string(ChannelNamePolicySubjectType))
+			case rbac.User.Type.IsUserBased():
+				// automations are not executed by any user
+				sl.ReportError(bindings, pluginKey, executor, tag, string(rbac.User.Type))
+			case rbac.Group.Type.IsUserBased():
+				sl.ReportError(bindings, pluginKey, executor, tag, string(rbac.Group.Type))
 			}
 		}
 	}
@@ -0,0 +1,455 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/slack-go/slack"
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/formatx"
+)
+
+var _ CommandExecutor = &ApprovalExecutor{}
+
+const (
+	noPendingRequestsMsg = "No pending approval requests."
+	approvalNotPossible  = "Command %q requires approval, which is not supported for commands run by automated actions or schedules."
+	approvalIDLength     = 8
+	approvalTimeFormat   = "2006-01-02 15:04:05 MST"
+)
+
+var approvalFeatureName = FeatureName{
+	Name:    "requests",
+	Aliases: []string{"request", "req"},
+}
+
+// approvalPluginExecutor executes plugin commands once they are approved.
+type approvalPluginExecutor interface {
+	Execute(ctx context.Context, bindings []string, slackState *slack.BlockActionStates, cmdCtx CommandContext) (interactive.CoreMessage, error)
+}
+
+// approvalRequest holds a command which waits for approval.
+type approvalRequest struct {
+	ID          string
+	PluginName  string
+	CmdCtx      CommandContext
+	Bindings    []string
+	SlackState  *slack.BlockActionStates
+	RequestedAt time.Time
+}
+
+// ApprovalExecutor intercepts commands which require approval and executes them once approved.
+// Pending requests are stored in memory, so they don't survive Botkube restarts.
+type ApprovalExecutor struct {
+	log            logrus.FieldLogger
+	cfg            config.CommandApprovals
+	pluginExecutor approvalPluginExecutor
+	auditReporter  audit.AuditReporter
+
+	now   func() time.Time
+	newID func() string
+
+	mu      sync.Mutex
+	pending map[string]approvalRequest
+}
+
+// NewApprovalExecutor returns a new ApprovalExecutor instance.
+func NewApprovalExecutor(log logrus.FieldLogger, cfg config.Config, pluginExecutor approvalPluginExecutor, auditReporter audit.AuditReporter) *ApprovalExecutor {
+	return &ApprovalExecutor{
+		log:            log,
+		cfg:            cfg.CommandApprovals,
+		pluginExecutor: pluginExecutor,
+		auditReporter:  auditReporter,
+		now:            time.Now,
+		newID: func() string {
+			return uuid.NewString()[:approvalIDLength]
+		},
+		pending: map[string]approvalRequest{},
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *ApprovalExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ApproveVerb: e.Approve,
+		command.RejectVerb:  e.Reject,
+		command.ListVerb:    e.List,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *ApprovalExecutor) FeatureName() FeatureName {
+	return approvalFeatureName
+}
+
+// RequiresApproval returns true if a given plugin command matches one of the configured approval rules.
+// Only the command verb is matched, see commandVerbCandidates for details.
+func (e *ApprovalExecutor) RequiresApproval(cmdCtx CommandContext) (bool, error) {
+	if !e.cfg.Enabled || len(cmdCtx.Args) == 0 {
+		return false, nil
+	}
+
+	pluginName := cmdCtx.Args[0]
+	for _, rule := range e.cfg.Rules {
+		matched, err := matchesAny(rule.Plugins, pluginName)
+		if err != nil {
+			return false, fmt.Errorf("while matching plugins for %q rule: %w", rule.Name, err)
+		}
+		if !matched {
+			continue
+		}
+
+		if len(rule.Verbs) == 0 {
+			return true, nil
+		}
+		for _, verb := range commandVerbCandidates(cmdCtx.Args[1:]) {
+			matched, err := matchesAny(rule.Verbs, verb)
+			if err != nil {
+				return false, fmt.Errorf("while matching verbs for %q rule: %w", rule.Name, err)
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// RequestApproval stores a given command as pending and returns a message with Approve and Reject buttons.
+// Commands run by automated actions or schedules cannot be approved, as nobody can respond to their requests.
+func (e *ApprovalExecutor) RequestApproval(ctx context.Context, bindings []string, slackState *slack.BlockActionStates, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	switch cmdCtx.Conversation.CommandOrigin {
+	case command.AutomationOrigin, command.ScheduleOrigin:
+		return interactive.CoreMessage{}, NewExecutionCommandError(approvalNotPossible, cmdCtx.CleanCmd)
+	}
+
+	req := approvalRequest{
+		ID:          e.newID(),
+		PluginName:  cmdCtx.Args[0],
+		CmdCtx:      cmdCtx,
+		Bindings:    bindings,
+		SlackState:  slackState,
+		RequestedAt: e.now(),
+	}
+
+	e.mu.Lock()
+	e.pending[req.ID] = req
+	e.mu.Unlock()
+
+	e.log.WithFields(logrus.Fields{
+		"id":      req.ID,
+		"command": cmdCtx.CleanCmd,
+	}).Info("Command requires approval. Waiting for approver...")
+	e.reportAuditEvent(ctx, req, cmdCtx.User, "approval requested")
+
+	details := formatx.Table{
+		Rows: [][]string{
+			{"Request ID:", req.ID},
+			{"Requester:", userName(cmdCtx.User)},
+			{"Cluster:", cmdCtx.ClusterName},
+			{"Command:", cmdCtx.CleanCmd},
+		},
+	}
+	if e.cfg.Timeout > 0 {
+		details.Rows = append(details.Rows, []string{"Expires at:", req.RequestedAt.Add(e.cfg.Timeout).UTC().Format(approvalTimeFormat)})
+	}
+
+	msg := interactive.CoreMessage{
+		Description: header(cmdCtx),
+		Message: api.Message{
+			BaseBody: api.Body{
+				Plaintext: "This command requires approval. It will be executed once an authorized approver approves it.",
+			},
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Body: api.Body{
+							CodeBlock: details.Render(),
+						},
+					},
+				},
+			},
+		},
+	}
+	if cmdCtx.Platform.IsInteractive() {
+		btnBuilder := api.NewMessageButtonBuilder()
+		msg.Sections[0].Buttons = api.Buttons{
+			btnBuilder.ForCommandWithoutDesc("Approve", fmt.Sprintf("%s request %s", command.ApproveVerb, req.ID), api.ButtonStylePrimary),
+			btnBuilder.ForCommandWithoutDesc("Reject", fmt.Sprintf("%s request %s", command.RejectVerb, req.ID), api.ButtonStyleDanger),
+		}
+	} else {
+		msg.Sections[0].Context = api.ContextItems{
+			{Text: fmt.Sprintf("To approve, run: %s request %s", command.ApproveVerb, req.ID)},
+		}
+	}
+	return msg, nil
+}
+
+// Approve executes a pending command if the user is an authorized approver.
+func (e *ApprovalExecutor) Approve(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	req, err := e.getPendingRequest(ctx, cmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	if cmdCtx.User.ID == "" {
+		return interactive.CoreMessage{}, NewExecutionCommandError("Cannot verify your identity, the approval was rejected.")
+	}
+	if isSameUser(cmdCtx.User, req.CmdCtx.User) {
+		return interactive.CoreMessage{}, NewExecutionCommandError("You cannot approve your own request.")
+	}
+	if !e.isApprover(cmdCtx.User, cmdCtx.Conversation.Approvers) {
+		return interactive.CoreMessage{}, NewExecutionCommandError("You are not allowed to approve commands in this channel.")
+	}
+
+	if !e.remove(req.ID) {
+		// already handled by someone else in the meantime
+		return interactive.CoreMessage{}, NewExecutionCommandError("Request %q not found.", req.ID)
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"id":       req.ID,
+		"approver": userName(cmdCtx.User),
+	}).Info("Command approved. Executing...")
+	e.reportAuditEvent(ctx, req, cmdCtx.User, fmt.Sprintf("approved by %s", userName(cmdCtx.User)))
+
+	// the approval is the confirmation of the command, so executors don't ask for it again, e.g. with a dry-run preview
+	approvedCmdCtx := req.CmdCtx
+	approvedCmdCtx.IsApproved = true
+	out, err := e.pluginExecutor.Execute(ctx, req.Bindings, req.SlackState, approvedCmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while executing approved command %q: %w", req.CmdCtx.CleanCmd, err)
+	}
+	out.Description = fmt.Sprintf("%s (approved by %s)", header(req.CmdCtx), userName(cmdCtx.User))
+	return out, nil
+}
+
+// Reject removes a pending command. Commands can be rejected by an authorized approver or by the requester.
+func (e *ApprovalExecutor) Reject(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	req, err := e.getPendingRequest(ctx, cmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	if !isSameUser(cmdCtx.User, req.CmdCtx.User) && !e.isApprover(cmdCtx.User, cmdCtx.Conversation.Approvers) {
+		return interactive.CoreMessage{}, NewExecutionCommandError("You are not allowed to reject commands in this channel.")
+	}
+
+	if !e.remove(req.ID) {
+		return interactive.CoreMessage{}, NewExecutionCommandError("Request %q not found.", req.ID)
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"id":   req.ID,
+		"user": userName(cmdCtx.User),
+	}).Info("Command rejected.")
+	e.reportAuditEvent(ctx, req, cmdCtx.User, fmt.Sprintf("rejected by %s", userName(cmdCtx.User)))
+
+	return respond(fmt.Sprintf("Request %q to run %q was rejected by %s.", req.ID, req.CmdCtx.CleanCmd, userName(cmdCtx.User)), cmdCtx), nil
+}
+
+// List returns a tabular representation of pending requests for a given conversation.
+func (e *ApprovalExecutor) List(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.log.Debug("Listing approval requests...")
+	e.removeExpired(ctx)
+
+	e.mu.Lock()
+	var reqs []approvalRequest
+	for _, req := range e.pending {
+		if req.CmdCtx.Conversation.ID != cmdCtx.Conversation.ID {
+			continue
+		}
+		reqs = append(reqs, req)
+	}
+	e.mu.Unlock()
+
+	if len(reqs) == 0 {
+		return respond(noPendingRequestsMsg, cmdCtx), nil
+	}
+
+	sort.Slice(reqs, func(i, j int) bool {
+		return reqs[i].RequestedAt.Before(reqs[j].RequestedAt)
+	})
+
+	table := formatx.Table{
+		Headers: []string{"ID", "REQUESTED AT", "REQUESTER", "COMMAND"},
+	}
+	for _, req := range reqs {
+		table.Rows = append(table.Rows, []string{
+			req.ID,
+			req.RequestedAt.UTC().Format(approvalTimeFormat),
+			userName(req.CmdCtx.User),
+			req.CmdCtx.CleanCmd,
+		})
+	}
+	return respond(table.Render(), cmdCtx), nil
+}
+
+func (e *ApprovalExecutor) getPendingRequest(ctx context.Context, cmdCtx CommandContext) (approvalRequest, error) {
+	if len(cmdCtx.Args) != 3 {
+		return approvalRequest{}, errInvalidCommand
+	}
+	id := cmdCtx.Args[2]
+
+	e.removeExpired(ctx)
+
+	e.mu.Lock()
+	req, found := e.pending[id]
+	e.mu.Unlock()
+
+	// requests are visible only in the conversation they were created in
+	if !found || req.CmdCtx.Conversation.ID != cmdCtx.Conversation.ID {
+		return approvalRequest{}, NewExecutionCommandError("Request %q not found. It might have been already handled or expired.", id)
+	}
+	return req, nil
+}
+
+func (e *ApprovalExecutor) remove(id string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	_, found := e.pending[id]
+	delete(e.pending, id)
+	return found
+}
+
+func (e *ApprovalExecutor) removeExpired(ctx context.Context) {
+	if e.cfg.Timeout <= 0 {
+		return
+	}
+
+	now := e.now()
+	var expired []approvalRequest
+
+	e.mu.Lock()
+	for id, req := range e.pending {
+		if now.Sub(req.RequestedAt) < e.cfg.Timeout {
+			continue
+		}
+		expired = append(expired, req)
+		delete(e.pending, id)
+	}
+	e.mu.Unlock()
+
+	for _, req := range expired {
+		e.log.WithField("id", req.ID).Info("Approval request expired.")
+		e.reportAuditEvent(ctx, req, req.CmdCtx.User, "expired")
+	}
+}
+
+// isApprover returns true if a given user is listed in channel approvers.
+// If the channel doesn't define its own approvers, the global ones are used.
+func (e *ApprovalExecutor) isApprover(user UserInput, channelApprovers config.CommandApprovers) bool {
+	approvers := channelApprovers
+	if approvers.IsEmpty() {
+		approvers = e.cfg.Approvers
+	}
+
+	users := slices.Clone(approvers.Users)
+	for _, group := range approvers.Groups {
+		users = append(users, e.cfg.Groups[group]...)
+	}
+
+	for _, name := range users {
+		if isUserMatching(user, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ApprovalExecutor) reportAuditEvent(ctx context.Context, req approvalRequest, user UserInput, status string) {
+	channelName := req.CmdCtx.Conversation.ID
+	if req.CmdCtx.Conversation.DisplayName != "" {
+		channelName = req.CmdCtx.Conversation.DisplayName
+	}
+
+	event := audit.ExecutorAuditEvent{
+		PlatformUser: userName(user),
+		CreatedAt:    e.now().Format(time.RFC3339),
+		PluginName:   req.PluginName,
+		Channel:      channelName,
+		Command:      fmt.Sprintf("%s (request %s %s)", req.CmdCtx.ExpandedRawCmd, req.ID, status),
+		BotPlatform:  remoteapi.NewBotPlatform(req.CmdCtx.Platform.String()),
+	}
+	if err := e.auditReporter.ReportExecutorAuditEvent(ctx, event); err != nil {
+		e.log.Errorf("while reporting approval audit event for request %q: %s", req.ID, err.Error())
+	}
+}
+
+// isUserMatching returns true if a given name is the user ID. Names configured as mentions are matched without
+// platform specific decorations, e.g. `<@U0123>` matches the `U0123` Slack user ID.
+// Display names are not matched, as they are not unique and can be changed by users.
+func isUserMatching(user UserInput, name string) bool {
+	name = strings.Trim(strings.TrimSpace(name), "<@>")
+	if name == "" || user.ID == "" {
+		return false
+	}
+	return name == user.ID
+}
+
+// isSameUser returns true if both users have the same ID.
+func isSameUser(a, b UserInput) bool {
+	return a.ID != "" && a.ID == b.ID
+}
+
+func userName(user UserInput) string {
+	switch {
+	case user.DisplayName != "":
+		return user.DisplayName
+	case user.Mention != "":
+		return user.Mention
+	default:
+		return "unknown user"
+	}
+}
+
+// commandVerbCandidates returns the arguments which can be the verb of a given plugin command, which is the first
+// positional argument. Flags placed before the verb may take a value as a separate argument, so in such case both
+// the argument following the flag and the next positional argument are returned, to not let flags bypass the approval.
+func commandVerbCandidates(args []string) []string {
+	var candidates []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			return append(candidates, arg)
+		}
+		if strings.Contains(arg, "=") || i+1 >= len(args) {
+			continue
+		}
+		i++
+		candidates = append(candidates, args[i])
+	}
+	return candidates
+}
+
+// matchesAny returns true if a given value is equal to, or fully matches, one of the given patterns.
+func matchesAny(patterns []string, value string) (bool, error) {
+	for _, pattern := range patterns {
+		if pattern == value {
+			return true, nil
+		}
+		matched, err := regexp.MatchString(fmt.Sprintf("^(?:%s)$", pattern), value)
+		if err != nil {
+			return false, fmt.Errorf("while matching %q with regex %q: %w", value, pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,371 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var fixApprovalNow = time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC)
+
+func TestApprovalExecutorRequiresApproval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{
+			name:     "matching verb",
+			args:     []string{"kubectl", "delete", "pod", "nginx"},
+			expected: true,
+		},
+		{
+			name:     "matching verb after flags",
+			args:     []string{"kubectl", "-n", "default", "delete", "pod", "nginx"},
+			expected: true,
+		},
+		{
+			name:     "matching verb after boolean flag",
+			args:     []string{"kubectl", "--insecure-skip-tls-verify", "delete", "pod", "nginx"},
+			expected: true,
+		},
+		{
+			name:     "matching verb after flag with inline value",
+			args:     []string{"kubectl", "--namespace=default", "delete", "pod", "nginx"},
+			expected: true,
+		},
+		{
+			name:     "verb matched by regex",
+			args:     []string{"helm", "rollback", "api", "1"},
+			expected: true,
+		},
+		{
+			name:     "rule without verbs",
+			args:     []string{"flux", "get", "sources"},
+			expected: true,
+		},
+		{
+			name:     "not matching verb",
+			args:     []string{"kubectl", "get", "pod", "delete-me"},
+			expected: false,
+		},
+		{
+			name:     "matching verb used as flag value",
+			args:     []string{"kubectl", "get", "pods", "-n", "drain"},
+			expected: false,
+		},
+		{
+			name:     "matching verb used as resource name",
+			args:     []string{"kubectl", "get", "configmap", "delete"},
+			expected: false,
+		},
+		{
+			name:     "not matching plugin",
+			args:     []string{"echo", "delete"},
+			expected: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e, _, _ := newTestApprovalExecutor()
+
+			// when
+			out, err := e.RequiresApproval(CommandContext{Args: tc.args})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
+
+func TestApprovalExecutorApprove(t *testing.T) {
+	// given
+	ctx := context.Background()
+	e, pluginExec, auditReporter := newTestApprovalExecutor()
+
+	reqMsg, err := e.RequestApproval(ctx, []string{"k8s-admin"}, nil, fixApprovalCmdCtx("kubectl delete pod nginx", fixRequester))
+	require.NoError(t, err)
+	require.Len(t, reqMsg.Sections, 1)
+	assert.Equal(t, api.Buttons{
+		api.NewMessageButtonBuilder().ForCommandWithoutDesc("Approve", "approve request req-1", api.ButtonStylePrimary),
+		api.NewMessageButtonBuilder().ForCommandWithoutDesc("Reject", "reject request req-1", api.ButtonStyleDanger),
+	}, reqMsg.Sections[0].Buttons)
+	assert.Contains(t, reqMsg.Sections[0].Body.CodeBlock, "kubectl delete pod nginx")
+
+	// when
+	_, err = e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", fixRequester))
+
+	// then
+	assert.EqualError(t, err, "You cannot approve your own request.")
+	assert.Empty(t, pluginExec.executed)
+
+	// when
+	_, err = e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", UserInput{Mention: "<@U999>", DisplayName: "Mallory", ID: "U999"}))
+
+	// then
+	assert.EqualError(t, err, "You are not allowed to approve commands in this channel.")
+	assert.Empty(t, pluginExec.executed)
+
+	// when a different user uses the approver display name
+	_, err = e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", UserInput{Mention: "<@U998>", DisplayName: fixApprover.DisplayName, ID: "U998"}))
+
+	// then
+	assert.EqualError(t, err, "You are not allowed to approve commands in this channel.")
+	assert.Empty(t, pluginExec.executed)
+
+	// when the user ID is not available
+	_, err = e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", UserInput{Mention: fixApprover.Mention, DisplayName: fixApprover.DisplayName}))
+
+	// then
+	assert.EqualError(t, err, "Cannot verify your identity, the approval was rejected.")
+	assert.Empty(t, pluginExec.executed)
+
+	// when
+	out, err := e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", fixApprover))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "executed", out.BaseBody.CodeBlock)
+	assert.Equal(t, "`kubectl delete pod nginx` on `dev` (approved by Bob)", out.Description)
+	require.Len(t, pluginExec.executed, 1)
+	assert.Equal(t, "kubectl delete pod nginx", pluginExec.executed[0].CleanCmd)
+	assert.Equal(t, fixRequester, pluginExec.executed[0].User)
+	assert.True(t, pluginExec.executed[0].IsApproved)
+	assert.Equal(t, []string{"k8s-admin"}, pluginExec.bindings)
+
+	require.Len(t, auditReporter.events, 2)
+	assert.Equal(t, "kubectl delete pod nginx (request req-1 approval requested)", auditReporter.events[0].Command)
+	assert.Equal(t, "Alice", auditReporter.events[0].PlatformUser)
+	assert.Equal(t, "kubectl delete pod nginx (request req-1 approved by Bob)", auditReporter.events[1].Command)
+	assert.Equal(t, "Bob", auditReporter.events[1].PlatformUser)
+
+	// when
+	_, err = e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", fixApprover))
+
+	// then
+	assert.EqualError(t, err, `Request "req-1" not found. It might have been already handled or expired.`)
+	assert.Len(t, pluginExec.executed, 1)
+}
+
+func TestApprovalExecutorRequestApprovalUnsupportedOrigins(t *testing.T) {
+	for _, origin := range []command.Origin{command.AutomationOrigin, command.ScheduleOrigin} {
+		t.Run(string(origin), func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			e, pluginExec, auditReporter := newTestApprovalExecutor()
+			cmdCtx := fixApprovalCmdCtx("kubectl delete pod nginx", fixRequester)
+			cmdCtx.Conversation = Conversation{ID: "n/a", CommandOrigin: origin}
+
+			// when
+			_, err := e.RequestApproval(ctx, nil, nil, cmdCtx)
+
+			// then
+			require.Error(t, err)
+			assert.True(t, IsExecutionCommandError(err))
+			assert.EqualError(t, err, `Command "kubectl delete pod nginx" requires approval, which is not supported for commands run by automated actions or schedules.`)
+			assert.Empty(t, e.pending)
+			assert.Empty(t, pluginExec.executed)
+			assert.Empty(t, auditReporter.events)
+		})
+	}
+}
+
+func TestApprovalExecutorReject(t *testing.T) {
+	// given
+	ctx := context.Background()
+	e, pluginExec, _ := newTestApprovalExecutor()
+	_, err := e.RequestApproval(ctx, nil, nil, fixApprovalCmdCtx("kubectl delete pod nginx", fixRequester))
+	require.NoError(t, err)
+
+	// when
+	out, err := e.Reject(ctx, fixApprovalCmdCtx("reject request req-1", fixRequester))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `Request "req-1" to run "kubectl delete pod nginx" was rejected by Alice.`, out.BaseBody.CodeBlock)
+	assert.Empty(t, pluginExec.executed)
+
+	// when
+	out, err = e.List(ctx, fixApprovalCmdCtx("list requests", fixApprover))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, noPendingRequestsMsg, out.BaseBody.CodeBlock)
+}
+
+func TestApprovalExecutorTimeout(t *testing.T) {
+	// given
+	ctx := context.Background()
+	e, pluginExec, auditReporter := newTestApprovalExecutor()
+	for _, cmd := range []string{"kubectl delete pod nginx", "helm uninstall api"} {
+		_, err := e.RequestApproval(ctx, nil, nil, fixApprovalCmdCtx(cmd, fixRequester))
+		require.NoError(t, err)
+	}
+
+	// when
+	out, err := e.List(ctx, fixApprovalCmdCtx("list requests", fixApprover))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "ID    REQUESTED AT            REQUESTER COMMAND\nreq-1 2023-05-10 12:00:00 UTC Alice     kubectl delete pod nginx\nreq-2 2023-05-10 12:00:00 UTC Alice     helm uninstall api", out.BaseBody.CodeBlock)
+
+	// when
+	e.now = func() time.Time { return fixApprovalNow.Add(time.Hour) }
+	_, err = e.Approve(ctx, fixApprovalCmdCtx("approve request req-1", fixApprover))
+
+	// then
+	assert.EqualError(t, err, `Request "req-1" not found. It might have been already handled or expired.`)
+	assert.Empty(t, pluginExec.executed)
+	require.Len(t, auditReporter.events, 4)
+	assert.Contains(t, auditReporter.events[2].Command, "expired")
+	assert.Contains(t, auditReporter.events[3].Command, "expired")
+}
+
+func TestApprovalExecutorIsApprover(t *testing.T) {
+	// given
+	e, _, _ := newTestApprovalExecutor()
+
+	testCases := []struct {
+		name      string
+		user      UserInput
+		approvers config.CommandApprovers
+		expected  bool
+	}{
+		{
+			name:     "global approver by user ID",
+			user:     fixApprover,
+			expected: true,
+		},
+		{
+			name:     "global approver from group by mention",
+			user:     UserInput{Mention: "<@U003>", ID: "U003"},
+			expected: true,
+		},
+		{
+			name:     "not an approver",
+			user:     fixRequester,
+			expected: false,
+		},
+		{
+			name:     "approver display name with a different ID",
+			user:     UserInput{Mention: "<@U999>", DisplayName: "Bob", ID: "U999"},
+			expected: false,
+		},
+		{
+			name:     "approver without ID",
+			user:     UserInput{Mention: "<@U002>", DisplayName: "Bob"},
+			expected: false,
+		},
+		{
+			name:      "channel approvers override global ones",
+			user:      fixApprover,
+			approvers: config.CommandApprovers{Users: []string{"U001"}},
+			expected:  false,
+		},
+		{
+			name:      "channel approver",
+			user:      fixRequester,
+			approvers: config.CommandApprovers{Users: []string{"U001"}},
+			expected:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, e.isApprover(tc.user, tc.approvers))
+		})
+	}
+}
+
+var (
+	fixRequester = UserInput{Mention: "<@U001>", DisplayName: "Alice", ID: "U001"}
+	fixApprover  = UserInput{Mention: "<@U002>", DisplayName: "Bob", ID: "U002"}
+)
+
+func newTestApprovalExecutor() (*ApprovalExecutor, *fakeApprovalPluginExecutor, *fakeAuditReporter) {
+	cfg := config.Config{
+		CommandApprovals: config.CommandApprovals{
+			Enabled: true,
+			Timeout: 15 * time.Minute,
+			Rules: []config.CommandApprovalRule{
+				{Name: "kubectl", Plugins: []string{"kubectl"}, Verbs: []string{"delete", "drain"}},
+				{Name: "helm", Plugins: []string{"helm"}, Verbs: []string{"uninstall|rollback"}},
+				{Name: "flux", Plugins: []string{"flux"}},
+			},
+			Groups: map[string][]string{
+				"sre": {"<@U003>"},
+			},
+			Approvers: config.CommandApprovers{
+				Users:  []string{"U002"},
+				Groups: []string{"sre"},
+			},
+		},
+	}
+	pluginExec := &fakeApprovalPluginExecutor{}
+	auditReporter := &fakeAuditReporter{}
+
+	e := NewApprovalExecutor(loggerx.NewNoop(), cfg, pluginExec, auditReporter)
+	e.now = func() time.Time { return fixApprovalNow }
+	var cnt int
+	e.newID = func() string {
+		cnt++
+		return fmt.Sprintf("req-%d", cnt)
+	}
+	return e, pluginExec, auditReporter
+}
+
+func fixApprovalCmdCtx(cmd string, user UserInput) CommandContext {
+	args, _ := ParseFlags(cmd)
+	return CommandContext{
+		ExpandedRawCmd: cmd,
+		CleanCmd:       cmd,
+		Args:           args.TokenizedCmd,
+		ClusterName:    "dev",
+		User:           user,
+		Conversation:   Conversation{ID: "C123", CommandOrigin: command.TypedOrigin},
+		Platform:       config.SocketSlackCommPlatformIntegration,
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+}
+
+type fakeApprovalPluginExecutor struct {
+	executed []CommandContext
+	bindings []string
+}
+
+func (f *fakeApprovalPluginExecutor) Execute(_ context.Context, bindings []string, _ *slack.BlockActionStates, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	f.executed = append(f.executed, cmdCtx)
+	f.bindings = bindings
+	return interactive.CoreMessage{
+		Description: header(cmdCtx),
+		Message: api.Message{
+			BaseBody: api.Body{CodeBlock: "executed"},
+		},
+	}, nil
+}
+
+type fakeAuditReporter struct {
+	events []audit.ExecutorAuditEvent
+}
+
+func (f *fakeAuditReporter) ReportExecutorAuditEvent(_ context.Context, e audit.ExecutorAuditEvent) error {
+	f.events = append(f.events, e)
+	return nil
+}
+
+func (f *fakeAuditReporter) ReportSourceAuditEvent(context.Context, audit.SourceAuditEvent) error {
+	return nil
+}
@@ -14,6 +14,9 @@ const (
 	EditVerb     Verb = "edit"
 	StatusVerb   Verb = "status"
 	ShowVerb     Verb = "show"
+	ApproveVerb  Verb = "approve"
+	RejectVerb   Verb = "reject"
+	RunVerb      Verb = "run"
 )
 
 func AllVerbs() []Verb {
@@ -28,5 +31,8 @@ func AllVerbs() []Verb {
 		EditVerb,
 		StatusVerb,
 		ShowVerb,
+		ApproveVerb,
+		RejectVerb,
+		RunVerb,
 	}
 }
@@ -62,7 +62,9 @@ func (e *ConfigExecutor) renderBotkubeConfiguration() (string, error) {
 
 	// hide sensitive info
 	// TODO: avoid printing sensitive data without need to resetting them manually (which is an error-prone approach)
-	for key, old := range cfg.Communications {
+	// copy the map, so the redacted values are not stored in the original configuration
+	cfg.Communications = make(map[string]config.Communications, len(e.cfg.Communications))
+	for key, old := range e.cfg.Communications {
 		old.Slack.Token = redactedSecretStr
 		old.SocketSlack.AppToken = redactedSecretStr
 		old.SocketSlack.BotToken = redactedSecretStr
@@ -70,6 +72,13 @@ func (e *ConfigExecutor) renderBotkubeConfiguration() (string, error) {
 		old.Discord.Token = redactedSecretStr
 		old.Mattermost.Token = redactedSecretStr
 		old.Teams.AppPassword = redactedSecretStr
+		old.Loki.Password = redactedSecretStr
+		old.Kafka.SASL.Password = redactedSecretStr
+		old.OTLPLogs.Headers = redactedMapValues(old.OTLPLogs.Headers)
+		old.Webhook.Auth.Token = redactedSecretStr
+		old.Webhook.Auth.Password = redactedSecretStr
+		old.Webhook.Signing.Secret = redactedSecretStr
+		old.Webhook.Headers = redactedMapValues(old.Webhook.Headers)
 
 		// maps are not addressable: https://stackoverflow.com/questions/42605337/cannot-assign-to-struct-field-in-a-map
 		cfg.Communications[key] = old
@@ -82,3 +91,15 @@ func (e *ConfigExecutor) renderBotkubeConfiguration() (string, error) {
 
 	return string(b), nil
 }
+
+// redactedMapValues returns a copy of a given map with all values redacted.
+func redactedMapValues(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for key := range in {
+		out[key] = redactedSecretStr
+	}
+	return out
+}
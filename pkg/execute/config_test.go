@@ -7,6 +7,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/pkg/config"
@@ -39,10 +40,17 @@ func TestConfigExecutorShowConfig(t *testing.T) {
 			},
 			ExpectedResult: heredoc.Doc(`
 						actions: {}
+						schedules: {}
 						sources: {}
 						executors: {}
 						aliases: {}
 						communications: {}
+						commandApprovals:
+						    enabled: false
+						    timeout: 0s
+						    rules: []
+						    groups: {}
+						    approvers: {}
 						analytics:
 						    disable: false
 						settings:
@@ -68,6 +76,19 @@ func TestConfigExecutorShowConfig(t *testing.T) {
 						    informersResyncPeriod: 0s
 						    kubeconfig: ""
 						    saCredentialsPathPrefix: ""
+						    eventHistory:
+						        enabled: false
+						        storage:
+						            type: ""
+						            bolt:
+						                path: ""
+						            configMap: {}
+						        retention:
+						            maxEvents: 0
+						            maxAge: 0s
+						    userMapping:
+						        static: {}
+						        configMap: {}
 						configWatcher:
 						    enabled: false
 						    remote:
@@ -98,3 +119,79 @@ func TestConfigExecutorShowConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigExecutorShowConfigRedactsSecrets(t *testing.T) {
+	// given
+	cfg := config.Config{
+		Communications: map[string]config.Communications{
+			"default-group": {
+				Webhook: config.Webhook{
+					Enabled: true,
+					URL:     "https://example.com/events",
+					Headers: map[string]string{
+						"X-Api-Key": "api-key",
+					},
+					Auth: config.WebhookAuth{
+						Type:     config.BasicWebhookAuth,
+						Username: "botkube",
+						Password: "password",
+						Token:    "token",
+					},
+					Signing: config.WebhookSigning{
+						Enabled: true,
+						Secret:  "signing-secret",
+					},
+				},
+				Loki: config.Loki{
+					Enabled:  true,
+					Username: "botkube",
+					Password: "loki-password",
+				},
+				OTLPLogs: config.OTLPLogs{
+					Enabled: true,
+					Headers: map[string]string{
+						"Authorization": "Bearer otlp-token",
+					},
+				},
+				Kafka: config.Kafka{
+					Enabled: true,
+					SASL: config.KafkaSASL{
+						Enabled:  true,
+						Username: "botkube",
+						Password: "kafka-password",
+					},
+				},
+			},
+		},
+	}
+	e := NewConfigExecutor(loggerx.NewNoop(), cfg)
+
+	// when
+	msg, err := e.Show(context.Background(), CommandContext{
+		Args:           []string{"config"},
+		ExecutorFilter: newExecutorTextFilter(""),
+	})
+
+	// then
+	require.NoError(t, err)
+	for _, secret := range []string{"api-key", "signing-secret", "loki-password", "otlp-token", "kafka-password"} {
+		assert.NotContains(t, msg.BaseBody.CodeBlock, secret)
+	}
+
+	var got config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(msg.BaseBody.CodeBlock), &got))
+	webhook := got.Communications["default-group"].Webhook
+	assert.Equal(t, "https://example.com/events", webhook.URL)
+	assert.Equal(t, "botkube", webhook.Auth.Username)
+	assert.Equal(t, redactedSecretStr, webhook.Auth.Password)
+	assert.Equal(t, redactedSecretStr, webhook.Auth.Token)
+	assert.Equal(t, redactedSecretStr, webhook.Signing.Secret)
+	assert.Equal(t, map[string]string{"X-Api-Key": redactedSecretStr}, webhook.Headers)
+	assert.Equal(t, redactedSecretStr, got.Communications["default-group"].Loki.Password)
+	assert.Equal(t, redactedSecretStr, got.Communications["default-group"].Kafka.SASL.Password)
+	assert.Equal(t, map[string]string{"Authorization": redactedSecretStr}, got.Communications["default-group"].OTLPLogs.Headers)
+
+	// the original configuration is not modified
+	assert.Equal(t, "api-key", cfg.Communications["default-group"].Webhook.Headers["X-Api-Key"])
+	assert.Equal(t, "password", cfg.Communications["default-group"].Webhook.Auth.Password)
+}
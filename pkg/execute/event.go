@@ -0,0 +1,187 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/internal/eventhistory"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/formatx"
+)
+
+var _ CommandExecutor = &EventExecutor{}
+
+const (
+	eventHistoryDisabledMsg = "Event history is disabled. To enable it, set the `settings.eventHistory.enabled` property to `true`."
+	noEventsFoundMsg        = "No events found for current conversation."
+	defaultEventsListLimit  = 20
+	eventTimeFormat         = "2006-01-02 15:04:05 MST"
+)
+
+var eventFeatureName = FeatureName{
+	Name:    "events",
+	Aliases: []string{"event", "ev"},
+}
+
+// EventExecutor executes all commands that are related to the event history.
+type EventExecutor struct {
+	log     logrus.FieldLogger
+	cfg     config.Config
+	history eventhistory.Store
+}
+
+// NewEventExecutor returns a new EventExecutor instance.
+func NewEventExecutor(log logrus.FieldLogger, cfg config.Config, history eventhistory.Store) *EventExecutor {
+	return &EventExecutor{
+		log:     log,
+		cfg:     cfg,
+		history: history,
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *EventExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ListVerb: e.List,
+		command.ShowVerb: e.Show,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *EventExecutor) FeatureName() FeatureName {
+	return eventFeatureName
+}
+
+// List returns a tabular representation of events from sources bound to a given conversation.
+func (e *EventExecutor) List(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.log.Debug("Listing events...")
+	if !e.cfg.Settings.EventHistory.Enabled {
+		return respond(eventHistoryDisabledMsg, cmdCtx), nil
+	}
+
+	var (
+		sources   []string
+		since     time.Duration
+		namespace string
+		limit     int
+	)
+	flags := pflag.NewFlagSet("list events", pflag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.StringSliceVar(&sources, "source", nil, "Source names")
+	flags.DurationVar(&since, "since", 0, "Only events newer than a given duration")
+	flags.StringVarP(&namespace, "namespace", "n", "", "Namespace")
+	flags.IntVar(&limit, "limit", defaultEventsListLimit, "Maximum number of events")
+	if err := flags.Parse(cmdCtx.Args[2:]); err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError("while parsing flags: %s", err.Error())
+	}
+	if since < 0 || limit < 0 {
+		return interactive.CoreMessage{}, NewExecutionCommandError("The --since and --limit flags cannot be negative.")
+	}
+
+	boundSources := cmdCtx.Conversation.SourceBindings
+	for _, src := range sources {
+		if !slices.Contains(boundSources, src) {
+			return interactive.CoreMessage{}, NewExecutionCommandError("The %q source is not bound to this channel.", src)
+		}
+	}
+	if len(sources) == 0 {
+		sources = boundSources
+	}
+	if len(sources) == 0 {
+		return respond(noEventsFoundMsg, cmdCtx), nil
+	}
+
+	query := eventhistory.Query{
+		SourceNames: sources,
+		Interactive: cmdCtx.Platform.IsInteractive(),
+		Namespace:   namespace,
+		Limit:       limit,
+	}
+	if since > 0 {
+		query.Since = time.Now().Add(-since)
+	}
+
+	events, err := e.history.List(ctx, query)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while listing events: %w", err)
+	}
+	if len(events) == 0 {
+		return respond(noEventsFoundMsg, cmdCtx), nil
+	}
+
+	table := formatx.Table{
+		Headers: []string{"ID", "TIME", "SOURCE", "NAMESPACE", "LEVEL", "TITLE"},
+	}
+	for _, event := range events {
+		table.Rows = append(table.Rows, []string{
+			event.ID,
+			event.Timestamp.UTC().Format(eventTimeFormat),
+			event.SourceName,
+			event.Namespace,
+			event.Level,
+			event.Title,
+		})
+	}
+
+	return respond(table.Render(), cmdCtx), nil
+}
+
+// Show returns details of a given event. Only events from sources bound to a given conversation are returned.
+func (e *EventExecutor) Show(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.log.Debug("Showing event details...")
+	if !e.cfg.Settings.EventHistory.Enabled {
+		return respond(eventHistoryDisabledMsg, cmdCtx), nil
+	}
+
+	if len(cmdCtx.Args) != 3 {
+		return interactive.CoreMessage{}, errInvalidCommand
+	}
+	id := cmdCtx.Args[2]
+
+	event, err := e.history.Get(ctx, id)
+	switch {
+	case err == nil:
+	case errors.Is(err, eventhistory.ErrEventNotFound):
+		return interactive.CoreMessage{}, NewExecutionCommandError("Event %q not found.", id)
+	default:
+		return interactive.CoreMessage{}, fmt.Errorf("while getting event %q: %w", id, err)
+	}
+
+	isVisible := slices.Contains(cmdCtx.Conversation.SourceBindings, event.SourceName) && event.Interactive == cmdCtx.Platform.IsInteractive()
+	if !isVisible {
+		return interactive.CoreMessage{}, NewExecutionCommandError("Event %q not found.", id)
+	}
+
+	details := formatx.Table{
+		Rows: [][]string{
+			{"ID:", event.ID},
+			{"Time:", event.Timestamp.UTC().Format(eventTimeFormat)},
+			{"Source:", event.SourceName},
+			{"Namespace:", event.Namespace},
+			{"Level:", event.Level},
+			{"Title:", event.Title},
+		},
+	}
+	out := details.Render()
+
+	if len(event.RawObject) > 0 {
+		rawObject, err := yaml.JSONToYAML(event.RawObject)
+		if err != nil {
+			return interactive.CoreMessage{}, fmt.Errorf("while converting event %q to YAML: %w", id, err)
+		}
+		out = fmt.Sprintf("%s\n\n%s", out, strings.TrimSpace(string(rawObject)))
+	}
+
+	return respond(out, cmdCtx), nil
+}
@@ -0,0 +1,215 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/eventhistory"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestEventExecutorList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		bindings []string
+
+		expOutput string
+		expErrMsg string
+	}{
+		{
+			name:     "all events from bound sources",
+			args:     []string{"list", "events"},
+			bindings: []string{"k8s-events", "prometheus"},
+			expOutput: heredoc.Doc(`
+				ID   TIME                    SOURCE     NAMESPACE   LEVEL TITLE
+				3    2023-05-10 11:50:00 UTC k8s-events kube-system error v1/pods error
+				2    2023-05-10 11:40:00 UTC prometheus default     error KubePodCrashLooping
+				1    2023-05-10 11:30:00 UTC k8s-events default     error v1/pods error`),
+		},
+		{
+			name:     "only events from sources bound to the channel",
+			args:     []string{"list", "events"},
+			bindings: []string{"prometheus"},
+			expOutput: heredoc.Doc(`
+				ID   TIME                    SOURCE     NAMESPACE LEVEL TITLE
+				2    2023-05-10 11:40:00 UTC prometheus default   error KubePodCrashLooping`),
+		},
+		{
+			name:     "filtered events",
+			args:     []string{"list", "events", "--source", "k8s-events", "--namespace", "default"},
+			bindings: []string{"k8s-events", "prometheus"},
+			expOutput: heredoc.Doc(`
+				ID   TIME                    SOURCE     NAMESPACE LEVEL TITLE
+				1    2023-05-10 11:30:00 UTC k8s-events default   error v1/pods error`),
+		},
+		{
+			name:      "no bound sources",
+			args:      []string{"list", "events"},
+			expOutput: noEventsFoundMsg,
+		},
+		{
+			name:      "source not bound to the channel",
+			args:      []string{"list", "events", "--source", "k8s-events"},
+			bindings:  []string{"prometheus"},
+			expErrMsg: `The "k8s-events" source is not bound to this channel.`,
+		},
+		{
+			name:      "invalid flag",
+			args:      []string{"list", "events", "--since", "foo"},
+			bindings:  []string{"prometheus"},
+			expErrMsg: `while parsing flags: invalid argument "foo" for "--since" flag: time: invalid duration "foo"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cmdCtx := CommandContext{
+				Args:           tc.args,
+				ExecutorFilter: newExecutorTextFilter(""),
+				Conversation:   Conversation{SourceBindings: tc.bindings},
+				Platform:       config.DiscordCommPlatformIntegration,
+			}
+			e := NewEventExecutor(loggerx.NewNoop(), fixEventHistoryConfig(), fixEventHistoryStore(t))
+
+			// when
+			msg, err := e.List(context.Background(), cmdCtx)
+
+			// then
+			if tc.expErrMsg != "" {
+				require.Error(t, err)
+				assert.True(t, IsExecutionCommandError(err))
+				assert.EqualError(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expOutput, msg.BaseBody.CodeBlock)
+		})
+	}
+}
+
+func TestEventExecutorShow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		bindings []string
+		platform config.CommPlatformIntegration
+
+		expOutput string
+		expErrMsg string
+	}{
+		{
+			name:     "event details",
+			args:     []string{"show", "event", "2"},
+			bindings: []string{"prometheus"},
+			platform: config.DiscordCommPlatformIntegration,
+			expOutput: heredoc.Doc(`
+				ID:        2
+				Time:      2023-05-10 11:40:00 UTC
+				Source:    prometheus
+				Namespace: default
+				Level:     error
+				Title:     KubePodCrashLooping
+
+				kind: Pod`),
+		},
+		{
+			name:      "source not bound to the channel",
+			args:      []string{"show", "event", "2"},
+			bindings:  []string{"k8s-events"},
+			platform:  config.DiscordCommPlatformIntegration,
+			expErrMsg: `Event "2" not found.`,
+		},
+		{
+			name:      "event dispatched to different platform type",
+			args:      []string{"show", "event", "2"},
+			bindings:  []string{"prometheus"},
+			platform:  config.SocketSlackCommPlatformIntegration,
+			expErrMsg: `Event "2" not found.`,
+		},
+		{
+			name:      "unknown event",
+			args:      []string{"show", "event", "42"},
+			bindings:  []string{"prometheus"},
+			platform:  config.DiscordCommPlatformIntegration,
+			expErrMsg: `Event "42" not found.`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cmdCtx := CommandContext{
+				Args:           tc.args,
+				ExecutorFilter: newExecutorTextFilter(""),
+				Conversation:   Conversation{SourceBindings: tc.bindings},
+				Platform:       tc.platform,
+			}
+			e := NewEventExecutor(loggerx.NewNoop(), fixEventHistoryConfig(), fixEventHistoryStore(t))
+
+			// when
+			msg, err := e.Show(context.Background(), cmdCtx)
+
+			// then
+			if tc.expErrMsg != "" {
+				require.Error(t, err)
+				assert.True(t, IsExecutionCommandError(err))
+				assert.EqualError(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expOutput, msg.BaseBody.CodeBlock)
+		})
+	}
+}
+
+func TestEventExecutorDisabledHistory(t *testing.T) {
+	// given
+	cmdCtx := CommandContext{
+		Args:           []string{"list", "events"},
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+	e := NewEventExecutor(loggerx.NewNoop(), config.Config{}, eventhistory.NewNoopStore())
+
+	// when
+	msg, err := e.List(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, eventHistoryDisabledMsg, msg.BaseBody.CodeBlock)
+}
+
+func fixEventHistoryConfig() config.Config {
+	return config.Config{
+		Settings: config.Settings{
+			EventHistory: config.EventHistory{
+				Enabled: true,
+			},
+		},
+	}
+}
+
+func fixEventHistoryStore(t *testing.T) eventhistory.Store {
+	t.Helper()
+
+	store := eventhistory.NewConfigMapStore(loggerx.NewNoop(), fake.NewSimpleClientset(), config.K8sResourceRef{Name: "events", Namespace: "botkube"}, config.EventHistoryRetention{})
+	events := []eventhistory.Event{
+		{SourceName: "k8s-events", Namespace: "default", Title: "v1/pods error"},
+		{SourceName: "prometheus", Namespace: "default", Title: "KubePodCrashLooping"},
+		{SourceName: "k8s-events", Namespace: "kube-system", Title: "v1/pods error"},
+	}
+	for idx, event := range events {
+		event.Level = "error"
+		event.Timestamp = time.Date(2023, 5, 10, 11, 30+idx*10, 0, 0, time.UTC)
+		event.RawObject = []byte(`{"kind":"Pod"}`)
+		_, err := store.Add(context.Background(), event)
+		require.NoError(t, err)
+	}
+	return store
+}
@@ -52,6 +52,7 @@ type DefaultExecutor struct {
 	configExecutor        *ConfigExecutor
 	execExecutor          *ExecExecutor
 	sourceExecutor        *SourceExecutor
+	approvalExecutor      *ApprovalExecutor
 	notifierHandler       NotifierHandler
 	message               string
 	platform              config.CommPlatformIntegration
@@ -61,6 +62,13 @@ type DefaultExecutor struct {
 	cmdsMapping           *CommandMapping
 	auditReporter         audit.AuditReporter
 	pluginHealthStats     *plugin.HealthStats
+	executionErr          error
+}
+
+// ExecutionError returns the error of the executed command, if any. As Execute always returns a message
+// which can be sent back to the user, it is used by automations to check if the command failed.
+func (e *DefaultExecutor) ExecutionError() error {
+	return e.executionErr
 }
 
 // Execute executes commands and returns output
@@ -87,6 +95,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	flags, err := ParseFlags(expandedRawCmd)
 	if err != nil {
 		e.log.Errorf("while parsing command flags %q: %s", expandedRawCmd, err.Error())
+		e.executionErr = err
 		return interactive.CoreMessage{
 			Description: header(cmdCtx),
 			Message: api.Message{
@@ -138,7 +147,22 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 			return e.ExecuteHelp(ctx, cmdCtx)
 		}
 
+		requiresApproval, err := e.approvalExecutor.RequiresApproval(cmdCtx)
+		if err != nil {
+			e.log.Errorf("while checking if command %q requires approval: %s", cmdCtx.CleanCmd, err.Error())
+			return respond(fmt.Sprintf(internalErrorMsgFmt, cmdCtx.ClusterName), cmdCtx)
+		}
+		if requiresApproval {
+			msg, err := e.approvalExecutor.RequestApproval(ctx, e.conversation.ExecutorBindings, e.conversation.SlackState, cmdCtx)
+			if err != nil {
+				e.executionErr = err
+				return respond(err.Error(), cmdCtx)
+			}
+			return msg
+		}
+
 		out, err := e.pluginExecutor.Execute(ctx, e.conversation.ExecutorBindings, e.conversation.SlackState, cmdCtx)
+		e.executionErr = err
 		switch {
 		case err == nil:
 		case IsExecutionCommandError(err):
@@ -166,6 +190,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	if !foundRes {
 		e.reportCommand(ctx, "", anonymizedInvalidVerb, false, cmdCtx)
 		e.log.Infof("received unsupported command: %q", cmdCtx.CleanCmd)
+		e.executionErr = errUnsupportedCommand
 		return respond(unsupportedCmdMsg, cmdCtx)
 	}
 
@@ -178,6 +203,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 		e.reportCommand(ctx, "", reportedCmd, false, cmdCtx)
 		helpMsg := e.cmdsMapping.HelpMessageForVerb(cmdVerb)
 		responseMsg := fmt.Sprintf(invalidCmdWithUsage, cmdRes, helpMsg)
+		e.executionErr = errInvalidCommand
 		return respond(responseMsg, cmdCtx)
 	} else {
 		cmdToReport := string(cmdVerb)
@@ -188,6 +214,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	}
 
 	msg, err := fn(ctx, cmdCtx)
+	e.executionErr = err
 	switch {
 	case err == nil:
 	case errors.Is(err, errInvalidCommand):
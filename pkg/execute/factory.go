@@ -10,6 +10,7 @@ import (
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/internal/audit"
 	guard "github.com/kubeshop/botkube/internal/command"
+	"github.com/kubeshop/botkube/internal/eventhistory"
 	"github.com/kubeshop/botkube/internal/plugin"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
@@ -32,6 +33,7 @@ type DefaultExecutorFactory struct {
 	configExecutor        *ConfigExecutor
 	execExecutor          *ExecExecutor
 	sourceExecutor        *SourceExecutor
+	approvalExecutor      *ApprovalExecutor
 	cmdsMapping           *CommandMapping
 	auditReporter         audit.AuditReporter
 	pluginHealthStats     *plugin.HealthStats
@@ -49,6 +51,9 @@ type DefaultExecutorFactoryParams struct {
 	BotKubeVersion    string
 	AuditReporter     audit.AuditReporter
 	PluginHealthStats *plugin.HealthStats
+	EventHistory      eventhistory.Store
+	UserMapping       *plugin.UserMappingLoader
+	Schedules         SchedulesManager
 }
 
 // Executor is an interface for processes to execute commands
@@ -116,6 +121,30 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		params.Log.WithField("component", "Alias Executor"),
 		params.Cfg,
 	)
+	eventExecutor := NewEventExecutor(
+		params.Log.WithField("component", "Event Executor"),
+		params.Cfg,
+		params.EventHistory,
+	)
+	pluginExecutor := NewPluginExecutor(
+		params.Log.WithField("component", "Botkube Plugin Executor"),
+		params.Cfg,
+		params.PluginManager,
+		params.RestCfg,
+		params.UserMapping,
+	)
+	approvalExecutor := NewApprovalExecutor(
+		params.Log.WithField("component", "Approval Executor"),
+		params.Cfg,
+		pluginExecutor,
+		params.AuditReporter,
+	)
+
+	scheduleExecutor := NewScheduleExecutor(
+		params.Log.WithField("component", "Schedule Executor"),
+		params.Schedules,
+		params.Cfg,
+	)
 
 	executors := []CommandExecutor{
 		actionExecutor,
@@ -129,22 +158,20 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		execExecutor,
 		sourceExecutor,
 		aliasExecutor,
+		eventExecutor,
+		approvalExecutor,
+		scheduleExecutor,
 	}
 	mappings, err := NewCmdsMapping(executors)
 	if err != nil {
 		return nil, err
 	}
 	return &DefaultExecutorFactory{
-		log:               params.Log,
-		cfg:               params.Cfg,
-		analyticsReporter: params.AnalyticsReporter,
-		notifierExecutor:  notifierExecutor,
-		pluginExecutor: NewPluginExecutor(
-			params.Log.WithField("component", "Botkube Plugin Executor"),
-			params.Cfg,
-			params.PluginManager,
-			params.RestCfg,
-		),
+		log:                   params.Log,
+		cfg:                   params.Cfg,
+		analyticsReporter:     params.AnalyticsReporter,
+		notifierExecutor:      notifierExecutor,
+		pluginExecutor:        pluginExecutor,
 		sourceBindingExecutor: sourceBindingExecutor,
 		actionExecutor:        actionExecutor,
 		pingExecutor:          pingExecutor,
@@ -154,6 +181,7 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		configExecutor:        configExecutor,
 		execExecutor:          execExecutor,
 		sourceExecutor:        sourceExecutor,
+		approvalExecutor:      approvalExecutor,
 		cmdsMapping:           mappings,
 		auditReporter:         params.AuditReporter,
 		pluginHealthStats:     params.PluginHealthStats,
@@ -172,6 +200,7 @@ type Conversation struct {
 	SlackState       *slack.BlockActionStates
 	URL              string
 	Text             string
+	Approvers        config.CommandApprovers
 }
 
 // NewDefaultInput an input for NewDefault
@@ -188,6 +217,10 @@ type NewDefaultInput struct {
 type UserInput struct {
 	Mention     string
 	DisplayName string
+	// ID is the user identifier on a given communication platform.
+	ID string
+	// Email is the user email. It's available only if the platform exposes it.
+	Email string
 }
 
 // NewDefault creates new Default Executor.
@@ -207,6 +240,7 @@ func (f *DefaultExecutorFactory) NewDefault(cfg NewDefaultInput) Executor {
 		configExecutor:        f.configExecutor,
 		execExecutor:          f.execExecutor,
 		sourceExecutor:        f.sourceExecutor,
+		approvalExecutor:      f.approvalExecutor,
 		cmdsMapping:           f.cmdsMapping,
 		auditReporter:         f.auditReporter,
 		pluginHealthStats:     f.pluginHealthStats,
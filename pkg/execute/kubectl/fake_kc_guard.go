@@ -1,6 +1,10 @@
 package kubectl
 
-import "github.com/kubeshop/botkube/internal/command"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/internal/command"
+)
 
 // FakeCommandGuard provides functionality to resolve correlations between kubectl verbs and resource types.
 // It's used for test purposes.
@@ -63,6 +67,19 @@ func (f *FakeCommandGuard) GetResourceDetails(verb, resourceType string) (comman
 	}, nil
 }
 
+// GetServerResourceMap returns a map of all resources available on the server.
+func (f *FakeCommandGuard) GetServerResourceMap() (map[string]metav1.APIResource, error) {
+	out := map[string]metav1.APIResource{}
+	for name, res := range f.staticResourceMapping() {
+		out[name] = metav1.APIResource{
+			Name:       res.Name,
+			Namespaced: res.Namespaced,
+			Version:    "v1",
+		}
+	}
+	return out, nil
+}
+
 func (f *FakeCommandGuard) resourcelessVerbs() map[string]struct{} {
 	return map[string]struct{}{
 		"auth":          {},
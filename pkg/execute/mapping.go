@@ -56,6 +56,8 @@ type CommandContext struct {
 	Mapping             *CommandMapping
 	CmdHeader           string
 	PluginHealthStats   *plugin.HealthStats
+	// IsApproved is set to true if the command was approved by an authorized approver.
+	IsApproved bool
 }
 
 // ProvidedClusterNameEqualOrEmpty returns true when provided cluster name is empty
@@ -24,15 +24,17 @@ type PluginExecutor struct {
 	cfg           config.Config
 	pluginManager *plugin.Manager
 	restCfg       *rest.Config
+	userMapping   *plugin.UserMappingLoader
 }
 
 // NewPluginExecutor creates a new instance of PluginExecutor.
-func NewPluginExecutor(log logrus.FieldLogger, cfg config.Config, manager *plugin.Manager, restCfg *rest.Config) *PluginExecutor {
+func NewPluginExecutor(log logrus.FieldLogger, cfg config.Config, manager *plugin.Manager, restCfg *rest.Config, userMapping *plugin.UserMappingLoader) *PluginExecutor {
 	return &PluginExecutor{
 		log:           log,
 		cfg:           cfg,
 		pluginManager: manager,
 		restCfg:       restCfg,
+		userMapping:   userMapping,
 	}
 }
 
@@ -82,7 +84,16 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 	}
 
 	input := plugin.KubeConfigInput{
-		Channel: cmdCtx.Conversation.DisplayName,
+		Channel:   cmdCtx.Conversation.DisplayName,
+		UserID:    cmdCtx.User.ID,
+		UserEmail: cmdCtx.User.Email,
+	}
+	if rbac := plugins[0].Context.RBAC; rbac != nil && (rbac.User.Type.IsUserBased() || rbac.Group.Type.IsUserBased()) {
+		input.UserMapping, err = e.userMapping.Load(ctx)
+		if err != nil {
+			return interactive.CoreMessage{}, fmt.Errorf("while loading user mapping: %w", err)
+		}
+		input.DefaultUserMapping = e.userMapping.Default()
 	}
 	kubeconfig, err := plugin.GenerateKubeConfig(e.restCfg, e.cfg.Settings.ClusterName, plugins[0].Context, input)
 	if err != nil {
@@ -105,6 +116,7 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 			IsInteractivitySupported: e.isInteractivitySupported(cmdCtx),
 			SlackState:               slackState,
 			KubeConfig:               kubeconfig,
+			IsApproved:               cmdCtx.IsApproved,
 			Message: executor.Message{
 				Text: cmdCtx.Conversation.Text,
 				URL:  cmdCtx.Conversation.URL,
@@ -0,0 +1,185 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+const (
+	scheduleNameMissing = "You forgot to pass schedule name. Please pass one of the following valid schedules:\n\n%s"
+	scheduleEnabled     = "I have enabled '%s' schedule on '%s' cluster. The change is not persisted and will be reverted after Botkube restart."
+	scheduleDisabled    = "Done. I won't run '%s' schedule on '%s' cluster. The change is not persisted and will be reverted after Botkube restart."
+	scheduleExecuted    = "I have executed '%s' schedule on '%s' cluster. The output was sent to the schedule channels."
+	scheduleNotAllowed  = "The '%s' schedule can be managed only from channels it sends the output to."
+)
+
+var (
+	scheduleFeatureName = FeatureName{
+		Name:    "schedule",
+		Aliases: []string{"schedules", "sched"},
+	}
+)
+
+// ScheduleStatus describes the runtime state of a given schedule.
+type ScheduleStatus struct {
+	Enabled bool
+	// NextRun is zero if the schedule is disabled.
+	NextRun time.Time
+}
+
+// SchedulesManager manages scheduled commands at runtime.
+type SchedulesManager interface {
+	Status(name string) (ScheduleStatus, error)
+	SetEnabled(name string, enabled bool) error
+	RunNow(ctx context.Context, name string) error
+}
+
+// ScheduleExecutor executes all commands that are related to schedules.
+type ScheduleExecutor struct {
+	log       logrus.FieldLogger
+	manager   SchedulesManager
+	schedules config.Schedules
+}
+
+// NewScheduleExecutor returns a new ScheduleExecutor instance.
+func NewScheduleExecutor(log logrus.FieldLogger, manager SchedulesManager, cfg config.Config) *ScheduleExecutor {
+	return &ScheduleExecutor{
+		log:       log,
+		manager:   manager,
+		schedules: cfg.Schedules,
+	}
+}
+
+// Commands returns slice of commands the executor supports
+func (e *ScheduleExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ListVerb:    e.List,
+		command.EnableVerb:  e.Enable,
+		command.DisableVerb: e.Disable,
+		command.RunVerb:     e.Run,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *ScheduleExecutor) FeatureName() FeatureName {
+	return scheduleFeatureName
+}
+
+// List returns a tabular representation of Schedules
+func (e *ScheduleExecutor) List(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.log.Debug("List schedules")
+	out, err := e.SchedulesTabularOutput()
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+	return respond(out, cmdCtx), nil
+}
+
+// Enable enables given schedule at runtime
+func (e *ScheduleExecutor) Enable(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	return e.setEnabled(cmdCtx, true, scheduleEnabled)
+}
+
+// Disable disables given schedule at runtime
+func (e *ScheduleExecutor) Disable(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	return e.setEnabled(cmdCtx, false, scheduleDisabled)
+}
+
+// Run executes given schedule immediately and sends the output to the schedule channels.
+func (e *ScheduleExecutor) Run(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	name, msg, err := e.scheduleName(cmdCtx)
+	if err != nil || name == "" {
+		return msg, err
+	}
+	if !e.isScheduleChannel(name, cmdCtx.Conversation) {
+		return interactive.CoreMessage{}, NewExecutionCommandError(scheduleNotAllowed, name)
+	}
+	e.log.Debugf("Running schedule %q...", name)
+
+	if err := e.manager.RunNow(ctx, name); err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while running schedule %q: %w", name, err)
+	}
+	return respond(fmt.Sprintf(scheduleExecuted, name, cmdCtx.ClusterName), cmdCtx), nil
+}
+
+func (e *ScheduleExecutor) setEnabled(cmdCtx CommandContext, enabled bool, msgFmt string) (interactive.CoreMessage, error) {
+	name, msg, err := e.scheduleName(cmdCtx)
+	if err != nil || name == "" {
+		return msg, err
+	}
+	if !e.isScheduleChannel(name, cmdCtx.Conversation) {
+		return interactive.CoreMessage{}, NewExecutionCommandError(scheduleNotAllowed, name)
+	}
+	e.log.Debugf("Setting schedule %q enabled to %t...", name, enabled)
+
+	if err := e.manager.SetEnabled(name, enabled); err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while setting schedule %q to %t: %w", name, enabled, err)
+	}
+	return respond(fmt.Sprintf(msgFmt, name, cmdCtx.ClusterName), cmdCtx), nil
+}
+
+// isScheduleChannel returns true if a given conversation is one of the schedule channels.
+// Unknown schedules are reported by the schedules manager.
+func (e *ScheduleExecutor) isScheduleChannel(name string, conversation Conversation) bool {
+	schedule, found := e.schedules[name]
+	if !found {
+		return true
+	}
+
+	for _, target := range schedule.Bindings.Channels {
+		target = strings.TrimPrefix(target, "#")
+		for _, ref := range []string{conversation.Alias, conversation.DisplayName, conversation.ID} {
+			if ref != "" && ref == target {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scheduleName returns the schedule name from a given command. If it's missing, returns the message with all valid schedules.
+func (e *ScheduleExecutor) scheduleName(cmdCtx CommandContext) (string, interactive.CoreMessage, error) {
+	if len(cmdCtx.Args) >= 3 {
+		return cmdCtx.Args[2], interactive.CoreMessage{}, nil
+	}
+
+	out, err := e.SchedulesTabularOutput()
+	if err != nil {
+		return "", interactive.CoreMessage{}, err
+	}
+	return "", respond(fmt.Sprintf(scheduleNameMissing, out), cmdCtx), nil
+}
+
+// SchedulesTabularOutput sorts schedules by key and returns a printable table
+func (e *ScheduleExecutor) SchedulesTabularOutput() (string, error) {
+	keys := maputil.SortKeys(e.schedules)
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "SCHEDULE\tENABLED \tCRON\tNEXT RUN\tDISPLAY NAME")
+	for _, name := range keys {
+		status, err := e.manager.Status(name)
+		if err != nil {
+			return "", fmt.Errorf("while getting status for schedule %q: %w", name, err)
+		}
+
+		nextRun := "-"
+		if !status.NextRun.IsZero() {
+			nextRun = status.NextRun.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "\n%s\t%v \t%s\t%s\t%s", name, status.Enabled, e.schedules[name].Cron, nextRun, e.schedules[name].DisplayName)
+	}
+	w.Flush()
+	return buf.String(), nil
+}
@@ -0,0 +1,119 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+func TestScheduleExecutorChannelRestriction(t *testing.T) {
+	// given
+	cfg := config.Config{
+		Schedules: config.Schedules{
+			"not-running-pods": {
+				Enabled: true,
+				Cron:    "0 8 * * *",
+				Command: "kubectl get pods -A",
+				Bindings: config.ScheduleBindings{
+					Channels: []string{"#sre"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		cmd          string
+		conversation Conversation
+		expErr       string
+		expEnabled   map[string]bool
+		expRuns      []string
+	}{
+		{
+			name:         "enable from the schedule channel",
+			cmd:          "enable schedule not-running-pods",
+			conversation: Conversation{Alias: "ops", DisplayName: "sre", ID: "C123"},
+			expEnabled:   map[string]bool{"not-running-pods": true},
+		},
+		{
+			name:         "run from the schedule channel",
+			cmd:          "run schedule not-running-pods",
+			conversation: Conversation{Alias: "ops", DisplayName: "sre", ID: "C123"},
+			expRuns:      []string{"not-running-pods"},
+		},
+		{
+			name:         "disable from other channel",
+			cmd:          "disable schedule not-running-pods",
+			conversation: Conversation{Alias: "default", DisplayName: "general", ID: "C456"},
+			expErr:       "The 'not-running-pods' schedule can be managed only from channels it sends the output to.",
+		},
+		{
+			name:         "run from other channel",
+			cmd:          "run schedule not-running-pods",
+			conversation: Conversation{Alias: "default", DisplayName: "general", ID: "C456"},
+			expErr:       "The 'not-running-pods' schedule can be managed only from channels it sends the output to.",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			manager := &fakeSchedulesManager{enabled: map[string]bool{}}
+			e := NewScheduleExecutor(loggerx.NewNoop(), manager, cfg)
+
+			args, err := ParseFlags(tc.cmd)
+			require.NoError(t, err)
+			cmdCtx := CommandContext{
+				Args:           args.TokenizedCmd,
+				ClusterName:    "dev",
+				Conversation:   tc.conversation,
+				ExecutorFilter: newExecutorTextFilter(""),
+			}
+			cmdCtx.Conversation.CommandOrigin = command.TypedOrigin
+
+			// when
+			fn := e.Commands()[command.Verb(args.TokenizedCmd[0])]
+			_, err = fn(context.Background(), cmdCtx)
+
+			// then
+			if tc.expErr != "" {
+				assert.EqualError(t, err, tc.expErr)
+				assert.Empty(t, manager.enabled)
+				assert.Empty(t, manager.runs)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expEnabled != nil {
+				assert.Equal(t, tc.expEnabled, manager.enabled)
+			}
+			assert.Equal(t, tc.expRuns, manager.runs)
+		})
+	}
+}
+
+type fakeSchedulesManager struct {
+	enabled map[string]bool
+	runs    []string
+}
+
+func (f *fakeSchedulesManager) Status(string) (ScheduleStatus, error) {
+	return ScheduleStatus{}, nil
+}
+
+func (f *fakeSchedulesManager) SetEnabled(name string, enabled bool) error {
+	if name == "" {
+		return fmt.Errorf("schedule name cannot be empty")
+	}
+	f.enabled[name] = enabled
+	return nil
+}
+
+func (f *fakeSchedulesManager) RunNow(_ context.Context, name string) error {
+	f.runs = append(f.runs, name)
+	return nil
+}
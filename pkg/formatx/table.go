@@ -0,0 +1,29 @@
+package formatx
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// Render returns the table with columns aligned using spaces. Headers are printed only if defined.
+func (t Table) Render() string {
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+
+	var lines [][]string
+	if len(t.Headers) > 0 {
+		lines = append(lines, t.Headers)
+	}
+	lines = append(lines, t.Rows...)
+
+	for idx, line := range lines {
+		if idx > 0 {
+			fmt.Fprint(w, "\n")
+		}
+		fmt.Fprint(w, strings.Join(line, "\t"))
+	}
+	w.Flush()
+	return buf.String()
+}
@@ -0,0 +1,57 @@
+package formatx
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		table    Table
+		expected string
+	}{
+		{
+			name: "with headers",
+			table: Table{
+				Headers: []string{"ID", "SOURCE", "TITLE"},
+				Rows: [][]string{
+					{"1", "k8s-err-events", "v1/pods error"},
+					{"12", "prometheus", "KubePodCrashLooping"},
+				},
+			},
+			expected: heredoc.Doc(`
+				ID   SOURCE         TITLE
+				1    k8s-err-events v1/pods error
+				12   prometheus     KubePodCrashLooping`),
+		},
+		{
+			name: "without headers",
+			table: Table{
+				Rows: [][]string{
+					{"ID:", "1"},
+					{"Source:", "k8s-err-events"},
+				},
+			},
+			expected: heredoc.Doc(`
+				ID:     1
+				Source: k8s-err-events`),
+		},
+		{
+			name:     "empty",
+			table:    Table{},
+			expected: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := tc.table.Render()
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
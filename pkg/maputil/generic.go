@@ -0,0 +1,54 @@
+package maputil
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// AsGeneric converts a given object to a generic map. Objects received via gRPC are already decoded to such form.
+// Returns nil if a given object cannot be represented as a map.
+func AsGeneric(in any) map[string]any {
+	if out, ok := in.(map[string]any); ok {
+		return out
+	}
+
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil
+	}
+	var out map[string]any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// Lookup returns a non-empty value for a given dot-separated path. Keys are matched case-insensitively.
+func Lookup(obj map[string]any, path string) (any, bool) {
+	var current any = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		val, found := m[key]
+		if !found {
+			for k, v := range m {
+				if strings.EqualFold(k, key) {
+					val, found = v, true
+					break
+				}
+			}
+		}
+		if !found {
+			return nil, false
+		}
+		current = val
+	}
+
+	if current == nil || current == "" {
+		return nil, false
+	}
+	return current, true
+}
@@ -0,0 +1,56 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+func TestLookup(t *testing.T) {
+	// given
+	obj := maputil.AsGeneric(struct {
+		Namespace string
+		Labels    map[string]string
+	}{
+		Namespace: "default",
+		Labels: map[string]string{
+			"alertname": "KubePodCrashLooping",
+			"empty":     "",
+		},
+	})
+
+	tests := map[string]struct {
+		path     string
+		expVal   any
+		expFound bool
+	}{
+		"Top-level field matched case-insensitively": {
+			path:     "namespace",
+			expVal:   "default",
+			expFound: true,
+		},
+		"Nested field": {
+			path:     "labels.alertname",
+			expVal:   "KubePodCrashLooping",
+			expFound: true,
+		},
+		"Empty value": {
+			path: "labels.empty",
+		},
+		"Missing field": {
+			path: "namespace.name",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			val, found := maputil.Lookup(obj, test.path)
+
+			// then
+			assert.Equal(t, test.expFound, found)
+			assert.Equal(t, test.expVal, val)
+		})
+	}
+}
@@ -20,6 +20,10 @@ type Bot interface {
 	// SendMessage sends a generic message for a given source bindings.
 	SendMessage(context.Context, interactive.CoreMessage, []string) error
 
+	// SendMessageToChannels sends a generic message to given channels. Channels are referenced by their aliases or identifiers.
+	// Channels which are not configured for a given integration are ignored.
+	SendMessageToChannels(context.Context, interactive.CoreMessage, []string) error
+
 	// IntegrationName returns a name of a given communication platform.
 	IntegrationName() config.CommPlatformIntegration
 
@@ -27,6 +31,21 @@ type Bot interface {
 	Type() config.IntegrationType
 }
 
+// ChannelGate returns true if a notification can be sent to a given channel.
+type ChannelGate func(channel string) bool
+
+// Allows returns true if a notification can be sent to a given channel. A nil gate allows all channels.
+func (g ChannelGate) Allows(channel string) bool {
+	return g == nil || g(channel)
+}
+
+// GatedBot is implemented by bots which can limit notifications per channel.
+type GatedBot interface {
+	// SendGatedMessage sends a generic message for a given source bindings, only to channels allowed by a given gate.
+	// The gate is consulted after channel routing rules, so notifications filtered out by routes are not counted.
+	SendGatedMessage(context.Context, interactive.CoreMessage, []string, ChannelGate) error
+}
+
 // SendPlaintextMessage sends a plaintext message to specified providers.
 func SendPlaintextMessage(ctx context.Context, notifiers []Bot, msg string) error {
 	if msg == "" {
@@ -0,0 +1,222 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+const (
+	// unknownValue defines an unknown string value.
+	unknownValue = "n/a"
+)
+
+// ExecutorFactory facilitates creation of execute.Executor instances.
+type ExecutorFactory interface {
+	NewDefault(cfg execute.NewDefaultInput) execute.Executor
+}
+
+// Scheduler runs Botkube commands periodically and sends their output to the configured channels.
+type Scheduler struct {
+	log       logrus.FieldLogger
+	schedules config.Schedules
+	parsed    map[string]cron.Schedule
+	cron      *cron.Cron
+
+	mu              sync.RWMutex
+	entries         map[string]cron.EntryID
+	ctx             context.Context
+	executorFactory ExecutorFactory
+	notifiers       []notifier.Bot
+}
+
+// NewScheduler returns new instance of Scheduler. Enabled schedules are executed once the Run method is called.
+func NewScheduler(log logrus.FieldLogger, cfg config.Schedules) (*Scheduler, error) {
+	cronLogger := cron.PrintfLogger(log)
+	s := &Scheduler{
+		log:       log,
+		schedules: cfg,
+		parsed:    make(map[string]cron.Schedule),
+		cron: cron.New(
+			cron.WithLogger(cronLogger),
+			cron.WithChain(cron.Recover(cronLogger), cron.SkipIfStillRunning(cronLogger)),
+		),
+		entries: make(map[string]cron.EntryID),
+	}
+
+	for name, schedule := range cfg {
+		if schedule.Cron == "" {
+			// the cron expression is required only for enabled schedules, so it's validated once the schedule is enabled
+			continue
+		}
+
+		parsed, err := schedule.CronSchedule()
+		if err != nil {
+			return nil, fmt.Errorf("while parsing cron expression for schedule %q: %w", name, err)
+		}
+		s.parsed[name] = parsed
+
+		if schedule.Enabled {
+			s.entries[name] = s.cron.Schedule(parsed, s.job(name))
+		}
+	}
+
+	return s, nil
+}
+
+// Run starts executing enabled schedules. It blocks until the context is cancelled.
+func (s *Scheduler) Run(ctx context.Context, executorFactory ExecutorFactory, notifiers []notifier.Bot) error {
+	s.mu.Lock()
+	s.ctx = ctx
+	s.executorFactory = executorFactory
+	s.notifiers = notifiers
+	enabledCount := len(s.entries)
+	s.mu.Unlock()
+
+	s.log.Infof("Starting scheduler with %d enabled schedule(s)...", enabledCount)
+	s.cron.Start()
+
+	<-ctx.Done()
+	s.log.Info("Stopping scheduler...")
+	<-s.cron.Stop().Done()
+	return nil
+}
+
+// Status returns the runtime state of a given schedule.
+func (s *Scheduler) Status(name string) (execute.ScheduleStatus, error) {
+	if _, found := s.schedules[name]; !found {
+		return execute.ScheduleStatus{}, notFoundError(name)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	parsed, hasCron := s.parsed[name]
+	if _, enabled := s.entries[name]; !enabled || !hasCron {
+		return execute.ScheduleStatus{}, nil
+	}
+	return execute.ScheduleStatus{
+		Enabled: true,
+		NextRun: parsed.Next(time.Now()),
+	}, nil
+}
+
+// SetEnabled enables or disables a given schedule. The change is not persisted.
+func (s *Scheduler) SetEnabled(name string, enabled bool) error {
+	if _, found := s.schedules[name]; !found {
+		return notFoundError(name)
+	}
+	parsed, hasCron := s.parsed[name]
+	if enabled && !hasCron {
+		return fmt.Errorf("schedule %q cannot be enabled as it has no cron expression", name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, isEnabled := s.entries[name]
+	switch {
+	case enabled && !isEnabled:
+		s.entries[name] = s.cron.Schedule(parsed, s.job(name))
+	case !enabled && isEnabled:
+		s.cron.Remove(id)
+		delete(s.entries, name)
+	}
+	return nil
+}
+
+// RunNow executes a given schedule immediately, regardless if it's enabled or not.
+func (s *Scheduler) RunNow(ctx context.Context, name string) error {
+	if _, found := s.schedules[name]; !found {
+		return notFoundError(name)
+	}
+
+	s.mu.RLock()
+	executorFactory, notifiers := s.executorFactory, s.notifiers
+	s.mu.RUnlock()
+	if executorFactory == nil {
+		return errors.New("scheduler is not running yet")
+	}
+
+	return s.execute(ctx, executorFactory, notifiers, name)
+}
+
+func (s *Scheduler) job(name string) cron.Job {
+	return cron.FuncJob(func() {
+		s.mu.RLock()
+		ctx, executorFactory, notifiers := s.ctx, s.executorFactory, s.notifiers
+		s.mu.RUnlock()
+
+		if err := s.execute(ctx, executorFactory, notifiers, name); err != nil {
+			s.log.WithField("schedule", name).Errorf("while executing schedule: %s", err.Error())
+		}
+	})
+}
+
+func (s *Scheduler) execute(ctx context.Context, executorFactory ExecutorFactory, notifiers []notifier.Bot, name string) error {
+	schedule := s.schedules[name]
+	log := s.log.WithFields(logrus.Fields{
+		"schedule": name,
+		"command":  schedule.Command,
+	})
+	log.Info("Executing scheduled command...")
+
+	displayName := schedule.DisplayName
+	if displayName == "" {
+		displayName = name
+	}
+	userName := fmt.Sprintf("Schedule %q", displayName)
+
+	e := executorFactory.NewDefault(execute.NewDefaultInput{
+		Conversation: execute.Conversation{
+			IsKnown:          true,
+			ExecutorBindings: schedule.Bindings.Executors,
+			CommandOrigin:    command.ScheduleOrigin,
+			Alias:            unknownValue,
+			ID:               unknownValue,
+		},
+		CommGroupName:   unknownValue,
+		Platform:        unknownValue,
+		NotifierHandler: &noopNotifierHandler{},
+		Message:         strings.TrimSpace(schedule.Command),
+		User: execute.UserInput{
+			Mention:     userName,
+			DisplayName: userName,
+		},
+	})
+	msg := e.Execute(ctx)
+	log.WithField("message", fmt.Sprintf("%+v", msg)).Debug("Scheduled command executed. Sending output message...")
+
+	errs := multierror.New()
+	for _, n := range notifiers {
+		if err := n.SendMessageToChannels(ctx, msg, schedule.Bindings.Channels); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending schedule result message via %s: %w", n.IntegrationName(), err))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func notFoundError(name string) error {
+	return fmt.Errorf("schedule with name %q not found", name)
+}
+
+type noopNotifierHandler struct{}
+
+func (n *noopNotifierHandler) NotificationsEnabled(_ string) bool {
+	return false
+}
+
+func (n *noopNotifierHandler) SetNotificationsEnabled(_ string, _ bool) error {
+	return errors.New("setting notification from scheduled command is not supported. Use Botkube commands on a specific channel to set notifications")
+}
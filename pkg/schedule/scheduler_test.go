@@ -0,0 +1,248 @@
+package schedule_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/notifier"
+	"github.com/kubeshop/botkube/pkg/schedule"
+)
+
+func TestScheduler_RunNow(t *testing.T) {
+	// given
+	userName := `Schedule "Not running pods"`
+	executorBindings := []string{"kubectl-read-only"}
+	expectedExecutorInput := execute.NewDefaultInput{
+		CommGroupName:   "n/a",
+		Platform:        "n/a",
+		NotifierHandler: nil, // won't check it
+		Conversation: execute.Conversation{
+			Alias:            "n/a",
+			ID:               "n/a",
+			ExecutorBindings: executorBindings,
+			IsKnown:          true,
+			CommandOrigin:    command.ScheduleOrigin,
+		},
+		Message: "kubectl get pods -A --field-selector=status.phase!=Running",
+		User: execute.UserInput{
+			Mention:     userName,
+			DisplayName: userName,
+		},
+	}
+
+	scheduler, err := schedule.NewScheduler(loggerx.NewNoop(), fixSchedulesConfig())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bot := &fakeBot{}
+	go func() {
+		_ = scheduler.Run(ctx, &fakeFactory{t: t, expectedInput: expectedExecutorInput}, []notifier.Bot{bot})
+	}()
+
+	// when
+	require.Eventually(t, func() bool {
+		err = scheduler.RunNow(ctx, "not-running-pods")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// then
+	assert.Equal(t, []string{"ops", "#alerts"}, bot.channels)
+	assert.Equal(t, fixInteractiveMessage(), bot.msg)
+}
+
+func TestScheduler_RunNowNotStarted(t *testing.T) {
+	// given
+	scheduler, err := schedule.NewScheduler(loggerx.NewNoop(), fixSchedulesConfig())
+	require.NoError(t, err)
+
+	// when
+	err = scheduler.RunNow(context.Background(), "not-running-pods")
+
+	// then
+	assert.EqualError(t, err, "scheduler is not running yet")
+
+	// when
+	err = scheduler.RunNow(context.Background(), "unknown")
+
+	// then
+	assert.EqualError(t, err, `schedule with name "unknown" not found`)
+}
+
+func TestScheduler_SetEnabled(t *testing.T) {
+	// given
+	scheduler, err := schedule.NewScheduler(loggerx.NewNoop(), fixSchedulesConfig())
+	require.NoError(t, err)
+
+	// when
+	status, err := scheduler.Status("disabled")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, execute.ScheduleStatus{}, status)
+
+	// when
+	err = scheduler.SetEnabled("disabled", true)
+	require.NoError(t, err)
+	status, err = scheduler.Status("disabled")
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	loc, err := time.LoadLocation("Europe/Warsaw")
+	require.NoError(t, err)
+	assert.Equal(t, 8, status.NextRun.In(loc).Hour())
+
+	// when
+	err = scheduler.SetEnabled("disabled", false)
+	require.NoError(t, err)
+	status, err = scheduler.Status("disabled")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, execute.ScheduleStatus{}, status)
+
+	// when
+	err = scheduler.SetEnabled("unknown", true)
+
+	// then
+	assert.EqualError(t, err, `schedule with name "unknown" not found`)
+}
+
+func TestNewScheduler_InvalidCron(t *testing.T) {
+	// given
+	cfg := config.Schedules{
+		"invalid": {
+			Cron:    "every morning",
+			Command: "kubectl get pods",
+		},
+	}
+
+	// when
+	_, err := schedule.NewScheduler(loggerx.NewNoop(), cfg)
+
+	// then
+	assert.ErrorContains(t, err, `while parsing cron expression for schedule "invalid"`)
+}
+
+func TestNewScheduler_DisabledWithoutCron(t *testing.T) {
+	// given
+	cfg := config.Schedules{
+		"manual": {
+			Enabled: false,
+			Command: "kubectl get pods",
+		},
+	}
+
+	// when
+	scheduler, err := schedule.NewScheduler(loggerx.NewNoop(), cfg)
+
+	// then
+	require.NoError(t, err)
+
+	// when
+	status, err := scheduler.Status("manual")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, execute.ScheduleStatus{}, status)
+
+	// when
+	err = scheduler.SetEnabled("manual", true)
+
+	// then
+	assert.EqualError(t, err, `schedule "manual" cannot be enabled as it has no cron expression`)
+	require.NoError(t, scheduler.SetEnabled("manual", false))
+}
+
+func fixSchedulesConfig() config.Schedules {
+	return config.Schedules{
+		"not-running-pods": {
+			Enabled:     true,
+			DisplayName: "Not running pods",
+			Cron:        "0 8 * * *",
+			Command:     "kubectl get pods -A --field-selector=status.phase!=Running",
+			Bindings: config.ScheduleBindings{
+				Executors: []string{"kubectl-read-only"},
+				Channels:  []string{"ops", "#alerts"},
+			},
+		},
+		"disabled": {
+			Enabled:  false,
+			Cron:     "0 8 * * *",
+			Timezone: "Europe/Warsaw",
+			Command:  "kubectl get nodes",
+			Bindings: config.ScheduleBindings{
+				Executors: []string{"kubectl-read-only"},
+				Channels:  []string{"ops"},
+			},
+		},
+	}
+}
+
+type fakeFactory struct {
+	t             *testing.T
+	expectedInput execute.NewDefaultInput
+}
+
+func (f *fakeFactory) NewDefault(input execute.NewDefaultInput) execute.Executor {
+	input.NotifierHandler = nil
+	require.Equal(f.t, f.expectedInput, input)
+
+	return &fakeExecutor{}
+}
+
+type fakeExecutor struct{}
+
+func (fakeExecutor) Execute(_ context.Context) interactive.CoreMessage {
+	return fixInteractiveMessage()
+}
+
+func fixInteractiveMessage() interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Header: "Sample",
+		Message: api.Message{
+			BaseBody: api.Body{
+				CodeBlock: "pod-1   0/1   CrashLoopBackOff",
+			},
+		},
+	}
+}
+
+type fakeBot struct {
+	msg      interactive.CoreMessage
+	channels []string
+}
+
+func (f *fakeBot) SendMessageToAll(context.Context, interactive.CoreMessage) error {
+	return nil
+}
+
+func (f *fakeBot) SendMessage(context.Context, interactive.CoreMessage, []string) error {
+	return nil
+}
+
+func (f *fakeBot) SendMessageToChannels(_ context.Context, msg interactive.CoreMessage, channels []string) error {
+	f.msg = msg
+	f.channels = channels
+	return nil
+}
+
+func (f *fakeBot) IntegrationName() config.CommPlatformIntegration {
+	return config.SocketSlackCommPlatformIntegration
+}
+
+func (f *fakeBot) Type() config.IntegrationType {
+	return config.BotIntegrationType
+}
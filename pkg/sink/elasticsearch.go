@@ -9,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -50,6 +51,10 @@ type Elasticsearch struct {
 	client         *elastic.Client
 	indices        map[string]config.ELSIndex
 	clusterVersion string
+	bulkCfg        config.ELSBulk
+	buffer         *elsBulkBuffer
+	flushRequested chan struct{}
+	statusMu       sync.RWMutex
 	status         health.PlatformStatusMsg
 	failureReason  health.FailureReasonMsg
 }
@@ -134,9 +139,20 @@ func NewElasticsearch(log logrus.FieldLogger, commGroupIdx int, c config.Elastic
 		client:         elsClient,
 		indices:        c.Indices,
 		clusterVersion: pong.Version.Number,
+		bulkCfg:        c.Bulk,
 		status:         health.StatusUnknown,
 		failureReason:  "",
 	}
+	if c.Bulk.Enabled {
+		esNotifier.buffer = newELSBulkBuffer(c.Bulk.BufferSize)
+		esNotifier.flushRequested = make(chan struct{}, 1)
+	}
+
+	for _, indexCfg := range c.Indices {
+		if err := esNotifier.setupIndex(context.Background(), indexCfg); err != nil {
+			return nil, fmt.Errorf("while setting up Elasticsearch index %q: %w", indexCfg.Name, err)
+		}
+	}
 
 	err = reporter.ReportSinkEnabled(esNotifier.IntegrationName(), commGroupIdx)
 	if err != nil {
@@ -160,41 +176,27 @@ type index struct {
 }
 
 func (e *Elasticsearch) flushIndex(ctx context.Context, indexCfg config.ELSIndex, event interface{}) error {
-	// Construct the ELS Index Name with timestamp suffix
-	indexName := indexCfg.Name + "-" + time.Now().Format(indexSuffixFormat)
-	// Create index if not exists
-	exists, err := e.client.IndexExists(indexName).Do(ctx)
-	if err != nil {
-		return fmt.Errorf("while getting index: %w", err)
-	}
-	if !exists {
-		// Create a new index.
-		mapping := mapping{
-			Settings: settings{
-				index{
-					Shards:   indexCfg.Shards,
-					Replicas: indexCfg.Replicas,
-				},
-			},
-		}
-		_, err := e.client.CreateIndex(indexName).BodyJson(mapping).Do(ctx)
-		if err != nil && elastic.ErrorReason(err) != elasticErrorReasonResourceAlreadyExists {
-			return fmt.Errorf("while creating index: %w", err)
-		}
+	now := time.Now()
+	indexName := indexName(indexCfg, now)
+	if err := e.ensureIndex(ctx, indexCfg, indexName); err != nil {
+		return err
 	}
 
 	// Send event to els
-	indexService := e.client.Index().Index(indexName)
-	majorVersion, err := esMajorClusterVersion(e.clusterVersion)
+	indexService := e.client.Index().Index(indexName).BodyJson(document(indexCfg, event, now))
+	if indexCfg.DataStream {
+		// data streams accept only the `create` operation
+		indexService.OpType("create")
+	}
+	docType, err := e.docType(indexCfg)
 	if err != nil {
-		return fmt.Errorf("while getting cluster major version: %w", err)
+		return err
 	}
-	if majorVersion <= 7 && indexCfg.Type != "" {
-		// Only Elasticsearch <= 7.x supports Type parameter
+	if docType != "" {
 		// nolint:staticcheck
-		indexService.Type(indexCfg.Type)
+		indexService.Type(docType)
 	}
-	_, err = indexService.BodyJson(event).Do(ctx)
+	_, err = indexService.Do(ctx)
 	if err != nil {
 		return fmt.Errorf("while posting data to ELS: %w", err)
 	}
@@ -206,10 +208,60 @@ func (e *Elasticsearch) flushIndex(ctx context.Context, indexCfg config.ELSIndex
 	return nil
 }
 
+// ensureIndex creates a given index with the configured settings, if it doesn't exist yet.
+// Indices managed by index templates, as well as data streams, are created by Elasticsearch.
+func (e *Elasticsearch) ensureIndex(ctx context.Context, indexCfg config.ELSIndex, indexName string) error {
+	if indexCfg.DataStream || indexCfg.Template.Enabled {
+		return nil
+	}
+
+	exists, err := e.client.IndexExists(indexName).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("while getting index: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	// Create a new index.
+	mapping := mapping{
+		Settings: settings{
+			index{
+				Shards:   indexCfg.Shards,
+				Replicas: indexCfg.Replicas,
+			},
+		},
+	}
+	_, err = e.client.CreateIndex(indexName).BodyJson(mapping).Do(ctx)
+	if err != nil && elastic.ErrorReason(err) != elasticErrorReasonResourceAlreadyExists {
+		return fmt.Errorf("while creating index: %w", err)
+	}
+	return nil
+}
+
+// docType returns the document type for a given index. Only Elasticsearch <= 7.x supports Type parameter.
+func (e *Elasticsearch) docType(indexCfg config.ELSIndex) (string, error) {
+	if indexCfg.Type == "" || indexCfg.DataStream {
+		return "", nil
+	}
+	majorVersion, err := esMajorClusterVersion(e.clusterVersion)
+	if err != nil {
+		return "", fmt.Errorf("while getting cluster major version: %w", err)
+	}
+	if majorVersion > 7 {
+		return "", nil
+	}
+	return indexCfg.Type, nil
+}
+
 // SendEvent sends an event to a configured elasticsearch server.
 func (e *Elasticsearch) SendEvent(ctx context.Context, rawData any, sources []string) error {
 	e.log.Debugf(">> Sending to Elasticsearch: %+v", rawData)
 
+	if e.buffer != nil {
+		return e.bufferEvent(rawData, sources)
+	}
+
 	errs := multierror.New()
 	for _, indexCfg := range e.indices {
 		if !sliceutil.Intersect(indexCfg.Bindings.Sources, sources) {
@@ -229,6 +281,31 @@ func (e *Elasticsearch) SendEvent(ctx context.Context, rawData any, sources []st
 	return errs.ErrorOrNil()
 }
 
+// bufferEvent adds an event to the bulk buffer for all matching indices. Events are sent asynchronously.
+func (e *Elasticsearch) bufferEvent(rawData any, sources []string) error {
+	now := time.Now()
+
+	var items []elsBulkItem
+	for _, indexCfg := range e.indices {
+		if !sliceutil.Intersect(indexCfg.Bindings.Sources, sources) {
+			continue
+		}
+		docType, err := e.docType(indexCfg)
+		if err != nil {
+			return err
+		}
+		items = append(items, elsBulkItem{
+			cfg:   indexCfg,
+			index: indexName(indexCfg, now),
+			typ:   docType,
+			doc:   document(indexCfg, rawData, now),
+		})
+	}
+
+	e.enqueue(items)
+	return nil
+}
+
 // IntegrationName describes the notifier integration name.
 func (e *Elasticsearch) IntegrationName() config.CommPlatformIntegration {
 	return config.ElasticsearchCommPlatformIntegration
@@ -240,6 +317,9 @@ func (e *Elasticsearch) Type() config.IntegrationType {
 }
 
 func (e *Elasticsearch) setFailureReason(reason health.FailureReasonMsg) {
+	e.statusMu.Lock()
+	defer e.statusMu.Unlock()
+
 	if reason == "" {
 		e.status = health.StatusHealthy
 	} else {
@@ -250,6 +330,9 @@ func (e *Elasticsearch) setFailureReason(reason health.FailureReasonMsg) {
 
 // GetStatus gets sink status
 func (e *Elasticsearch) GetStatus() health.PlatformStatus {
+	e.statusMu.RLock()
+	defer e.statusMu.RUnlock()
+
 	return health.PlatformStatus{
 		Status:   e.status,
 		Restarts: "0/0",
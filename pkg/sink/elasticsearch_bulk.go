@@ -0,0 +1,256 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// finalFlushTimeout limits the time spent on flushing buffered events on shutdown.
+const finalFlushTimeout = 10 * time.Second
+
+var droppedELSEventsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "botkube_elasticsearch_dropped_events_total",
+	Help: "Total number of events dropped by the Elasticsearch sink, as the bulk buffer was full or the events were rejected.",
+})
+
+// elsBulkItem is a single document waiting for the bulk indexing.
+type elsBulkItem struct {
+	cfg   config.ELSIndex
+	index string
+	typ   string
+	doc   any
+}
+
+func (i elsBulkItem) request() elastic.BulkableRequest {
+	if i.cfg.DataStream {
+		return elastic.NewBulkCreateRequest().Index(i.index).Doc(i.doc)
+	}
+
+	req := elastic.NewBulkIndexRequest().Index(i.index).Doc(i.doc)
+	if i.typ != "" {
+		// nolint:staticcheck
+		req.Type(i.typ)
+	}
+	return req
+}
+
+// elsBulkBuffer is a bounded FIFO buffer of documents waiting for the bulk indexing.
+// Once the buffer is full, the oldest documents are dropped.
+type elsBulkBuffer struct {
+	mu      sync.Mutex
+	items   []elsBulkItem
+	size    int
+	dropped int
+}
+
+func newELSBulkBuffer(size int) *elsBulkBuffer {
+	return &elsBulkBuffer{size: size}
+}
+
+// Push appends given items and returns the number of buffered items.
+func (b *elsBulkBuffer) Push(items ...elsBulkItem) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.items = append(b.items, items...)
+	b.trim()
+	return len(b.items)
+}
+
+// Requeue puts back given items in front of the buffer, as they are older than the buffered ones.
+func (b *elsBulkBuffer) Requeue(items []elsBulkItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.items = append(append([]elsBulkItem{}, items...), b.items...)
+	b.trim()
+}
+
+// Pop removes and returns up to n oldest items.
+func (b *elsBulkBuffer) Pop(n int) []elsBulkItem {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if n > len(b.items) {
+		n = len(b.items)
+	}
+	out := b.items[:n:n]
+	b.items = b.items[n:]
+	return out
+}
+
+// Len returns the number of buffered items.
+func (b *elsBulkBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.items)
+}
+
+// PopDropped returns the number of items dropped since the last call.
+func (b *elsBulkBuffer) PopDropped() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	dropped := b.dropped
+	b.dropped = 0
+	return dropped
+}
+
+func (b *elsBulkBuffer) trim() {
+	overflow := len(b.items) - b.size
+	if overflow <= 0 {
+		return
+	}
+	b.dropped += overflow
+	b.items = b.items[overflow:]
+}
+
+// Start flushes the buffered events periodically, until a given context is canceled.
+// It's a no-op if bulk indexing is disabled.
+func (e *Elasticsearch) Start(ctx context.Context) error {
+	if e.buffer == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(e.bulkCfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.log.Info("Flushing buffered events before shutdown...")
+			flushCtx, cancel := context.WithTimeout(context.Background(), finalFlushTimeout)
+			e.flushBuffer(flushCtx)
+			cancel()
+			return nil
+		case <-ticker.C:
+			e.flushBuffer(ctx)
+		case <-e.flushRequested:
+			e.flushBuffer(ctx)
+		}
+	}
+}
+
+// enqueue adds given items to the buffer and requests the flush once the flush size is reached.
+func (e *Elasticsearch) enqueue(items []elsBulkItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	buffered := e.buffer.Push(items...)
+	e.reportDropped()
+
+	if buffered < e.bulkCfg.FlushSize {
+		return
+	}
+	select {
+	case e.flushRequested <- struct{}{}:
+	default: // flush already requested
+	}
+}
+
+// flushBuffer sends buffered events in batches. Events which failed with a retryable error are put back to the buffer,
+// and the flush is stopped until the next one.
+func (e *Elasticsearch) flushBuffer(ctx context.Context) {
+	for e.buffer.Len() > 0 {
+		items := e.buffer.Pop(e.bulkCfg.FlushSize)
+		retry, err := e.sendBulk(ctx, items)
+		if len(retry) > 0 {
+			e.buffer.Requeue(retry)
+		}
+		e.reportDropped()
+
+		if err != nil {
+			e.setFailureReason(health.FailureReasonConnectionError)
+			e.log.Errorf("while sending events to Elasticsearch (%d events buffered for retry): %s", e.buffer.Len(), err.Error())
+			return
+		}
+		e.setFailureReason("")
+	}
+}
+
+// sendBulk sends given items in a single bulk request. Returns items which should be retried.
+func (e *Elasticsearch) sendBulk(ctx context.Context, items []elsBulkItem) ([]elsBulkItem, error) {
+	if err := e.ensureIndices(ctx, items); err != nil {
+		return items, err
+	}
+
+	bulk := e.client.Bulk()
+	for _, item := range items {
+		bulk.Add(item.request())
+	}
+
+	resp, err := bulk.Do(ctx)
+	if err != nil {
+		return items, fmt.Errorf("while sending bulk request: %w", err)
+	}
+
+	var (
+		retry    []elsBulkItem
+		rejected int
+	)
+	for idx, result := range resp.Items {
+		if idx >= len(items) {
+			break
+		}
+		for _, res := range result {
+			switch {
+			case res.Status >= 200 && res.Status <= 299:
+			case res.Status == http.StatusTooManyRequests || res.Status >= http.StatusInternalServerError:
+				retry = append(retry, items[idx])
+			default:
+				rejected++
+				e.log.Errorf("Elasticsearch rejected event for index %q (status: %d): %s", items[idx].index, res.Status, bulkErrorReason(res))
+			}
+		}
+	}
+	droppedELSEventsCounter.Add(float64(rejected))
+
+	if len(retry) > 0 {
+		return retry, fmt.Errorf("%d of %d events failed with retryable errors", len(retry), len(items))
+	}
+	e.log.Debugf("%d events successfully sent to Elasticsearch", len(items))
+	return nil, nil
+}
+
+// ensureIndices creates indices for given items, if they don't exist yet.
+func (e *Elasticsearch) ensureIndices(ctx context.Context, items []elsBulkItem) error {
+	checked := map[string]struct{}{}
+	for _, item := range items {
+		if _, found := checked[item.index]; found {
+			continue
+		}
+		checked[item.index] = struct{}{}
+
+		if err := e.ensureIndex(ctx, item.cfg, item.index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Elasticsearch) reportDropped() {
+	dropped := e.buffer.PopDropped()
+	if dropped == 0 {
+		return
+	}
+	droppedELSEventsCounter.Add(float64(dropped))
+	e.log.Warnf("Dropped %d oldest events, as the Elasticsearch bulk buffer is full (size: %d)", dropped, e.bulkCfg.BufferSize)
+}
+
+func bulkErrorReason(res *elastic.BulkResponseItem) string {
+	if res.Error == nil {
+		return "unknown error"
+	}
+	return fmt.Sprintf("%s: %s", res.Error.Type, res.Error.Reason)
+}
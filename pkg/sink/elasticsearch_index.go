@@ -0,0 +1,157 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+const (
+	elsTimestampField = "@timestamp"
+	elsMessageField   = "message"
+)
+
+// indexName returns the name of the index, or data stream, for a given time.
+func indexName(cfg config.ELSIndex, now time.Time) string {
+	switch {
+	case cfg.DataStream:
+		return cfg.Name
+	case cfg.NamePattern != "":
+		return strings.NewReplacer(
+			"%%", "%",
+			"%Y", fmt.Sprintf("%04d", now.Year()),
+			"%m", fmt.Sprintf("%02d", now.Month()),
+			"%d", fmt.Sprintf("%02d", now.Day()),
+			"%H", fmt.Sprintf("%02d", now.Hour()),
+		).Replace(cfg.NamePattern)
+	default:
+		return cfg.Name + "-" + now.Format(indexSuffixFormat)
+	}
+}
+
+// indexPatterns returns the patterns matching all indices created for a given configuration.
+func indexPatterns(cfg config.ELSIndex) []string {
+	switch {
+	case cfg.DataStream:
+		return []string{cfg.Name}
+	case cfg.NamePattern != "":
+		prefix, _, _ := strings.Cut(cfg.NamePattern, "%")
+		return []string{prefix + "*"}
+	default:
+		return []string{cfg.Name + "-*"}
+	}
+}
+
+// document returns the document indexed for a given event. Data streams require the @timestamp field,
+// so the event is converted to a generic map, and the timestamp is added if missing.
+func document(cfg config.ELSIndex, event any, now time.Time) any {
+	if !cfg.DataStream {
+		return event
+	}
+
+	doc := maputil.AsGeneric(event)
+	if doc == nil {
+		doc = map[string]any{elsMessageField: event}
+	} else {
+		// the event is shared with other sinks, so it mustn't be modified
+		doc = maps.Clone(doc)
+	}
+
+	if _, found := doc[elsTimestampField]; !found {
+		doc[elsTimestampField] = now.UTC().Format(time.RFC3339Nano)
+	}
+	return doc
+}
+
+// setupIndex creates or updates the ILM policy and index template for a given index, if configured.
+func (e *Elasticsearch) setupIndex(ctx context.Context, cfg config.ELSIndex) error {
+	var policyName string
+	if cfg.ILM.Enabled {
+		policyName = valueOrDefault(cfg.ILM.Name, cfg.Name)
+		_, err := e.client.XPackIlmPutLifecycle().Policy(policyName).BodyJson(ilmPolicyBody(cfg)).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("while putting ILM policy %q: %w", policyName, err)
+		}
+	}
+
+	if cfg.Template.Enabled {
+		templateName := valueOrDefault(cfg.Template.Name, cfg.Name)
+		_, err := e.client.IndexPutIndexTemplate(templateName).BodyJson(indexTemplateBody(cfg, policyName)).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("while putting index template %q: %w", templateName, err)
+		}
+	}
+
+	return nil
+}
+
+func indexTemplateBody(cfg config.ELSIndex, policyName string) map[string]any {
+	settings := map[string]any{
+		"number_of_shards":   cfg.Shards,
+		"number_of_replicas": cfg.Replicas,
+	}
+	if policyName != "" {
+		settings["index.lifecycle.name"] = policyName
+	}
+
+	body := map[string]any{
+		"index_patterns": indexPatterns(cfg),
+		"priority":       cfg.Template.Priority,
+		"template": map[string]any{
+			"settings": settings,
+		},
+	}
+	if cfg.DataStream {
+		body["data_stream"] = map[string]any{}
+	}
+	return body
+}
+
+func ilmPolicyBody(cfg config.ELSIndex) map[string]any {
+	phases := map[string]any{}
+
+	rollover := map[string]any{}
+	if cfg.DataStream {
+		if cfg.ILM.RolloverMaxAge != "" {
+			rollover["max_age"] = cfg.ILM.RolloverMaxAge
+		}
+		if cfg.ILM.RolloverMaxPrimaryShardSize != "" {
+			rollover["max_primary_shard_size"] = cfg.ILM.RolloverMaxPrimaryShardSize
+		}
+	}
+	hotActions := map[string]any{}
+	if len(rollover) > 0 {
+		hotActions["rollover"] = rollover
+	}
+	phases["hot"] = map[string]any{
+		"actions": hotActions,
+	}
+
+	if cfg.ILM.DeleteAfter != "" {
+		phases["delete"] = map[string]any{
+			"min_age": cfg.ILM.DeleteAfter,
+			"actions": map[string]any{
+				"delete": map[string]any{},
+			},
+		}
+	}
+
+	return map[string]any{
+		"policy": map[string]any{
+			"phases": phases,
+		},
+	}
+}
+
+func valueOrDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
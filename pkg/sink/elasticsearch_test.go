@@ -1,10 +1,25 @@
 package sink
 
 import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
 )
 
 func TestElasticsearchVersion(t *testing.T) {
@@ -27,3 +42,240 @@ func TestElasticsearchVersion(t *testing.T) {
 		assert.Equal(t, test.err, err)
 	}
 }
+
+func TestElasticsearchIndexName(t *testing.T) {
+	now := time.Date(2023, 9, 5, 7, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		cfg         config.ELSIndex
+		expName     string
+		expPatterns []string
+	}{
+		"Default date suffix": {
+			cfg:         config.ELSIndex{Name: "botkube"},
+			expName:     "botkube-2023-09-05",
+			expPatterns: []string{"botkube-*"},
+		},
+		"Name pattern": {
+			cfg:         config.ELSIndex{Name: "botkube", NamePattern: "botkube-events-%Y.%m.%d-%H"},
+			expName:     "botkube-events-2023.09.05-07",
+			expPatterns: []string{"botkube-events-*"},
+		},
+		"Data stream": {
+			cfg:         config.ELSIndex{Name: "logs-botkube-default", DataStream: true},
+			expName:     "logs-botkube-default",
+			expPatterns: []string{"logs-botkube-default"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expName, indexName(test.cfg, now))
+			assert.Equal(t, test.expPatterns, indexPatterns(test.cfg))
+		})
+	}
+}
+
+func TestElasticsearchDataStreamDocument(t *testing.T) {
+	// given
+	now := time.Date(2023, 9, 5, 7, 0, 0, 0, time.UTC)
+	cfg := config.ELSIndex{Name: "logs-botkube-default", DataStream: true}
+	event := map[string]any{"name": "nginx"}
+
+	// when
+	doc := document(cfg, event, now)
+
+	// then
+	assert.Equal(t, map[string]any{"name": "nginx", "@timestamp": "2023-09-05T07:00:00Z"}, doc)
+	assert.Equal(t, map[string]any{"name": "nginx"}, event)
+	assert.Equal(t, map[string]any{"message": "foo", "@timestamp": "2023-09-05T07:00:00Z"}, document(cfg, "foo", now))
+	assert.Equal(t, event, document(config.ELSIndex{Name: "botkube"}, event, now))
+}
+
+func TestElasticsearchBulkBuffer(t *testing.T) {
+	// given
+	buffer := newELSBulkBuffer(3)
+	item := func(name string) elsBulkItem {
+		return elsBulkItem{index: name}
+	}
+
+	// when
+	buffered := buffer.Push(item("1"), item("2"), item("3"), item("4"))
+
+	// then
+	assert.Equal(t, 3, buffered)
+	assert.Equal(t, 1, buffer.PopDropped())
+	assert.Equal(t, 0, buffer.PopDropped())
+
+	// when
+	popped := buffer.Pop(2)
+	buffer.Push(item("5"), item("6"))
+	buffer.Requeue(popped)
+
+	// then
+	assert.Equal(t, 2, buffer.PopDropped())
+	assert.Equal(t, []elsBulkItem{item("4"), item("5"), item("6")}, buffer.Pop(5))
+	assert.Empty(t, buffer.Pop(1))
+}
+
+func TestElasticsearchBulkIndexing(t *testing.T) {
+	// given
+	es := newFakeElasticsearch(t)
+	es.SetBulkStatuses([]int{http.StatusCreated, http.StatusServiceUnavailable})
+	defer es.Close()
+
+	sink, err := NewElasticsearch(loggerx.NewNoop(), 0, config.Elasticsearch{
+		Server: es.URL,
+		Indices: map[string]config.ELSIndex{
+			"default": {
+				Name:       "logs-botkube-default",
+				DataStream: true,
+				Shards:     1,
+				Template: config.ELSIndexTemplate{
+					Enabled:  true,
+					Priority: 200,
+				},
+				ILM: config.ELSILMPolicy{
+					Enabled:        true,
+					Name:           "botkube",
+					RolloverMaxAge: "1d",
+					DeleteAfter:    "30d",
+				},
+				Bindings: config.SinkBindings{Sources: []string{"k8s-events"}},
+			},
+		},
+		Bulk: config.ELSBulk{
+			Enabled:       true,
+			FlushSize:     2,
+			FlushInterval: time.Hour,
+			BufferSize:    10,
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// then
+	assert.JSONEq(t, `{"policy": {"phases": {
+		"hot": {"actions": {"rollover": {"max_age": "1d"}}},
+		"delete": {"min_age": "30d", "actions": {"delete": {}}}
+	}}}`, es.Request("PUT /_ilm/policy/botkube"))
+	assert.JSONEq(t, `{
+		"index_patterns": ["logs-botkube-default"],
+		"priority": 200,
+		"data_stream": {},
+		"template": {"settings": {"number_of_shards": 1, "number_of_replicas": 0, "index.lifecycle.name": "botkube"}}
+	}`, es.Request("PUT /_index_template/logs-botkube-default"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = sink.Start(ctx)
+	}()
+
+	// when
+	require.NoError(t, sink.SendEvent(ctx, map[string]any{"name": "first"}, []string{"k8s-events"}))
+	require.NoError(t, sink.SendEvent(ctx, map[string]any{"name": "second"}, []string{"k8s-events"}))
+
+	// then
+	assert.Eventually(t, func() bool {
+		return len(es.IndexedDocs()) == 1 && sink.GetStatus().Status == health.StatusUnHealthy
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, sink.buffer.Len())
+
+	// when the next flush is triggered, the failed event is retried
+	es.SetBulkStatuses(nil)
+	require.NoError(t, sink.SendEvent(ctx, map[string]any{"name": "third"}, []string{"k8s-events"}))
+
+	// then
+	assert.Eventually(t, func() bool {
+		return len(es.IndexedDocs()) == 3 && sink.GetStatus().Status == health.StatusHealthy
+	}, time.Second, 10*time.Millisecond)
+
+	var names []any
+	for _, doc := range es.IndexedDocs() {
+		assert.Contains(t, doc, "@timestamp")
+		names = append(names, doc["name"])
+	}
+	assert.Equal(t, []any{"first", "second", "third"}, names)
+}
+
+type fakeElasticsearch struct {
+	*httptest.Server
+	t *testing.T
+
+	mu           sync.Mutex
+	requests     map[string]string
+	bulkStatuses []int
+	docs         []map[string]any
+}
+
+func newFakeElasticsearch(t *testing.T) *fakeElasticsearch {
+	es := &fakeElasticsearch{t: t, requests: map[string]string{}}
+	es.Server = httptest.NewServer(http.HandlerFunc(es.handle))
+	return es
+}
+
+func (f *fakeElasticsearch) SetBulkStatuses(statuses []int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.bulkStatuses = statuses
+}
+
+func (f *fakeElasticsearch) Request(key string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[key]
+}
+
+func (f *fakeElasticsearch) IndexedDocs() []map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]map[string]any{}, f.docs...)
+}
+
+func (f *fakeElasticsearch) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	reader := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		require.NoError(f.t, err)
+		reader = gzipReader
+	}
+	body, err := io.ReadAll(reader)
+	require.NoError(f.t, err)
+	f.requests[r.Method+" "+r.URL.Path] = string(body)
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/":
+		_, _ = w.Write([]byte(`{"version": {"number": "8.10.2"}}`))
+	case r.URL.Path == "/_bulk":
+		f.handleBulk(w, body)
+	default:
+		_, _ = w.Write([]byte(`{"acknowledged": true}`))
+	}
+}
+
+func (f *fakeElasticsearch) handleBulk(w http.ResponseWriter, body []byte) {
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	require.Zero(f.t, len(lines)%2)
+
+	var items []map[string]any
+	for i := 0; i < len(lines); i += 2 {
+		status := http.StatusCreated
+		if idx := i / 2; idx < len(f.bulkStatuses) {
+			status = f.bulkStatuses[idx]
+		}
+		items = append(items, map[string]any{"create": map[string]any{"status": status}})
+		if status != http.StatusCreated {
+			continue
+		}
+
+		var doc map[string]any
+		require.NoError(f.t, json.Unmarshal([]byte(lines[i+1]), &doc))
+		f.docs = append(f.docs, doc)
+	}
+
+	resp, err := json.Marshal(map[string]any{"errors": len(f.bulkStatuses) > 0, "items": items})
+	require.NoError(f.t, err)
+	_, _ = w.Write(resp)
+}
@@ -0,0 +1,220 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	sprig "github.com/go-task/slim-sprig"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/maputil"
+)
+
+var _ Sink = &Kafka{}
+
+const (
+	defaultKafkaTimeout = 10 * time.Second
+	// kafkaBatchTimeout limits the time spent waiting for other messages before the batch is sent.
+	// Events are sent one by one, so there's no need to wait for the default 1s.
+	kafkaBatchTimeout = 10 * time.Millisecond
+)
+
+// kafkaWriter writes messages to a Kafka topic.
+type kafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// Kafka provides integration with Apache Kafka. Events are published in the same JSON format as for the Webhook sink.
+type Kafka struct {
+	log      logrus.FieldLogger
+	reporter AnalyticsReporter
+
+	cfg           config.Kafka
+	writer        kafkaWriter
+	keyTpl        *template.Template
+	status        health.PlatformStatusMsg
+	failureReason health.FailureReasonMsg
+}
+
+// NewKafka creates a new Kafka instance.
+func NewKafka(log logrus.FieldLogger, commGroupIdx int, c config.Kafka, reporter AnalyticsReporter) (*Kafka, error) {
+	writer, err := newKafkaWriter(c)
+	if err != nil {
+		return nil, fmt.Errorf("while creating Kafka writer: %w", err)
+	}
+
+	return newKafka(log, commGroupIdx, c, writer, reporter)
+}
+
+func newKafka(log logrus.FieldLogger, commGroupIdx int, c config.Kafka, writer kafkaWriter, reporter AnalyticsReporter) (*Kafka, error) {
+	var keyTpl *template.Template
+	if c.KeyTemplate != "" {
+		tpl, err := template.New("kafka-key").Funcs(sprig.TxtFuncMap()).Parse(c.KeyTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing key template: %w", err)
+		}
+		keyTpl = tpl
+	}
+
+	kafkaNotifier := &Kafka{
+		log:           log,
+		reporter:      reporter,
+		cfg:           c,
+		writer:        writer,
+		keyTpl:        keyTpl,
+		status:        health.StatusUnknown,
+		failureReason: "",
+	}
+
+	err := reporter.ReportSinkEnabled(kafkaNotifier.IntegrationName(), commGroupIdx)
+	if err != nil {
+		log.Errorf("report analytics error: %s", err.Error())
+	}
+
+	return kafkaNotifier, nil
+}
+
+func newKafkaWriter(c config.Kafka) (*kafka.Writer, error) {
+	transport := &kafka.Transport{}
+	if c.TLS.Enabled {
+		tlsCfg, err := newTLSConfig(c.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLS = tlsCfg
+	}
+
+	if c.SASL.Enabled {
+		mechanism, err := kafkaSASLMechanism(c.SASL)
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mechanism
+	}
+
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = defaultKafkaTimeout
+	}
+
+	return &kafka.Writer{
+		Addr:         kafka.TCP(c.Brokers...),
+		Topic:        c.Topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireOne,
+		BatchTimeout: kafkaBatchTimeout,
+		WriteTimeout: timeout,
+		Transport:    transport,
+	}, nil
+}
+
+func kafkaSASLMechanism(c config.KafkaSASL) (sasl.Mechanism, error) {
+	switch c.Mechanism {
+	case config.SCRAMSHA256KafkaSASLMechanism:
+		return scram.Mechanism(scram.SHA256, c.Username, c.Password)
+	case config.SCRAMSHA512KafkaSASLMechanism:
+		return scram.Mechanism(scram.SHA512, c.Username, c.Password)
+	default:
+		return plain.Mechanism{Username: c.Username, Password: c.Password}, nil
+	}
+}
+
+// Start blocks until the context is cancelled and closes the Kafka writer, so its connections are released.
+func (k *Kafka) Start(ctx context.Context) error {
+	<-ctx.Done()
+
+	k.log.Info("Closing Kafka writer...")
+	if err := k.writer.Close(); err != nil {
+		return fmt.Errorf("while closing Kafka writer: %w", err)
+	}
+	return nil
+}
+
+// SendEvent publishes an event to a configured Kafka topic.
+func (k *Kafka) SendEvent(ctx context.Context, rawData any, sources []string) error {
+	k.log.Debugf(">> Sending to Kafka: %+v", rawData)
+
+	payload := &WebhookPayload{
+		Source:    strings.Join(sources, ","),
+		Data:      rawData,
+		TimeStamp: time.Now(),
+	}
+
+	msg, err := k.message(payload)
+	if err != nil {
+		return err
+	}
+
+	err = k.writer.WriteMessages(ctx, msg)
+	if err != nil {
+		k.setFailureReason(health.FailureReasonConnectionError)
+		return fmt.Errorf("while sending event to Kafka topic %q: %w", k.cfg.Topic, err)
+	}
+
+	k.setFailureReason("")
+	k.log.Debugf("Event successfully sent to Kafka topic %q", k.cfg.Topic)
+	return nil
+}
+
+func (k *Kafka) message(payload *WebhookPayload) (kafka.Message, error) {
+	value, err := json.Marshal(payload)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("while marshaling event: %w", err)
+	}
+
+	msg := kafka.Message{Value: value}
+	if k.keyTpl == nil {
+		return msg, nil
+	}
+
+	// the event is converted to a generic map, so the key template can use the same field names as in the JSON payload
+	data := *payload
+	data.Data = maputil.AsGeneric(payload.Data)
+
+	var key bytes.Buffer
+	if err := k.keyTpl.Execute(&key, data); err != nil {
+		return kafka.Message{}, fmt.Errorf("while rendering message key: %w", err)
+	}
+	msg.Key = key.Bytes()
+	return msg, nil
+}
+
+// IntegrationName describes the notifier integration name.
+func (k *Kafka) IntegrationName() config.CommPlatformIntegration {
+	return config.KafkaCommPlatformIntegration
+}
+
+// Type describes the notifier type.
+func (k *Kafka) Type() config.IntegrationType {
+	return config.SinkIntegrationType
+}
+
+func (k *Kafka) setFailureReason(reason health.FailureReasonMsg) {
+	if reason == "" {
+		k.status = health.StatusHealthy
+	} else {
+		k.status = health.StatusUnHealthy
+	}
+	k.failureReason = reason
+}
+
+// GetStatus gets sink status
+func (k *Kafka) GetStatus() health.PlatformStatus {
+	return health.PlatformStatus{
+		Status:   k.status,
+		Restarts: "0/0",
+		Reason:   k.failureReason,
+	}
+}
@@ -0,0 +1,119 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestKafkaSendEvent(t *testing.T) {
+	tests := map[string]struct {
+		keyTemplate string
+		expKey      string
+	}{
+		"Key rendered from event fields": {
+			keyTemplate: "{{ .Data.Namespace }}/{{ .Data.Name }}",
+			expKey:      "default/nginx",
+		},
+		"No key": {
+			keyTemplate: "",
+			expKey:      "",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			writer := &fakeKafkaWriter{}
+			sink, err := newKafka(loggerx.NewNoop(), 0, config.Kafka{
+				Topic:       "botkube-events",
+				KeyTemplate: test.keyTemplate,
+			}, writer, analytics.NewNoopReporter())
+			require.NoError(t, err)
+
+			event := struct {
+				Namespace string
+				Name      string
+			}{
+				Namespace: "default",
+				Name:      "nginx",
+			}
+
+			// when
+			err = sink.SendEvent(context.Background(), event, []string{"k8s-events"})
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, writer.messages, 1)
+			assert.Equal(t, test.expKey, string(writer.messages[0].Key))
+
+			var payload map[string]any
+			require.NoError(t, json.Unmarshal(writer.messages[0].Value, &payload))
+			assert.Equal(t, "k8s-events", payload["source"])
+			assert.Equal(t, map[string]any{"Namespace": "default", "Name": "nginx"}, payload["data"])
+			assert.Equal(t, health.StatusHealthy, sink.GetStatus().Status)
+		})
+	}
+}
+
+func TestKafkaSendEventFailure(t *testing.T) {
+	// given
+	writer := &fakeKafkaWriter{err: errors.New("leader not available")}
+	sink, err := newKafka(loggerx.NewNoop(), 0, config.Kafka{Topic: "botkube-events"}, writer, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	err = sink.SendEvent(context.Background(), "event", []string{"k8s-events"})
+
+	// then
+	assert.EqualError(t, err, `while sending event to Kafka topic "botkube-events": leader not available`)
+	assert.Equal(t, health.StatusUnHealthy, sink.GetStatus().Status)
+}
+
+func TestKafkaStartClosesWriterOnShutdown(t *testing.T) {
+	// given
+	writer := &fakeKafkaWriter{}
+	sink, err := newKafka(loggerx.NewNoop(), 0, config.Kafka{Topic: "botkube-events"}, writer, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- sink.Start(ctx)
+	}()
+
+	// when
+	cancel()
+
+	// then
+	require.NoError(t, <-done)
+	assert.True(t, writer.closed)
+}
+
+type fakeKafkaWriter struct {
+	messages []kafka.Message
+	err      error
+	closed   bool
+}
+
+func (f *fakeKafkaWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.messages = append(f.messages, msgs...)
+	return nil
+}
+
+func (f *fakeKafkaWriter) Close() error {
+	f.closed = true
+	return nil
+}
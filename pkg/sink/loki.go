@@ -0,0 +1,198 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/maputil"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+var _ Sink = &Loki{}
+
+const (
+	lokiPushAPIPath     = "/loki/api/v1/push"
+	lokiTenantHeader    = "X-Scope-OrgID"
+	lokiSourceLabelName = "source"
+	// maxErrorBodySize limits the size of the response body included in the error message.
+	maxErrorBodySize = 512
+)
+
+// Loki provides integration with Grafana Loki. Events are pushed as JSON-encoded log lines.
+type Loki struct {
+	log      logrus.FieldLogger
+	reporter AnalyticsReporter
+
+	cfg           config.Loki
+	pushURL       string
+	client        *http.Client
+	status        health.PlatformStatusMsg
+	failureReason health.FailureReasonMsg
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	// Values contains [<unix epoch in nanoseconds>, <log line>] pairs.
+	Values [][2]string `json:"values"`
+}
+
+// NewLoki creates a new Loki instance.
+func NewLoki(log logrus.FieldLogger, commGroupIdx int, c config.Loki, reporter AnalyticsReporter) (*Loki, error) {
+	client, err := newHTTPClient(c.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("while creating HTTP client: %w", err)
+	}
+
+	lokiNotifier := &Loki{
+		log:           log,
+		reporter:      reporter,
+		cfg:           c,
+		pushURL:       strings.TrimSuffix(c.URL, "/") + lokiPushAPIPath,
+		client:        client,
+		status:        health.StatusUnknown,
+		failureReason: "",
+	}
+
+	err = reporter.ReportSinkEnabled(lokiNotifier.IntegrationName(), commGroupIdx)
+	if err != nil {
+		log.Errorf("report analytics error: %s", err.Error())
+	}
+
+	return lokiNotifier, nil
+}
+
+// SendEvent pushes an event to a configured Loki server.
+func (l *Loki) SendEvent(ctx context.Context, rawData any, sources []string) error {
+	l.log.Debugf(">> Sending to Loki: %+v", rawData)
+
+	line, err := json.Marshal(rawData)
+	if err != nil {
+		return fmt.Errorf("while marshaling event: %w", err)
+	}
+
+	payload := lokiPushRequest{
+		Streams: []lokiStream{
+			{
+				Stream: l.streamLabels(rawData, sources),
+				Values: [][2]string{
+					{strconv.FormatInt(time.Now().UnixNano(), 10), string(line)},
+				},
+			},
+		},
+	}
+
+	err = l.push(ctx, payload)
+	if err != nil {
+		l.setFailureReason(health.FailureReasonConnectionError)
+		return fmt.Errorf("while sending event to Loki: %w", err)
+	}
+
+	l.setFailureReason("")
+	l.log.Debugf("Event successfully sent to Loki")
+	return nil
+}
+
+// streamLabels returns the static labels, followed by the labels resolved from event fields.
+// The source label is always set, as Loki rejects streams without labels.
+func (l *Loki) streamLabels(rawData any, sources []string) map[string]string {
+	labels := map[string]string{
+		lokiSourceLabelName: strings.Join(sources, ","),
+	}
+	for name, val := range l.cfg.Labels {
+		labels[name] = val
+	}
+
+	if len(l.cfg.LabelFields) == 0 {
+		return labels
+	}
+
+	obj := maputil.AsGeneric(rawData)
+	for name, path := range l.cfg.LabelFields {
+		val, found := maputil.Lookup(obj, path)
+		if !found {
+			continue
+		}
+		labels[name] = fmt.Sprint(val)
+	}
+	return labels
+}
+
+func (l *Loki) push(ctx context.Context, payload lokiPushRequest) (err error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("while marshaling push request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.pushURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if l.cfg.TenantID != "" {
+		req.Header.Set(lokiTenantHeader, l.cfg.TenantID)
+	}
+	if l.cfg.Username != "" {
+		req.SetBasicAuth(l.cfg.Username, l.cfg.Password)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		deferredErr := resp.Body.Close()
+		if deferredErr != nil {
+			err = multierror.Append(err, deferredErr)
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("got unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return nil
+}
+
+// IntegrationName describes the notifier integration name.
+func (l *Loki) IntegrationName() config.CommPlatformIntegration {
+	return config.LokiCommPlatformIntegration
+}
+
+// Type describes the notifier type.
+func (l *Loki) Type() config.IntegrationType {
+	return config.SinkIntegrationType
+}
+
+func (l *Loki) setFailureReason(reason health.FailureReasonMsg) {
+	if reason == "" {
+		l.status = health.StatusHealthy
+	} else {
+		l.status = health.StatusUnHealthy
+	}
+	l.failureReason = reason
+}
+
+// GetStatus gets sink status
+func (l *Loki) GetStatus() health.PlatformStatus {
+	return health.PlatformStatus{
+		Status:   l.status,
+		Restarts: "0/0",
+		Reason:   l.failureReason,
+	}
+}
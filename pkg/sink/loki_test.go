@@ -0,0 +1,99 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestLokiSendEvent(t *testing.T) {
+	// given
+	var (
+		gotReq    lokiPushRequest
+		gotTenant string
+		gotPath   string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotTenant = r.Header.Get(lokiTenantHeader)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotReq))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	loki, err := NewLoki(loggerx.NewNoop(), 0, config.Loki{
+		URL:      server.URL + "/",
+		TenantID: "team-a",
+		Labels: map[string]string{
+			"cluster": "prod",
+		},
+		LabelFields: map[string]string{
+			"namespace": "namespace",
+			"kind":      "kind",
+			"missing":   "labels.missing",
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	event := struct {
+		Kind      string
+		Namespace string
+		Name      string
+	}{
+		Kind:      "Pod",
+		Namespace: "default",
+		Name:      "nginx",
+	}
+
+	// when
+	err = loki.SendEvent(context.Background(), event, []string{"k8s-events"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, lokiPushAPIPath, gotPath)
+	assert.Equal(t, "team-a", gotTenant)
+	require.Len(t, gotReq.Streams, 1)
+	assert.Equal(t, map[string]string{
+		"source":    "k8s-events",
+		"cluster":   "prod",
+		"namespace": "default",
+		"kind":      "Pod",
+	}, gotReq.Streams[0].Stream)
+	require.Len(t, gotReq.Streams[0].Values, 1)
+	assert.NotEmpty(t, gotReq.Streams[0].Values[0][0])
+	assert.JSONEq(t, `{"Kind": "Pod", "Namespace": "default", "Name": "nginx"}`, gotReq.Streams[0].Values[0][1])
+	assert.Equal(t, health.StatusHealthy, loki.GetStatus().Status)
+}
+
+func TestLokiSendEventFailure(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("entry too far behind\n"))
+	}))
+	defer server.Close()
+
+	loki, err := NewLoki(loggerx.NewNoop(), 0, config.Loki{URL: server.URL}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	err = loki.SendEvent(context.Background(), map[string]any{"message": "foo"}, []string{"k8s-events"})
+
+	// then
+	assert.EqualError(t, err, "while sending event to Loki: got unexpected status code 400: entry too far behind")
+	assert.Equal(t, health.PlatformStatus{
+		Status:   health.StatusUnHealthy,
+		Restarts: "0/0",
+		Reason:   health.FailureReasonConnectionError,
+	}, loki.GetStatus())
+}
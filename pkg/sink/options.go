@@ -0,0 +1,70 @@
+package sink
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func newTLSConfig(cfg config.SinkTLS) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		// #nosec G402
+		InsecureSkipVerify: cfg.SkipVerify,
+	}
+
+	if cfg.CACertFile != "" {
+		caCert, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("while reading CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("while parsing CA certificate: no valid PEM certificates found")
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("while loading client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
+func newHTTPClient(cfg config.SinkTLS) (*http.Client, error) {
+	tlsCfg, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+
+	return &http.Client{
+		Timeout:   defaultHTTPCliTimeout,
+		Transport: transport,
+	}, nil
+}
+
+// secretValue returns a given value or, if it's empty, the trimmed content of a given file.
+func secretValue(value, file string) (string, error) {
+	if value != "" || file == "" {
+		return value, nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
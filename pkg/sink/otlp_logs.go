@@ -0,0 +1,332 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/maputil"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+var _ Sink = &OTLPLogs{}
+
+const (
+	otlpLogsHTTPPath       = "/v1/logs"
+	otlpServiceName        = "botkube"
+	otlpSourceAttributeKey = "botkube.source"
+	defaultOTLPTimeout     = 10 * time.Second
+)
+
+var (
+	otlpLevelFieldPaths = []string{"level", "labels.severity"}
+	otlpSeverities      = map[config.Level]logspb.SeverityNumber{
+		config.Debug:    logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG,
+		config.Info:     logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		config.Warn:     logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+		"warning":       logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+		config.Error:    logspb.SeverityNumber_SEVERITY_NUMBER_ERROR,
+		config.Critical: logspb.SeverityNumber_SEVERITY_NUMBER_FATAL,
+	}
+)
+
+// otlpLogsExporter exports log records using a given OTLP transport.
+type otlpLogsExporter interface {
+	Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+}
+
+// OTLPLogs provides integration with OpenTelemetry collectors. Events are exported as OTLP log records.
+type OTLPLogs struct {
+	log      logrus.FieldLogger
+	reporter AnalyticsReporter
+
+	cfg           config.OTLPLogs
+	exporter      otlpLogsExporter
+	resource      *resourcepb.Resource
+	status        health.PlatformStatusMsg
+	failureReason health.FailureReasonMsg
+}
+
+// NewOTLPLogs creates a new OTLPLogs instance.
+func NewOTLPLogs(log logrus.FieldLogger, commGroupIdx int, c config.OTLPLogs, reporter AnalyticsReporter) (*OTLPLogs, error) {
+	var (
+		exporter otlpLogsExporter
+		err      error
+	)
+	switch c.Protocol {
+	case config.HTTPOTLPProtocol:
+		exporter, err = newOTLPHTTPExporter(c)
+	default:
+		exporter, err = newOTLPGRPCExporter(c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("while creating OTLP exporter: %w", err)
+	}
+
+	otlpNotifier := &OTLPLogs{
+		log:           log,
+		reporter:      reporter,
+		cfg:           c,
+		exporter:      exporter,
+		resource:      otlpResource(c.ResourceAttributes),
+		status:        health.StatusUnknown,
+		failureReason: "",
+	}
+
+	err = reporter.ReportSinkEnabled(otlpNotifier.IntegrationName(), commGroupIdx)
+	if err != nil {
+		log.Errorf("report analytics error: %s", err.Error())
+	}
+
+	return otlpNotifier, nil
+}
+
+// SendEvent exports an event as a log record to a configured collector.
+func (o *OTLPLogs) SendEvent(ctx context.Context, rawData any, sources []string) error {
+	o.log.Debugf(">> Sending to OTLP collector: %+v", rawData)
+
+	record, err := o.logRecord(rawData, sources)
+	if err != nil {
+		return err
+	}
+
+	req := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: o.resource,
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						Scope:      &commonpb.InstrumentationScope{Name: otlpServiceName},
+						LogRecords: []*logspb.LogRecord{record},
+					},
+				},
+			},
+		},
+	}
+
+	timeout := o.cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultOTLPTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err = o.exporter.Export(ctx, req)
+	if err != nil {
+		o.setFailureReason(health.FailureReasonConnectionError)
+		return fmt.Errorf("while exporting event to OTLP collector: %w", err)
+	}
+
+	o.setFailureReason("")
+	o.log.Debugf("Event successfully sent to OTLP collector")
+	return nil
+}
+
+func (o *OTLPLogs) logRecord(rawData any, sources []string) (*logspb.LogRecord, error) {
+	body, err := json.Marshal(rawData)
+	if err != nil {
+		return nil, fmt.Errorf("while marshaling event: %w", err)
+	}
+
+	now := uint64(time.Now().UnixNano())
+	record := &logspb.LogRecord{
+		TimeUnixNano:         now,
+		ObservedTimeUnixNano: now,
+		Body:                 stringValue(string(body)),
+		Attributes: []*commonpb.KeyValue{
+			{Key: otlpSourceAttributeKey, Value: stringValue(strings.Join(sources, ","))},
+		},
+	}
+
+	obj := maputil.AsGeneric(rawData)
+	for _, path := range otlpLevelFieldPaths {
+		level, found := maputil.Lookup(obj, path)
+		if !found {
+			continue
+		}
+		record.SeverityText = fmt.Sprint(level)
+		record.SeverityNumber = otlpSeverities[config.Level(strings.ToLower(record.SeverityText))]
+		break
+	}
+
+	return record, nil
+}
+
+func otlpResource(attrs map[string]string) *resourcepb.Resource {
+	res := &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{
+			{Key: "service.name", Value: stringValue(otlpServiceName)},
+		},
+	}
+	for _, key := range maputil.SortKeys(attrs) {
+		res.Attributes = append(res.Attributes, &commonpb.KeyValue{Key: key, Value: stringValue(attrs[key])})
+	}
+	return res
+}
+
+func stringValue(in string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: in}}
+}
+
+// IntegrationName describes the notifier integration name.
+func (o *OTLPLogs) IntegrationName() config.CommPlatformIntegration {
+	return config.OTLPLogsCommPlatformIntegration
+}
+
+// Type describes the notifier type.
+func (o *OTLPLogs) Type() config.IntegrationType {
+	return config.SinkIntegrationType
+}
+
+func (o *OTLPLogs) setFailureReason(reason health.FailureReasonMsg) {
+	if reason == "" {
+		o.status = health.StatusHealthy
+	} else {
+		o.status = health.StatusUnHealthy
+	}
+	o.failureReason = reason
+}
+
+// GetStatus gets sink status
+func (o *OTLPLogs) GetStatus() health.PlatformStatus {
+	return health.PlatformStatus{
+		Status:   o.status,
+		Restarts: "0/0",
+		Reason:   o.failureReason,
+	}
+}
+
+type otlpGRPCExporter struct {
+	client  collogspb.LogsServiceClient
+	headers metadata.MD
+}
+
+func newOTLPGRPCExporter(c config.OTLPLogs) (*otlpGRPCExporter, error) {
+	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
+	if c.TLS.Enabled {
+		tlsCfg, err := newTLSConfig(c.TLS)
+		if err != nil {
+			return nil, err
+		}
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg))
+	}
+
+	conn, err := grpc.Dial(c.Endpoint, creds)
+	if err != nil {
+		return nil, fmt.Errorf("while creating gRPC connection: %w", err)
+	}
+
+	return &otlpGRPCExporter{
+		client:  collogspb.NewLogsServiceClient(conn),
+		headers: metadata.New(c.Headers),
+	}, nil
+}
+
+// Export sends a given request using OTLP/gRPC.
+func (e *otlpGRPCExporter) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	if len(e.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, e.headers)
+	}
+	resp, err := e.client.Export(ctx, req)
+	if err != nil {
+		return err
+	}
+	return partialSuccessError(resp.GetPartialSuccess())
+}
+
+type otlpHTTPExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newOTLPHTTPExporter(c config.OTLPLogs) (*otlpHTTPExporter, error) {
+	client, err := newHTTPClient(c.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("while creating HTTP client: %w", err)
+	}
+
+	url := strings.TrimSuffix(c.Endpoint, "/")
+	if !strings.HasSuffix(url, otlpLogsHTTPPath) {
+		url += otlpLogsHTTPPath
+	}
+
+	return &otlpHTTPExporter{
+		url:     url,
+		headers: c.Headers,
+		client:  client,
+	}, nil
+}
+
+// Export sends a given request using OTLP/HTTP with protobuf encoding.
+func (e *otlpHTTPExporter) Export(ctx context.Context, in *collogspb.ExportLogsServiceRequest) (err error) {
+	body, err := proto.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("while marshaling export request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for key, val := range e.headers {
+		req.Header.Set(key, val)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		deferredErr := resp.Body.Close()
+		if deferredErr != nil {
+			err = multierror.Append(err, deferredErr)
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("while reading response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if len(respBody) > maxErrorBodySize {
+			respBody = respBody[:maxErrorBodySize]
+		}
+		return fmt.Errorf("got unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var out collogspb.ExportLogsServiceResponse
+	if err := proto.Unmarshal(respBody, &out); err != nil {
+		// the response body is optional, so don't fail the export
+		return nil
+	}
+	return partialSuccessError(out.GetPartialSuccess())
+}
+
+// partialSuccessError returns an error if the collector rejected some of the log records.
+func partialSuccessError(in *collogspb.ExportLogsPartialSuccess) error {
+	if in.GetRejectedLogRecords() == 0 {
+		return nil
+	}
+	return fmt.Errorf("collector rejected %d log record(s): %s", in.GetRejectedLogRecords(), in.GetErrorMessage())
+}
@@ -0,0 +1,171 @@
+package sink
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/health"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestOTLPLogsSendEventHTTP(t *testing.T) {
+	// given
+	var (
+		gotReq         collogspb.ExportLogsServiceRequest
+		gotPath        string
+		gotContentType string
+		gotAuth        string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		gotAuth = r.Header.Get("Authorization")
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(body, &gotReq))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink, err := NewOTLPLogs(loggerx.NewNoop(), 0, config.OTLPLogs{
+		Endpoint: server.URL,
+		Protocol: config.HTTPOTLPProtocol,
+		Headers: map[string]string{
+			"Authorization": "Bearer token",
+		},
+		ResourceAttributes: map[string]string{
+			"k8s.cluster.name": "prod",
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	err = sink.SendEvent(context.Background(), map[string]any{"level": "error", "name": "nginx"}, []string{"k8s-events"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, otlpLogsHTTPPath, gotPath)
+	assert.Equal(t, "application/x-protobuf", gotContentType)
+	assert.Equal(t, "Bearer token", gotAuth)
+	assertOTLPLogsRequest(t, &gotReq)
+	assert.Equal(t, health.StatusHealthy, sink.GetStatus().Status)
+}
+
+func TestOTLPLogsSendEventGRPC(t *testing.T) {
+	// given
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	collector := &fakeLogsCollector{}
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, collector)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	sink, err := NewOTLPLogs(loggerx.NewNoop(), 0, config.OTLPLogs{
+		Endpoint: listener.Addr().String(),
+		Protocol: config.GRPCOTLPProtocol,
+		Headers: map[string]string{
+			"authorization": "Bearer token",
+		},
+		ResourceAttributes: map[string]string{
+			"k8s.cluster.name": "prod",
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	err = sink.SendEvent(context.Background(), map[string]any{"level": "error", "name": "nginx"}, []string{"k8s-events"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer token"}, collector.md.Get("authorization"))
+	assertOTLPLogsRequest(t, collector.req)
+	assert.Equal(t, health.StatusHealthy, sink.GetStatus().Status)
+}
+
+func TestOTLPLogsSendEventPartialSuccess(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, err := proto.Marshal(&collogspb.ExportLogsServiceResponse{
+			PartialSuccess: &collogspb.ExportLogsPartialSuccess{
+				RejectedLogRecords: 1,
+				ErrorMessage:       "body too large",
+			},
+		})
+		require.NoError(t, err)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	sink, err := NewOTLPLogs(loggerx.NewNoop(), 0, config.OTLPLogs{
+		Endpoint: server.URL + otlpLogsHTTPPath,
+		Protocol: config.HTTPOTLPProtocol,
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	err = sink.SendEvent(context.Background(), "event", []string{"k8s-events"})
+
+	// then
+	assert.EqualError(t, err, "while exporting event to OTLP collector: collector rejected 1 log record(s): body too large")
+	assert.Equal(t, health.StatusUnHealthy, sink.GetStatus().Status)
+}
+
+func assertOTLPLogsRequest(t *testing.T, req *collogspb.ExportLogsServiceRequest) {
+	t.Helper()
+
+	require.NotNil(t, req)
+	require.Len(t, req.ResourceLogs, 1)
+	resourceLogs := req.ResourceLogs[0]
+
+	resourceAttrs := map[string]string{}
+	for _, attr := range resourceLogs.GetResource().GetAttributes() {
+		resourceAttrs[attr.Key] = attr.GetValue().GetStringValue()
+	}
+	assert.Equal(t, map[string]string{
+		"service.name":     "botkube",
+		"k8s.cluster.name": "prod",
+	}, resourceAttrs)
+
+	require.Len(t, resourceLogs.ScopeLogs, 1)
+	require.Len(t, resourceLogs.ScopeLogs[0].LogRecords, 1)
+	record := resourceLogs.ScopeLogs[0].LogRecords[0]
+
+	assert.NotZero(t, record.TimeUnixNano)
+	assert.Equal(t, "error", record.SeverityText)
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, record.SeverityNumber)
+	assert.JSONEq(t, `{"level": "error", "name": "nginx"}`, record.GetBody().GetStringValue())
+	require.Len(t, record.Attributes, 1)
+	assert.Equal(t, otlpSourceAttributeKey, record.Attributes[0].Key)
+	assert.Equal(t, "k8s-events", record.Attributes[0].GetValue().GetStringValue())
+}
+
+type fakeLogsCollector struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	req *collogspb.ExportLogsServiceRequest
+	md  metadata.MD
+}
+
+func (f *fakeLogsCollector) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	f.req = req
+	f.md, _ = metadata.FromIncomingContext(ctx)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
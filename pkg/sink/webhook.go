@@ -3,12 +3,17 @@ package sink
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/avast/retry-go/v4"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/internal/health"
@@ -16,7 +21,10 @@ import (
 	"github.com/kubeshop/botkube/pkg/multierror"
 )
 
-const defaultHTTPCliTimeout = 30 * time.Second
+const (
+	defaultHTTPCliTimeout  = 30 * time.Second
+	defaultSignatureHeader = "X-Botkube-Signature"
+)
 
 // Webhook provides functionality to notify external service about new events.
 type Webhook struct {
@@ -25,6 +33,11 @@ type Webhook struct {
 
 	URL           string
 	Bindings      config.SinkBindings
+	cfg           config.Webhook
+	client        *http.Client
+	templates     webhookTemplates
+	queue         chan struct{}
+	statusMu      sync.RWMutex
 	status        health.PlatformStatusMsg
 	failureReason health.FailureReasonMsg
 }
@@ -38,16 +51,32 @@ type WebhookPayload struct {
 
 // NewWebhook creates a new Webhook instance.
 func NewWebhook(log logrus.FieldLogger, commGroupIdx int, c config.Webhook, reporter AnalyticsReporter) (*Webhook, error) {
+	client, err := newHTTPClient(c.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("while creating HTTP client: %w", err)
+	}
+
+	templates, err := parseWebhookTemplates(c.Templates)
+	if err != nil {
+		return nil, err
+	}
+
 	whNotifier := &Webhook{
 		log:           log,
 		reporter:      reporter,
 		URL:           c.URL,
 		Bindings:      c.Bindings,
+		cfg:           c,
+		client:        client,
+		templates:     templates,
 		status:        health.StatusUnknown,
 		failureReason: "",
 	}
+	if c.Retry.Enabled {
+		whNotifier.queue = make(chan struct{}, c.Retry.QueueSize)
+	}
 
-	err := reporter.ReportSinkEnabled(whNotifier.IntegrationName(), commGroupIdx)
+	err = reporter.ReportSinkEnabled(whNotifier.IntegrationName(), commGroupIdx)
 	if err != nil {
 		log.Errorf("report analytics error: %s", err.Error())
 	}
@@ -62,7 +91,7 @@ func (w *Webhook) SendEvent(ctx context.Context, rawData any, sources []string)
 		Data:   rawData,
 	}
 
-	err := w.PostWebhook(ctx, jsonPayload)
+	err := w.postWithRetries(ctx, jsonPayload, sources)
 	if err != nil {
 		w.setFailureReason(health.FailureReasonConnectionError)
 		return fmt.Errorf("while sending message to webhook: %w", err)
@@ -74,22 +103,100 @@ func (w *Webhook) SendEvent(ctx context.Context, rawData any, sources []string)
 }
 
 // PostWebhook posts webhook to listener
-func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload) (err error) {
-	message, err := json.Marshal(jsonPayload)
-	if err != nil {
+func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload) error {
+	_, err := w.postWebhook(ctx, jsonPayload, nil)
+	return err
+}
+
+// postWithRetries posts a given payload. If retries are enabled, failed requests are retried with exponential backoff
+// as long as the number of events waiting for a retry doesn't exceed the queue size.
+// The first attempt is sent directly, so slow deliveries don't fill the queue.
+func (w *Webhook) postWithRetries(ctx context.Context, jsonPayload *WebhookPayload, sources []string) error {
+	if !w.cfg.Retry.Enabled {
+		_, err := w.postWebhook(ctx, jsonPayload, sources)
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewBuffer(message))
+	var (
+		attempt uint
+		queued  bool
+	)
+	defer func() {
+		if queued {
+			<-w.queue
+		}
+	}()
+
+	opts := []retry.Option{
+		retry.Attempts(w.cfg.Retry.MaxAttempts),
+		retry.Delay(w.cfg.Retry.InitialDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.LastErrorOnly(true),
+		retry.Context(ctx),
+		retry.OnRetry(func(n uint, err error) {
+			w.log.Debugf("Retrying webhook request (attempt no %d/%d): %s", n+2, w.cfg.Retry.MaxAttempts, err.Error())
+		}),
+	}
+	if w.cfg.Retry.MaxDelay > 0 {
+		opts = append(opts, retry.MaxDelay(w.cfg.Retry.MaxDelay))
+	}
+
+	return retry.Do(func() error {
+		attempt++
+		retryable, err := w.postWebhook(ctx, jsonPayload, sources)
+		if err == nil || attempt >= w.cfg.Retry.MaxAttempts {
+			return err
+		}
+		if !retryable {
+			return retry.Unrecoverable(err)
+		}
+		if queued {
+			return err
+		}
+
+		select {
+		case w.queue <- struct{}{}:
+			queued = true
+			return err
+		default:
+			return retry.Unrecoverable(fmt.Errorf("delivery queue is full (size: %d), dropping event: %w", w.cfg.Retry.QueueSize, err))
+		}
+	}, opts...)
+}
+
+// postWebhook posts a given payload. Returns true if the request failed, but it can be retried.
+func (w *Webhook) postWebhook(ctx context.Context, jsonPayload *WebhookPayload, sources []string) (retryable bool, err error) {
+	body, err := w.renderBody(jsonPayload, sources)
 	if err != nil {
-		return err
+		return false, err
 	}
-	req.Header.Add("Content-Type", "application/json")
 
-	client := &http.Client{Timeout: defaultHTTPCliTimeout}
+	method := w.cfg.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, w.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, val := range w.cfg.Headers {
+		req.Header.Set(key, val)
+	}
+	if err := w.setAuth(req); err != nil {
+		return false, err
+	}
+	if err := w.sign(req, body); err != nil {
+		return false, err
+	}
+
+	client := w.client
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPCliTimeout}
+	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return true, err
 	}
 	defer func() {
 		deferredErr := resp.Body.Close()
@@ -98,10 +205,67 @@ func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload)
 		}
 	}()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error Posting Webhook: %s", fmt.Sprint(resp.StatusCode))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		retryable = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		return retryable, fmt.Errorf("Error Posting Webhook: %s", fmt.Sprint(resp.StatusCode))
+	}
+
+	return false, nil
+}
+
+// renderBody renders the request body using the first template matching given sources. If there is no such template, the payload is marshaled to JSON.
+func (w *Webhook) renderBody(jsonPayload *WebhookPayload, sources []string) ([]byte, error) {
+	tpl, found := w.templates.ForSources(sources)
+	if !found {
+		return json.Marshal(jsonPayload)
+	}
+
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, jsonPayload); err != nil {
+		return nil, fmt.Errorf("while rendering webhook template %q: %w", tpl.Name(), err)
+	}
+	return buff.Bytes(), nil
+}
+
+func (w *Webhook) setAuth(req *http.Request) error {
+	auth := w.cfg.Auth
+	switch auth.Type {
+	case config.BearerWebhookAuth:
+		token, err := secretValue(auth.Token, auth.TokenFile)
+		if err != nil {
+			return fmt.Errorf("while reading bearer token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case config.BasicWebhookAuth:
+		password, err := secretValue(auth.Password, auth.PasswordFile)
+		if err != nil {
+			return fmt.Errorf("while reading basic auth password: %w", err)
+		}
+		req.SetBasicAuth(auth.Username, password)
+	}
+	return nil
+}
+
+// sign adds the hex-encoded HMAC-SHA256 signature of a given body in the `sha256=<signature>` form.
+func (w *Webhook) sign(req *http.Request, body []byte) error {
+	signing := w.cfg.Signing
+	if !signing.Enabled {
+		return nil
 	}
 
+	secret, err := secretValue(signing.Secret, signing.SecretFile)
+	if err != nil {
+		return fmt.Errorf("while reading signing secret: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	header := signing.Header
+	if header == "" {
+		header = defaultSignatureHeader
+	}
+	req.Header.Set(header, "sha256="+hex.EncodeToString(mac.Sum(nil)))
 	return nil
 }
 
@@ -116,6 +280,9 @@ func (w *Webhook) Type() config.IntegrationType {
 }
 
 func (w *Webhook) setFailureReason(reason health.FailureReasonMsg) {
+	w.statusMu.Lock()
+	defer w.statusMu.Unlock()
+
 	if reason == "" {
 		w.status = health.StatusHealthy
 	} else {
@@ -126,6 +293,9 @@ func (w *Webhook) setFailureReason(reason health.FailureReasonMsg) {
 
 // GetStatus gets sink status
 func (w *Webhook) GetStatus() health.PlatformStatus {
+	w.statusMu.RLock()
+	defer w.statusMu.RUnlock()
+
 	return health.PlatformStatus{
 		Status:   w.status,
 		Restarts: "0/0",
@@ -0,0 +1,58 @@
+package sink
+
+import (
+	"fmt"
+	"text/template"
+
+	sprig "github.com/go-task/slim-sprig"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// webhookTemplates holds parsed webhook templates.
+type webhookTemplates struct {
+	defaultTpl *template.Template
+	sources    map[string]*template.Template
+}
+
+// ForSources returns the first template matching given sources. If there is no such template, the default one is returned, if configured.
+func (t webhookTemplates) ForSources(sources []string) (*template.Template, bool) {
+	for _, source := range sources {
+		if tpl, found := t.sources[source]; found {
+			return tpl, true
+		}
+	}
+
+	return t.defaultTpl, t.defaultTpl != nil
+}
+
+func parseWebhookTemplates(cfg config.WebhookTemplates) (webhookTemplates, error) {
+	out := webhookTemplates{
+		sources: make(map[string]*template.Template),
+	}
+
+	parse := func(name, text string) (*template.Template, error) {
+		tpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing webhook template %q: %w", name, err)
+		}
+		return tpl, nil
+	}
+
+	if cfg.Default != "" {
+		tpl, err := parse("default", cfg.Default)
+		if err != nil {
+			return webhookTemplates{}, err
+		}
+		out.defaultTpl = tpl
+	}
+
+	for source, text := range cfg.Sources {
+		tpl, err := parse(source, text)
+		if err != nil {
+			return webhookTemplates{}, err
+		}
+		out.sources[source] = tpl
+	}
+	return out, nil
+}
@@ -2,12 +2,24 @@ package sink
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
 )
 
 // Unit test PostWebhook
@@ -44,3 +56,217 @@ func TestPostWebhook(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookSendEvent(t *testing.T) {
+	// given
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-token\n"), 0o600))
+
+	type request struct {
+		method    string
+		body      string
+		auth      string
+		signature string
+		custom    string
+	}
+	var got request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		got = request{
+			method:    r.Method,
+			body:      string(body),
+			auth:      r.Header.Get("Authorization"),
+			signature: r.Header.Get("X-Signature"),
+			custom:    r.Header.Get("X-Custom"),
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	webhook, err := NewWebhook(loggerx.NewNoop(), 0, config.Webhook{
+		URL:    server.URL,
+		Method: http.MethodPut,
+		Headers: map[string]string{
+			"X-Custom": "value",
+		},
+		Auth: config.WebhookAuth{
+			Type:      config.BearerWebhookAuth,
+			TokenFile: tokenFile,
+		},
+		Templates: config.WebhookTemplates{
+			Default: `{"summary": "{{ .Data.name }}"}`,
+			Sources: map[string]string{
+				"prometheus": `{"alert": "{{ .Data.name }}", "source": "{{ .Source }}"}`,
+			},
+		},
+		Signing: config.WebhookSigning{
+			Enabled: true,
+			Secret:  "secret",
+			Header:  "X-Signature",
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	err = webhook.SendEvent(context.Background(), map[string]any{"name": "foo"}, []string{"k8s-events", "prometheus"})
+
+	// then
+	require.NoError(t, err)
+	expBody := `{"alert": "foo", "source": "k8s-events,prometheus"}`
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(expBody))
+	assert.Equal(t, request{
+		method:    http.MethodPut,
+		body:      expBody,
+		auth:      "Bearer my-token",
+		signature: "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+		custom:    "value",
+	}, got)
+
+	// when
+	err = webhook.SendEvent(context.Background(), map[string]any{"name": "bar"}, []string{"k8s-events"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `{"summary": "bar"}`, got.body)
+}
+
+func TestWebhookRetries(t *testing.T) {
+	tests := map[string]struct {
+		statusCodes []int
+		expAttempts int
+		expErr      string
+	}{
+		"Succeeded after retries": {
+			statusCodes: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			expAttempts: 3,
+		},
+		"Client error is not retried": {
+			statusCodes: []int{http.StatusBadRequest},
+			expAttempts: 1,
+			expErr:      "while sending message to webhook: Error Posting Webhook: 400",
+		},
+		"Attempts exceeded": {
+			statusCodes: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			expAttempts: 3,
+			expErr:      "while sending message to webhook: Error Posting Webhook: 502",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			// given
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.statusCodes[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			webhook, err := NewWebhook(loggerx.NewNoop(), 0, config.Webhook{
+				URL: server.URL,
+				Retry: config.WebhookRetry{
+					Enabled:      true,
+					MaxAttempts:  3,
+					InitialDelay: time.Millisecond,
+					MaxDelay:     5 * time.Millisecond,
+					QueueSize:    1,
+				},
+			}, analytics.NewNoopReporter())
+			require.NoError(t, err)
+
+			// when
+			err = webhook.SendEvent(context.Background(), "event", []string{"k8s-events"})
+
+			// then
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expAttempts, attempts)
+		})
+	}
+}
+
+func TestWebhookRetriesQueueFull(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	webhook, err := NewWebhook(loggerx.NewNoop(), 0, config.Webhook{
+		URL: server.URL,
+		Retry: config.WebhookRetry{
+			Enabled:      true,
+			MaxAttempts:  3,
+			InitialDelay: time.Hour,
+			QueueSize:    1,
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- webhook.SendEvent(ctx, "first", []string{"k8s-events"})
+	}()
+	require.Eventually(t, func() bool {
+		return len(webhook.queue) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// when
+	err = webhook.SendEvent(context.Background(), "second", []string{"k8s-events"})
+
+	// then
+	assert.EqualError(t, err, "while sending message to webhook: delivery queue is full (size: 1), dropping event: Error Posting Webhook: 503")
+
+	cancel()
+	assert.Error(t, <-errCh)
+	assert.Empty(t, webhook.queue)
+}
+
+func TestWebhookConcurrentSlowDeliveries(t *testing.T) {
+	// given
+	const events = 3
+	release := make(chan struct{})
+	received := make(chan struct{}, events)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	webhook, err := NewWebhook(loggerx.NewNoop(), 0, config.Webhook{
+		URL: server.URL,
+		Retry: config.WebhookRetry{
+			Enabled:      true,
+			MaxAttempts:  3,
+			InitialDelay: time.Millisecond,
+			QueueSize:    1,
+		},
+	}, analytics.NewNoopReporter())
+	require.NoError(t, err)
+
+	// when
+	errCh := make(chan error, events)
+	for i := 0; i < events; i++ {
+		go func() {
+			errCh <- webhook.SendEvent(context.Background(), "event", []string{"k8s-events"})
+		}()
+	}
+	for i := 0; i < events; i++ {
+		<-received
+	}
+	close(release)
+
+	// then
+	for i := 0; i < events; i++ {
+		assert.NoError(t, <-errCh)
+	}
+	assert.Empty(t, webhook.queue)
+}